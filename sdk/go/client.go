@@ -0,0 +1,126 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Request is implemented by the options of each route
+type Request interface {
+	// Route returns the daemon route that serves the request
+	Route() string
+	// Query returns the request's options as a query string
+	Query() url.Values
+}
+
+// Globals holds the options every route accepts
+type Globals struct {
+	// the chain to query (the daemon's default chain if empty)
+	Chain string `json:"chain,omitempty"`
+	// report more detail in the results
+	Verbose bool `json:"verbose,omitempty"`
+	// removes related items from the cache (requires an admin key)
+	Decache bool `json:"decache,omitempty"`
+}
+
+// ApiError is returned when the daemon rejects a request or reports errors while serving it
+type ApiError struct {
+	Status int      // the HTTP status of the response
+	Errors []string // the errors reported by the daemon
+}
+
+func (e *ApiError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("chifra daemon: %s", http.StatusText(e.Status))
+	}
+	return fmt.Sprintf("chifra daemon: %s", strings.Join(e.Errors, "; "))
+}
+
+// Client calls the routes of a chifra daemon
+type Client struct {
+	baseUrl    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithApiKey sends the key (as a bearer token) with each request. The daemon requires a key if any are
+// listed in the [server.apiKeys] group of its configuration.
+func WithApiKey(key string) ClientOption {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithHttpClient makes requests with the given client (http.DefaultClient by default)
+func WithHttpClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient returns a client for the daemon at baseUrl (for example, http://localhost:8080)
+func NewClient(baseUrl string, options ...ClientOption) *Client {
+	c := &Client{
+		baseUrl:    strings.TrimRight(baseUrl, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Fetch calls the request's route and returns its results (decoded as T) and the daemon's meta data. If
+// the daemon reported errors along with the results, both the results and an *ApiError are returned.
+func Fetch[T any](ctx context.Context, c *Client, req Request) ([]T, *MetaData, error) {
+	resp, err := c.do(ctx, http.MethodGet, req.Route(), req.Query(), "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		Data   []T       `json:"data"`
+		Meta   *MetaData `json:"meta"`
+		Errors []string  `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, nil, &ApiError{Status: resp.StatusCode}
+		}
+		return nil, nil, fmt.Errorf("decoding the response from %s: %w", req.Route(), err)
+	}
+	if resp.StatusCode != http.StatusOK || len(response.Errors) > 0 {
+		return response.Data, response.Meta, &ApiError{Status: resp.StatusCode, Errors: response.Errors}
+	}
+	return response.Data, response.Meta, nil
+}
+
+// do sends a request to the daemon
+func (c *Client) do(ctx context.Context, method, route string, query url.Values, accept string) (*http.Response, error) {
+	target := c.baseUrl + route
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", "trueblocks-sdk-go/"+Version)
+	if len(c.apiKey) > 0 {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	return c.httpClient.Do(req)
+}
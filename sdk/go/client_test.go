@@ -0,0 +1,261 @@
+package sdk
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// allRequests has one of each route's options
+var allRequests = []Request{
+	&AbisOptions{}, &BlocksOptions{}, &ChunksOptions{}, &ConfigOptions{}, &ExportOptions{}, &InitOptions{},
+	&LineageOptions{}, &ListOptions{}, &LogsOptions{}, &MonitorsOptions{}, &NamesOptions{}, &ReceiptsOptions{},
+	&ScrapeOptions{}, &SlurpOptions{}, &StateOptions{}, &StatusOptions{}, &TokensOptions{}, &TracesOptions{},
+	&TransactionsOptions{}, &WhenOptions{},
+}
+
+func TestQuery(t *testing.T) {
+	opts := &ExportOptions{
+		Addrs:      []string{"trueblocks.eth", "0xf503017d7baf7fbc0fff7492b751025c6a78179b"},
+		Logs:       true,
+		MaxRecords: 10,
+		Globals:    Globals{Chain: "sepolia"},
+	}
+	expected := "addrs=trueblocks.eth&addrs=0xf503017d7baf7fbc0fff7492b751025c6a78179b&chain=sepolia&logs=true&maxRecords=10"
+	if query := opts.Query().Encode(); query != expected {
+		t.Error("wrong query", query)
+	}
+	if opts.Route() != "/export" {
+		t.Error("wrong route", opts.Route())
+	}
+}
+
+// TestOpenApi makes sure the options of each route are those documented in the API's specification
+func TestOpenApi(t *testing.T) {
+	spec, err := readOpenApi("../../docs/content/api/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes := map[string]bool{}
+	for _, req := range allRequests {
+		route := req.Route()
+		routes[route] = true
+		params, ok := spec[route]
+		if !ok {
+			t.Error("route", route, "is not in the specification")
+			continue
+		}
+		value := reflect.ValueOf(req).Elem()
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).Anonymous {
+				continue
+			}
+			name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+			if !params[name] {
+				t.Error("option", name, "of", route, "is not in the specification")
+			}
+		}
+	}
+	for route := range spec {
+		if !routes[route] && route != "/explore" {
+			t.Error("route", route, "has no options in the client")
+		}
+	}
+}
+
+// readOpenApi returns the parameters of each path in the API's specification
+func readOpenApi(path string) (map[string]map[string]bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	pathRe := regexp.MustCompile(`^  (/\w+):$`)
+	paramRe := regexp.MustCompile(`^        - name: (\w+)$`)
+	ret := map[string]map[string]bool{}
+	current := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if m := pathRe.FindStringSubmatch(scanner.Text()); m != nil {
+			current = m[1]
+			ret[current] = map[string]bool{}
+		} else if m := paramRe.FindStringSubmatch(scanner.Text()); m != nil && len(current) > 0 {
+			ret[current][m[1]] = true
+		} else if strings.HasPrefix(scanner.Text(), "components:") {
+			break
+		}
+	}
+	return ret, scanner.Err()
+}
+
+// TestTypes decodes some of the daemon's test results into the types, which are generated from the data
+// models rather than imported from chifra
+func TestTypes(t *testing.T) {
+	var blocks []Block[string]
+	readGold(t, "chifra/api_tests/chifra_run_blocks.txt", &blocks)
+	if len(blocks) != 1 || blocks[0].BlockNumber != 12 || blocks[0].BaseFeePerGas != "0" || blocks[0].Difficulty != 17179844608 {
+		t.Error("wrong blocks", blocks)
+	}
+
+	var names []Name
+	readGold(t, "chifra/api_tests/chifra_run_names.txt", &names)
+	if len(names) == 0 || names[0].Address != "0x0000000000001b84b1cb32787b0d64758d019317" || names[0].Decimals != 18 {
+		t.Error("wrong names", names)
+	}
+
+	var txs []Transaction
+	readGold(t, "acctExport/api_tests/acctExport_ens_test_export.txt", &txs)
+	if len(txs) == 0 || txs[0].BlockNumber != 4037786 || txs[0].GasPrice != 20000000000 {
+		t.Error("wrong transactions", txs)
+	}
+
+	var statements []Statement
+	readGold(t, "acctExport/api_tests/acctExport_accounting_to_cache.txt", &statements)
+	if len(statements) == 0 || statements[0].AccountedFor != "0xd7e30ae310c1d1800f5b641baa7af95b2e1fd98c" || statements[0].AmountIn != "0" {
+		t.Error("wrong statements", statements)
+	}
+
+	var traces []Trace
+	readGold(t, "acctExport/api_tests/acctExport_factory_traces.txt", &traces)
+	if len(traces) == 0 || traces[0].Action == nil || traces[0].Action.Gas != 2192168 || traces[0].Action.Value != "0" {
+		t.Error("wrong traces", traces)
+	}
+
+	var tokens []Token
+	readGold(t, "acctExport/api_tests/acctExport_balances_into_cache.txt", &tokens)
+	if len(tokens) == 0 || tokens[0].Balance != "1.23306318" {
+		t.Error("wrong balances", tokens)
+	}
+}
+
+// readGold decodes the data of one of the API's test results (the first line of which is the request)
+func readGold(t *testing.T, path string, data any) {
+	contents, err := os.ReadFile("../../test/gold/apps/" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, body, _ := strings.Cut(string(contents), "\n")
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(body), &response); err != nil {
+		t.Fatal(path, err)
+	}
+	if err := json.Unmarshal(response.Data, data); err != nil {
+		t.Error(path, err)
+	}
+}
+
+func newTestDaemon(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, "{\n  \"errors\": [\n    \"the API key is not valid\"\n  ]\n}")
+			return
+		}
+
+		w.Header().Set("X-Request-Id", "abc")
+		switch r.URL.Path {
+		case "/names":
+			if r.URL.Query().Get("terms") != "trueblocks" {
+				t.Error("wrong query", r.URL.RawQuery)
+			}
+			if r.Header.Get("Accept") == "application/x-ndjson" {
+				fmt.Fprintln(w, `{"address":"0xf503017d7baf7fbc0fff7492b751025c6a78179b","name":"TrueBlocks Wallet","tags":"31-Gitcoin:Grants"}`)
+				fmt.Fprintln(w, `{"error":"a name could not be read"}`)
+				fmt.Fprintln(w, `{"address":"0x054993ab0f2b1acc0fdc65405ee203b4271bebe6","name":"TrueBlocks Gitcoin","decimals":18}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[{"address":"0xf503017d7baf7fbc0fff7492b751025c6a78179b","name":"TrueBlocks Wallet"}],"meta":{"client":19000000,"chain":"mainnet"}}`)
+		case "/services":
+			fmt.Fprint(w, `{"data":[{"name":"scraper","state":"running","command":"chifra scrape","pid":12,"restarts":1}]}`)
+		case "/services/monitor/start":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":["unknown service: monitor"]}`)
+		}
+	}))
+}
+
+func TestFetch(t *testing.T) {
+	daemon := newTestDaemon(t)
+	defer daemon.Close()
+
+	client := NewClient(daemon.URL+"/", WithApiKey("secret"))
+	names, meta, err := Fetch[Name](context.Background(), client, &NamesOptions{Terms: []string{"trueblocks"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0].Name != "TrueBlocks Wallet" || names[0].Address != "0xf503017d7baf7fbc0fff7492b751025c6a78179b" {
+		t.Error("wrong names", names)
+	}
+	if meta == nil || meta.Latest != 19000000 || meta.Chain != "mainnet" {
+		t.Error("wrong meta data", meta)
+	}
+
+	_, _, err = Fetch[Name](context.Background(), NewClient(daemon.URL), &NamesOptions{})
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized || apiErr.Errors[0] != "the API key is not valid" {
+		t.Error("expected an unauthorized error, got", err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	daemon := newTestDaemon(t)
+	defer daemon.Close()
+
+	client := NewClient(daemon.URL, WithApiKey("secret"))
+	it := Stream[Name](context.Background(), client, &NamesOptions{Terms: []string{"trueblocks"}})
+	defer it.Close()
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.Value().Name)
+	}
+	if len(names) != 2 || names[0] != "TrueBlocks Wallet" || names[1] != "TrueBlocks Gitcoin" {
+		t.Error("wrong names", names)
+	}
+	var apiErr *ApiError
+	if err := it.Err(); !errors.As(err, &apiErr) || apiErr.Status != http.StatusOK || apiErr.Errors[0] != "a name could not be read" {
+		t.Error("expected the daemon's error, got", err)
+	}
+	if it.RequestId() != "abc" {
+		t.Error("wrong request id", it.RequestId())
+	}
+
+	// a rejected request reports the daemon's errors (even if they are not streamed)
+	it = Stream[Name](context.Background(), NewClient(daemon.URL), &NamesOptions{})
+	defer it.Close()
+	if it.Next() {
+		t.Error("a rejected request has no results")
+	}
+	if err := it.Err(); !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized || len(apiErr.Errors) != 1 {
+		t.Error("expected an unauthorized error, got", err)
+	}
+}
+
+func TestServices(t *testing.T) {
+	daemon := newTestDaemon(t)
+	defer daemon.Close()
+
+	client := NewClient(daemon.URL, WithApiKey("secret"))
+	statuses, err := client.Services(context.Background())
+	if err != nil || len(statuses) != 1 || statuses[0].State != "running" || statuses[0].Pid != 12 {
+		t.Error("wrong statuses", statuses, err)
+	}
+
+	var apiErr *ApiError
+	if _, err := client.StartService(context.Background(), "monitor"); !errors.As(err, &apiErr) || apiErr.Status != http.StatusNotFound {
+		t.Error("expected an unknown service, got", err)
+	}
+}
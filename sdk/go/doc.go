@@ -0,0 +1,27 @@
+// Package sdk is a client for the API server run by chifra daemon. It lets Go programs call a local or remote
+// daemon without shelling out to chifra or importing its internal packages. The module has no dependencies.
+//
+// Each route has an options struct (for example, BlocksOptions for /blocks) generated from the same
+// definitions as the command line. Results are decoded into the package's types (for example, Block and
+// Transaction), which are generated from chifra's data models:
+//
+//	client := sdk.NewClient("http://localhost:8080", sdk.WithApiKey(key))
+//	blocks, meta, err := sdk.Fetch[sdk.Block[string]](ctx, client, &sdk.BlocksOptions{
+//		Blocks: []string{"17000000"},
+//	})
+//
+// Stream returns an iterator that receives each record as soon as the daemon produces it (as NDJSON).
+// Closing the iterator (or canceling its context) cancels the request on the daemon:
+//
+//	records := sdk.Stream[sdk.Transaction](ctx, client, &sdk.ExportOptions{
+//		Addrs: []string{"trueblocks.eth"},
+//	})
+//	defer records.Close()
+//	for records.Next() {
+//		tx := records.Value()
+//		...
+//	}
+//	if err := records.Err(); err != nil {
+//		...
+//	}
+package sdk
@@ -0,0 +1,3 @@
+module github.com/TrueBlocks/trueblocks-core/sdk/go
+
+go 1.21
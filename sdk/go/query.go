@@ -0,0 +1,47 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// toQuery converts a route's options to its query string. Each field is sent under the name in its json
+// tag. Lists are sent as repeated values and switches only if they are on. Fields left at their zero
+// value are not sent, so the daemon applies the option's default.
+func toQuery(opts any) url.Values {
+	query := url.Values{}
+	addFields(query, reflect.Indirect(reflect.ValueOf(opts)))
+	return query
+}
+
+func addFields(query url.Values, value reflect.Value) {
+	for i := 0; i < value.NumField(); i++ {
+		field, fieldType := value.Field(i), value.Type().Field(i)
+		if fieldType.Anonymous && field.Kind() == reflect.Struct {
+			addFields(query, field)
+			continue
+		}
+
+		name, _, _ := strings.Cut(fieldType.Tag.Get("json"), ",")
+		if len(name) == 0 || name == "-" || field.IsZero() {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.Bool:
+			query.Set(name, "true")
+		case reflect.Slice:
+			for j := 0; j < field.Len(); j++ {
+				query.Add(name, fmt.Sprint(field.Index(j).Interface()))
+			}
+		default:
+			query.Set(name, fmt.Sprint(field.Interface()))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ServiceStatus reports the status of one of the daemon's services (the scraper or the monitor watcher)
+type ServiceStatus struct {
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Command   string `json:"command"`
+	Pid       int    `json:"pid,omitempty"`
+	Started   int64  `json:"started,omitempty"`
+	Restarts  uint64 `json:"restarts"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Services reports the status of the daemon's services
+func (c *Client) Services(ctx context.Context) ([]ServiceStatus, error) {
+	return c.services(ctx, http.MethodGet, "/services")
+}
+
+// StartService starts one of the daemon's services (which requires an admin key)
+func (c *Client) StartService(ctx context.Context, name string) (ServiceStatus, error) {
+	return c.service(ctx, "/services/"+url.PathEscape(name)+"/start")
+}
+
+// StopService stops one of the daemon's services (which requires an admin key), returning once it has
+// exited
+func (c *Client) StopService(ctx context.Context, name string) (ServiceStatus, error) {
+	return c.service(ctx, "/services/"+url.PathEscape(name)+"/stop")
+}
+
+func (c *Client) service(ctx context.Context, route string) (ServiceStatus, error) {
+	statuses, err := c.services(ctx, http.MethodPost, route)
+	if err != nil {
+		return ServiceStatus{}, err
+	}
+	if len(statuses) != 1 {
+		return ServiceStatus{}, errors.New("chifra daemon: expected the status of one service")
+	}
+	return statuses[0], nil
+}
+
+func (c *Client) services(ctx context.Context, method, route string) ([]ServiceStatus, error) {
+	resp, err := c.do(ctx, method, route, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		Data   []ServiceStatus `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if resp.StatusCode != http.StatusOK {
+		return nil, &ApiError{Status: resp.StatusCode, Errors: response.Errors}
+	} else if err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
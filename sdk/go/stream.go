@@ -0,0 +1,132 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Iterator receives the results of a streaming request one at a time
+type Iterator[T any] struct {
+	route     string
+	requestId string
+	status    int
+	body      io.ReadCloser
+	decoder   *json.Decoder
+	value     T
+	errs      []string
+	err       error
+}
+
+// Stream calls the request's route, asking the daemon to send each result (as NDJSON) as soon as it is
+// produced. The returned iterator must be closed. Closing it before it is done, or canceling the context,
+// cancels the request on the daemon.
+func Stream[T any](ctx context.Context, c *Client, req Request) *Iterator[T] {
+	it := &Iterator[T]{route: req.Route()}
+	resp, err := c.do(ctx, http.MethodGet, req.Route(), req.Query(), "application/x-ndjson")
+	if err != nil {
+		it.err = err
+		return it
+	}
+	it.requestId = resp.Header.Get("X-Request-Id")
+	it.status = resp.StatusCode
+	it.body = resp.Body
+	// a decoder (rather than a line scanner) also reads errors the daemon reports as a single document
+	it.decoder = json.NewDecoder(resp.Body)
+	return it
+}
+
+// Next advances to the next result, returning false when there are no more results or on a failure.
+// Errors the daemon reports while streaming do not end the iteration; they are reported by Err.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil || it.decoder == nil {
+		return false
+	}
+
+	for {
+		var raw json.RawMessage
+		if err := it.decoder.Decode(&raw); err != nil {
+			if !errors.Is(err, io.EOF) {
+				it.err = fmt.Errorf("reading the response from %s: %w", it.route, err)
+			}
+			it.decoder = nil
+			return false
+		}
+
+		if it.isError(raw) || it.status != http.StatusOK {
+			continue
+		}
+
+		var value T
+		if err := json.Unmarshal(raw, &value); err != nil {
+			it.err = fmt.Errorf("decoding a result from %s: %w", it.route, err)
+			return false
+		}
+		it.value = value
+		return true
+	}
+}
+
+// isError returns true (and records the errors) if the message reports errors rather than a result
+func (it *Iterator[T]) isError(raw json.RawMessage) bool {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(raw, &fields) != nil || len(fields) != 1 {
+		return false
+	}
+
+	if msg, ok := fields["error"]; ok {
+		var e string
+		if json.Unmarshal(msg, &e) == nil {
+			it.errs = append(it.errs, e)
+			return true
+		}
+	} else if msgs, ok := fields["errors"]; ok {
+		var e []string
+		if json.Unmarshal(msgs, &e) == nil {
+			it.errs = append(it.errs, e...)
+			return true
+		}
+	}
+	return false
+}
+
+// Value returns the current result
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the error, if any, that ended the iteration. Once the iteration is done, it returns an
+// *ApiError if the daemon rejected the request or reported any errors while serving it.
+func (it *Iterator[T]) Err() error {
+	if it.err != nil || it.decoder != nil {
+		return it.err
+	}
+	if it.status != http.StatusOK || len(it.errs) > 0 {
+		return &ApiError{Status: it.status, Errors: it.errs}
+	}
+	return nil
+}
+
+// RequestId returns the id the daemon gave the request. The daemon's websocket reports the request's
+// progress in progress messages with this id.
+func (it *Iterator[T]) RequestId() string {
+	return it.requestId
+}
+
+// Close ends the request
+func (it *Iterator[T]) Close() error {
+	it.decoder = nil
+	if it.body == nil {
+		return nil
+	}
+	body := it.body
+	it.body = nil
+	return body.Close()
+}
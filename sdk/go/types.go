@@ -0,0 +1,58 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MetaData describes the chain and the state of the daemon's index when a request was served
+type MetaData struct {
+	Latest    uint64 `json:"client"`
+	Finalized uint64 `json:"finalized"`
+	Staging   uint64 `json:"staging"`
+	Ripe      uint64 `json:"ripe"`
+	Unripe    uint64 `json:"unripe"`
+	ChainId   uint64 `json:"chainId,omitempty"`
+	NetworkId uint64 `json:"networkId,omitempty"`
+	Chain     string `json:"chain,omitempty"`
+}
+
+// BigInt holds a value too large for the integer types (wei, for example). The daemon reports these as
+// strings (as decimals in ether with --ether) or, when they are small, as numbers, so BigInt keeps the
+// value's text. Use math/big to do arithmetic on it.
+type BigInt string
+
+// UnmarshalJSON accepts both strings and numbers
+func (b *BigInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*b = BigInt(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*b = BigInt(n)
+	return nil
+}
+
+// RawAppearance is the block number and transaction index at which an address appears
+type RawAppearance struct {
+	Address          string `json:"address"`
+	BlockNumber      uint32 `json:"blockNumber"`
+	TransactionIndex uint32 `json:"transactionIndex"`
+}
+
+// TokenType is the type of a token (ERC20 or ERC721)
+type TokenType int
@@ -21,6 +21,7 @@ class chifra():
     # from ._explore import explore
     from ._export import export
     from ._init import init
+    from ._lineage import lineage
     from ._list import list
     from ._logs import logs
     from ._monitors import monitors
@@ -57,6 +58,8 @@ class chifra():
                 return self.export()
             case 'init':
                 return self.init()
+            case 'lineage':
+                return self.lineage()
             case 'list':
                 return self.list()
             case 'logs':
@@ -11,6 +11,17 @@ abisOpts = {
     "find": {"hotkey": "-f", "type": "flag"},
     "hint": {"hotkey": "-n", "type": "flag"},
     "encode": {"hotkey": "-e", "type": "flag"},
+    "lookup": {"hotkey": "-l", "type": "flag"},
+    "import": {"hotkey": "-i", "type": "flag"},
+    "export": {"hotkey": "", "type": "switch"},
+    "transaction": {"hotkey": "", "type": "switch"},
+    "from": {"hotkey": "", "type": "flag"},
+    "value": {"hotkey": "", "type": "flag"},
+    "gas": {"hotkey": "", "type": "flag"},
+    "maxFee": {"hotkey": "", "type": "flag"},
+    "priorityFee": {"hotkey": "", "type": "flag"},
+    "nonce": {"hotkey": "", "type": "flag"},
+    "keystore": {"hotkey": "", "type": "flag"},
     "fmt": {"hotkey": "-x", "type": "flag"},
     "verbose:": {"hotkey": "-v", "type": "switch"},
     "help": {"hotkey": "-h", "type": "switch"},
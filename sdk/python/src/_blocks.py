@@ -15,6 +15,8 @@ blocksOpts = {
     "logs": {"hotkey": "-l", "type": "switch"},
     "emitter": {"hotkey": "-m", "type": "flag"},
     "topic": {"hotkey": "-B", "type": "flag"},
+    "event": {"hotkey": "", "type": "flag"},
+    "where": {"hotkey": "", "type": "flag"},
     "withdrawals": {"hotkey": "-i", "type": "switch"},
     "articulate": {"hotkey": "-a", "type": "switch"},
     "bigRange": {"hotkey": "-r", "type": "flag"},
@@ -12,6 +12,8 @@ chunksOpts = {
     "publish": {"hotkey": "-p", "type": "switch"},
     "remote": {"hotkey": "-r", "type": "switch"},
     "belongs": {"hotkey": "-b", "type": "flag"},
+    "diff": {"hotkey": "-f", "type": "switch"},
+    "sync": {"hotkey": "", "type": "switch"},
     "firstBlock": {"hotkey": "-F", "type": "flag"},
     "lastBlock": {"hotkey": "-L", "type": "flag"},
     "maxAddrs": {"hotkey": "-m", "type": "flag"},
@@ -25,6 +25,8 @@ exportOpts = {
     "emitter": {"hotkey": "-m", "type": "flag"},
     "reverted": {"hotkey": "-V", "type": "switch"},
     "topic": {"hotkey": "-B", "type": "flag"},
+    "event": {"hotkey": "", "type": "flag"},
+    "where": {"hotkey": "", "type": "flag"},
     "asset": {"hotkey": "-P", "type": "flag"},
     "flow": {"hotkey": "-f", "type": "flag"},
     "factory": {"hotkey": "-y", "type": "switch"},
@@ -9,15 +9,25 @@ namesFmt = "json"
 namesOpts = {
     "expand": {"hotkey": "-e", "type": "switch"},
     "matchCase": {"hotkey": "-m", "type": "switch"},
+    "prefix": {"hotkey": "", "type": "switch"},
     "all": {"hotkey": "-a", "type": "switch"},
     "custom": {"hotkey": "-c", "type": "switch"},
     "prefund": {"hotkey": "-p", "type": "switch"},
+    "ens": {"hotkey": "-E", "type": "switch"},
     "addr": {"hotkey": "-s", "type": "switch"},
     "tags": {"hotkey": "-g", "type": "switch"},
     "clean": {"hotkey": "-C", "type": "switch"},
     "regular": {"hotkey": "-r", "type": "switch"},
     "dryRun": {"hotkey": "-d", "type": "switch"},
     "autoname": {"hotkey": "-A", "type": "flag"},
+    "history": {"hotkey": "-H", "type": "switch"},
+    "rollback": {"hotkey": "-R", "type": "flag"},
+    "pack": {"hotkey": "-P", "type": "flag"},
+    "import": {"hotkey": "-I", "type": "flag"},
+    "policy": {"hotkey": "", "type": "flag"},
+    "signer": {"hotkey": "", "type": "flag"},
+    "untrusted": {"hotkey": "", "type": "switch"},
+    "label": {"hotkey": "-L", "type": "switch"},
     "create": {"hotkey": "", "type": "switch"},
     "update": {"hotkey": "", "type": "switch"},
     "delete": {"hotkey": "", "type": "switch"},
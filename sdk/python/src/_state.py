@@ -13,6 +13,10 @@ stateOpts = {
     "call": {"hotkey": "-l", "type": "flag"},
     "articulate": {"hotkey": "-a", "type": "switch"},
     "proxyFor": {"hotkey": "-r", "type": "flag"},
+    "noMulticall": {"hotkey": "", "type": "switch"},
+    "slots": {"hotkey": "-s", "type": "flag"},
+    "layout": {"hotkey": "", "type": "flag"},
+    "diff": {"hotkey": "-d", "type": "switch"},
     "ether": {"hotkey": "-H", "type": "switch"},
     "cache": {"hotkey": "-o", "type": "switch"},
     "fmt": {"hotkey": "-x", "type": "flag"},
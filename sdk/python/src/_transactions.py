@@ -14,6 +14,8 @@ transactionsOpts = {
     "logs": {"hotkey": "-l", "type": "switch"},
     "emitter": {"hotkey": "-m", "type": "flag"},
     "topic": {"hotkey": "-B", "type": "flag"},
+    "event": {"hotkey": "", "type": "flag"},
+    "where": {"hotkey": "", "type": "flag"},
     "accountFor": {"hotkey": "-A", "type": "flag"},
     "cacheTraces": {"hotkey": "", "type": "switch"},
     "ether": {"hotkey": "-H", "type": "switch"},
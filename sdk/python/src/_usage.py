@@ -15,6 +15,7 @@ def isValidCommand(self):
         "explore": True,
         "export": True,
         "init": True,
+        "lineage": True,
         "list": True,
         "logs": True,
         "monitors": True,
@@ -13,6 +13,17 @@ export function getAbis(
     find?: string[],
     hint?: string[],
     encode?: string,
+    lookup?: string[],
+    import?: string,
+    export?: boolean,
+    transaction?: boolean,
+    from?: string,
+    value?: string,
+    gas?: number,
+    maxFee?: string,
+    priorityFee?: string,
+    nonce?: string,
+    keystore?: string,
     chain: string,
     noHeader?: boolean,
     fmt?: string,
@@ -17,6 +17,8 @@ export function getBlocks(
     logs?: boolean,
     emitter?: address[],
     topic?: topic[],
+    event?: string,
+    where?: string[],
     withdrawals?: boolean,
     articulate?: boolean,
     bigRange?: uint64,
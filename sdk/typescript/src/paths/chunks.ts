@@ -8,13 +8,15 @@ import { address, Appearance, blknum, ChunkAddress, ChunkBloom, ChunkIndex, Chun
 
 export function getChunks(
   parameters?: {
-    mode: 'manifest' | 'index' | 'blooms' | 'pins' | 'addresses' | 'appearances' | 'stats',
+    mode: 'manifest' | 'index' | 'blooms' | 'pins' | 'addresses' | 'appearances' | 'stats' | 'postings',
     blocks?: blknum[],
     check?: boolean,
     pin?: boolean,
     publish?: boolean,
     remote?: boolean,
     belongs?: address[],
+    diff?: boolean,
+    sync?: boolean,
     firstBlock?: blknum,
     lastBlock?: blknum,
     maxAddrs?: blknum,
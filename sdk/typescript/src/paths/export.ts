@@ -29,6 +29,8 @@ export function getExport(
     emitter?: address[],
     reverted?: boolean,
     topic?: topic[],
+    event?: string,
+    where?: string[],
     asset?: address[],
     flow?: 'in' | 'out' | 'zero',
     factory?: boolean,
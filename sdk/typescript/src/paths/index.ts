@@ -4,6 +4,7 @@ export * from './chunks';
 export * from './config';
 export * from './export';
 export * from './init';
+export * from './lineage';
 export * from './list';
 export * from './logs';
 export * from './monitors';
@@ -4,22 +4,32 @@
  * This file was generated with makeClass --sdk. Do not edit it.
  */
 import * as ApiCallers from '../lib/api_callers';
-import { address, Name } from '../types';
+import { address, Name, NameChange, NameProposal } from '../types';
 
 export function getNames(
   parameters?: {
     terms: string[],
     expand?: boolean,
     matchCase?: boolean,
+    prefix?: boolean,
     all?: boolean,
     custom?: boolean,
     prefund?: boolean,
+    ens?: boolean,
     addr?: boolean,
     tags?: boolean,
     clean?: boolean,
     regular?: boolean,
     dryRun?: boolean,
     autoname?: address,
+    history?: boolean,
+    rollback?: string,
+    pack?: string,
+    import?: string,
+    policy?: 'mine*' | 'theirs' | 'priority',
+    signer?: address[],
+    untrusted?: boolean,
+    label?: boolean,
     create?: boolean,
     update?: boolean,
     delete?: boolean,
@@ -35,7 +45,7 @@ export function getNames(
   },
   options?: RequestInit,
 ) {
-  return ApiCallers.fetch<Name[]>(
+  return ApiCallers.fetch<Name[] | NameChange[] | NameProposal[]>(
     { endpoint: '/names', method: 'get', parameters, options },
   );
 }
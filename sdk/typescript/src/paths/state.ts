@@ -16,6 +16,10 @@ export function getState(
     call?: string,
     articulate?: boolean,
     proxyFor?: address,
+    noMulticall?: boolean,
+    slots?: string[],
+    layout?: string,
+    diff?: boolean,
     chain: string,
     noHeader?: boolean,
     fmt?: string,
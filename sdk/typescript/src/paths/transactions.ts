@@ -16,6 +16,8 @@ export function getTransactions(
     logs?: boolean,
     emitter?: address[],
     topic?: topic[],
+    event?: string,
+    where?: string[],
     accountFor?: address,
     chain: string,
     noHeader?: boolean,
@@ -15,12 +15,15 @@ export * from './chunkStats';
 export * from './config';
 export * from './function';
 export * from './ipfsPin';
+export * from './lineage';
 export * from './log';
 export * from './logFilter';
 export * from './manifest';
 export * from './monitor';
 export * from './monitorClean';
 export * from './name';
+export * from './nameChange';
+export * from './nameProposal';
 export * from './namedBlock';
 export * from './parameter';
 export * from './receipt';
@@ -18,6 +18,7 @@ export type Trace = {
   result: TraceResult
   articulatedTrace?: Function
   compressedTrace?: string
+  revertReason?: string
   timestamp: timestamp
   date: datetime
 }
@@ -29,6 +29,7 @@ export type Transaction = {
   articulatedTx: Function
   compressedTx: string
   statements: Statement[]
+  revertReason?: string
   gasUsed: gas
   type: string
 }
@@ -48,7 +48,11 @@ const longAbis = `Purpose:
 
 const notesAbis = `
 Notes:
-  - Search for either four byte signatures or event signatures with the --find option.`
+  - Search for either four byte signatures or event signatures with the --find option.
+  - The signature database is filled by --find and with --import from files of signatures (one per line) or from all cached ABIs with --import cache.
+  - With --transaction and a single address, --encode builds an unsigned EIP-1559 transaction calling that contract (e.g. --encode "transfer(0x..., 100)").
+  - Provide --nonce, --gas, --max_fee, and --priority_fee to build the same transaction without reading those values from the node (the contract's ABI is still needed to encode the call).
+  - The --keystore password is read from the TB_KEYSTORE_PASSWORD environment variable or from the terminal.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra abis
@@ -62,6 +66,17 @@ func init() {
 	abisCmd.Flags().StringSliceVarP(&abisPkg.GetOptions().Find, "find", "f", nil, "search for function or event declarations given a four- or 32-byte code(s)")
 	abisCmd.Flags().StringSliceVarP(&abisPkg.GetOptions().Hint, "hint", "n", nil, "for the --find option only, provide hints to speed up the search")
 	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().Encode, "encode", "e", "", "generate the 32-byte encoding for a given cannonical function or event signature")
+	abisCmd.Flags().StringSliceVarP(&abisPkg.GetOptions().Lookup, "lookup", "l", nil, "search the signature database by four-byte selector, 32-byte topic, or part of a name")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().Import, "import", "i", "", "add signatures to the signature database from a file of signatures or from all cached ABIs (if cache)")
+	abisCmd.Flags().BoolVarP(&abisPkg.GetOptions().Export, "export", "", false, "export every signature in the signature database")
+	abisCmd.Flags().BoolVarP(&abisPkg.GetOptions().Transaction, "transaction", "", false, "with --encode and a single address, build an EIP-1559 transaction calling that contract")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().From, "from", "", "", "for --transaction only, the sender of the transaction (defaults to the --keystore address)")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().Value, "value", "", "", "for --transaction only, the value in wei sent with the transaction")
+	abisCmd.Flags().Uint64VarP(&abisPkg.GetOptions().Gas, "gas", "", 0, "for --transaction only, the gas limit of the transaction (estimated by the node if not provided)")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().MaxFee, "max_fee", "", "", "for --transaction only, the maximum fee per gas in wei (suggested by the node if not provided)")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().PriorityFee, "priority_fee", "", "", "for --transaction only, the maximum priority fee per gas in wei (suggested by the node if not provided)")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().Nonce, "nonce", "", "", "for --transaction only, the nonce of the transaction (read from the node if not provided)")
+	abisCmd.Flags().StringVarP(&abisPkg.GetOptions().Keystore, "keystore", "", "", "for --transaction only, sign the transaction with the key in this keystore file")
 	globals.InitGlobals("abis", abisCmd, &abisPkg.GetOptions().Globals, capabilities)
 
 	abisCmd.SetUsageTemplate(UsageWithNotes(notesAbis))
@@ -56,7 +56,8 @@ Notes:
   - Multiple topics match on topic0, topic1, and so on, not on different topic0's.
   - For the --logs option, large block ranges may crash the node, use --big_range to specify a larger range.
   - The --decache option removes the block(s), all transactions in those block(s), and all traces in those transactions from the cache.
-  - The --withdrawals option is only available on certain chains. It is ignored otherwise.`
+  - The --withdrawals option is only available on certain chains. It is ignored otherwise.
+  - With --event, each --where condition names an argument (by name or position) followed by one of =, !=, <, <=, >, or >= and a value (for example, value>1e18). Equality conditions on the same argument match any of their values.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra blocks
@@ -77,6 +78,8 @@ One of [ from | to | reward ]`)
 	blocksCmd.Flags().BoolVarP(&blocksPkg.GetOptions().Logs, "logs", "l", false, "display only the logs found in the block(s)")
 	blocksCmd.Flags().StringSliceVarP(&blocksPkg.GetOptions().Emitter, "emitter", "m", nil, "for the --logs option only, filter logs to show only those logs emitted by the given address(es)")
 	blocksCmd.Flags().StringSliceVarP(&blocksPkg.GetOptions().Topic, "topic", "B", nil, "for the --logs option only, filter logs to show only those with this topic(s)")
+	blocksCmd.Flags().StringVarP(&blocksPkg.GetOptions().Event, "event", "", "", "for the --logs option only, filter logs to show only those emitted by this event (for example, Transfer(address indexed from, address indexed to, uint256 value))")
+	blocksCmd.Flags().StringSliceVarP(&blocksPkg.GetOptions().Where, "where", "", nil, "for the --event option only, filter logs to show only those whose arguments match these conditions (for example, to=0x... or value>1e18)")
 	blocksCmd.Flags().BoolVarP(&blocksPkg.GetOptions().Withdrawals, "withdrawals", "i", false, "export the withdrawals from the block as opposed to the block data")
 	blocksCmd.Flags().BoolVarP(&blocksPkg.GetOptions().Articulate, "articulate", "a", false, "for the --logs option only, articulate the retrieved data if ABIs can be found")
 	blocksCmd.Flags().Uint64VarP(&blocksPkg.GetOptions().BigRange, "big_range", "r", 500, "for the --logs option only, allow for block ranges larger than 500")
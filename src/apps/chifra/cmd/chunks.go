@@ -40,7 +40,7 @@ const usageChunks = `chunks <mode> [flags] [blocks...] [address...]
 
 Arguments:
   mode - the type of data to process (required)
-	One of [ manifest | index | blooms | pins | addresses | appearances | stats ]
+	One of [ manifest | index | blooms | pins | addresses | appearances | stats | postings ]
   blocks - an optional list of blocks to intersect with chunk ranges`
 
 const shortChunks = "manage, investigate, and display the Unchained Index"
@@ -59,7 +59,9 @@ Notes:
   - The --pin option requires a locally running IPFS node or a pinning service API key.
   - The --publish option requires a private key.
   - The --publisher option is ignored with the --publish option since the sender of the transaction is recorded as the publisher.
-  - Without --rewrite, the manifest is written to the temporary cache. With it, the manifest is rewritten to the index folder.`
+  - Without --rewrite, the manifest is written to the temporary cache. With it, the manifest is rewritten to the index folder.
+  - In manifest mode, --diff compares the local manifest to the publisher's manifest chunk by chunk. Add --sync to download only the differing chunks.
+  - In postings mode, posting lists for the addresses in hotAddresses.txt (in the chain's config folder) are built or brought up to date and reported. chifra list reads them in place of the index.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra chunks
@@ -75,7 +77,8 @@ func init() {
 	chunksCmd.Flags().Uint64VarP(&chunksPkg.GetOptions().Truncate, "truncate", "n", 0, "truncate the entire index at this block (requires a block identifier) (hidden)")
 	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Remote, "remote", "r", false, "prior to processing, retreive the manifest from the Unchained Index smart contract")
 	chunksCmd.Flags().StringSliceVarP(&chunksPkg.GetOptions().Belongs, "belongs", "b", nil, "in index mode only, checks the address(es) for inclusion in the given index chunk")
-	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Diff, "diff", "f", false, "compare two index portions (see notes) (hidden)")
+	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Diff, "diff", "f", false, "compare two index portions or, in manifest mode, the local and remote manifests (see notes)")
+	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Sync, "sync", "", false, "for manifest --diff mode only, download the differing chunks and rewrite the local manifest")
 	chunksCmd.Flags().Uint64VarP(&chunksPkg.GetOptions().FirstBlock, "first_block", "F", 0, "first block to process (inclusive)")
 	chunksCmd.Flags().Uint64VarP(&chunksPkg.GetOptions().LastBlock, "last_block", "L", 0, "last block to process (inclusive)")
 	chunksCmd.Flags().Uint64VarP(&chunksPkg.GetOptions().MaxAddrs, "max_addrs", "m", 0, "the max number of addresses to process in a given chunk")
@@ -89,7 +92,6 @@ func init() {
 	if os.Getenv("TEST_MODE") != "true" {
 		chunksCmd.Flags().MarkHidden("publisher")
 		chunksCmd.Flags().MarkHidden("truncate")
-		chunksCmd.Flags().MarkHidden("diff")
 		chunksCmd.Flags().MarkHidden("list")
 		chunksCmd.Flags().MarkHidden("unpin")
 		chunksCmd.Flags().MarkHidden("tag")
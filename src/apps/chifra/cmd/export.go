@@ -60,7 +60,8 @@ Notes:
   - The _block and _record filters are ignored when used with the --count option.
   - If the --reversed option is present, the appearance list is reversed prior to all processing (including filtering).
   - The --decache option will remove all cache items (blocks, transactions, traces, etc.) for the given address(es).
-  - The --withdrawals option is only available on certain chains. It is ignored otherwise.`
+  - The --withdrawals option is only available on certain chains. It is ignored otherwise.
+  - With --event, each --where condition names an argument (by name or position) followed by one of =, !=, <, <=, >, or >= and a value (for example, value>1e18). Equality conditions on the same argument match any of their values.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra export
@@ -89,6 +90,8 @@ func init() {
 	exportCmd.Flags().StringSliceVarP(&exportPkg.GetOptions().Emitter, "emitter", "m", nil, "for log export only, export only logs if emitted by one of these address(es)")
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Reverted, "reverted", "V", false, "export only transactions that were reverted")
 	exportCmd.Flags().StringSliceVarP(&exportPkg.GetOptions().Topic, "topic", "B", nil, "for log export only, export only logs with this topic(s)")
+	exportCmd.Flags().StringVarP(&exportPkg.GetOptions().Event, "event", "", "", "for log export only, export only logs emitted by this event (for example, Transfer(address indexed from, address indexed to, uint256 value))")
+	exportCmd.Flags().StringSliceVarP(&exportPkg.GetOptions().Where, "where", "", nil, "for the --event option only, export only logs whose arguments match these conditions (for example, to=0x... or value>1e18)")
 	exportCmd.Flags().StringSliceVarP(&exportPkg.GetOptions().Asset, "asset", "P", nil, "for the accounting options only, export statements only for this asset")
 	exportCmd.Flags().StringVarP(&exportPkg.GetOptions().Flow, "flow", "f", "", `for the accounting options only, export statements with incoming, outgoing, or zero value
 One of [ in | out | zero ]`)
@@ -23,6 +23,7 @@ const helpText = `  Accounts:
   Chain State:
     state         retrieve account balance(s) for one or more addresses at given block(s)
     tokens        retrieve token balance(s) for one or more addresses at given block(s)
+    lineage       report the creation and the tree of contracts created by one or more contracts
   Admin:
     config        report on and edit the configuration of the TrueBlocks system
     status        report on the state of the internal binary caches
@@ -49,7 +49,14 @@ const longNames = `Purpose:
 const notesNames = `
 Notes:
   - The tool will accept up to three terms, each of which must match against any field in the database.
-  - The --match_case option enables case sensitive matching.`
+  - The --match_case option enables case sensitive matching.
+  - The --ens option resolves the primary ENS name of each address term and caches it for use by other tools.
+  - A term may be limited to one field (for example, tag:defi or symbol:USD) where the field is one of name, symbol, address, tag, source, or petname.
+  - A term starting with ~ matches fuzzily. With --prefix, the other terms match the start of any word. Fuzzy and prefix results are ranked by how well they match.
+  - The changes made to the custom names database are logged (when, by whom, and the old and new records) so they may be shown with --history or undone with --rollback.
+  - A name pack is signed with the key in TB_NAMES_SIGNINGKEY (if set). It is imported only if it is signed by a trusted signer (listed as trustedSigners in the [names] section of the config or given with --signer) unless --untrusted is given.
+  - With --policy priority, the name whose source is listed earlier in sourcePriority (in the [names] section of the config) is kept.
+  - The --label option proposes names (with a source and confidence) from token metadata, Safe owners, known factories, ENS reverse records, proxy implementations, and deposit address sweeps. Use --pack to write the proposals to a name pack for review.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra names
@@ -60,15 +67,26 @@ func init() {
 
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Expand, "expand", "e", false, "expand search to include all fields (search name, address, and symbol otherwise)")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().MatchCase, "match_case", "m", false, "do case-sensitive search")
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Prefix, "prefix", "", false, "match the terms against the start of words instead of as regular expressions")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().All, "all", "a", false, "include all (including custom) names in the search")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Custom, "custom", "c", false, "include only custom named accounts in the search")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Prefund, "prefund", "p", false, "include prefund accounts in the search")
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Ens, "ens", "E", false, "include ENS names in the search (resolving the primary name of any address terms)")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Addr, "addr", "s", false, "display only addresses in the results (useful for scripting, assumes --no_header)")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Tags, "tags", "g", false, "export the list of tags and subtags only")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Clean, "clean", "C", false, "clean the data (addrs to lower case, sort by addr)")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Regular, "regular", "r", false, "only available with --clean, cleans regular names database")
-	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().DryRun, "dry_run", "d", false, "only available with --clean or --autoname, outputs changes to stdout instead of updating databases")
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().DryRun, "dry_run", "d", false, "only available with --clean, --autoname, or --import, outputs changes to stdout instead of updating databases")
 	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Autoname, "autoname", "A", "", "an address assumed to be a token, added automatically to names database if true")
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().History, "history", "H", false, "show the logged changes to the custom names database (for the address terms, if any)")
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Rollback, "rollback", "R", "", "undo the changes made to the custom names database after the given date or timestamp")
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Pack, "pack", "P", "", "export the names matching the search terms to a name pack in the given file")
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Import, "import", "I", "", "import the names in the given name pack into the custom names database")
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Policy, "policy", "", "mine", `with --import, how to resolve names that differ from existing custom names
+One of [ mine | theirs | priority ]`)
+	namesCmd.Flags().StringSliceVarP(&namesPkg.GetOptions().Signer, "signer", "", nil, "with --import, the address(es) of signers whose name packs are trusted (in addition to those in the config)")
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Untrusted, "untrusted", "", false, "with --import, import the name pack even if it is unsigned or its signer is not trusted")
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Label, "label", "L", false, "propose names from on-chain evidence for the unnamed counterparties of the monitored address terms")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Create, "create", "", false, "create a new name record (hidden)")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Update, "update", "", false, "edit an existing name (hidden)")
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Delete, "delete", "", false, "delete a name, but do not remove it (hidden)")
@@ -55,7 +55,11 @@ Notes:
   - Special blocks are detailed under chifra when --list.
   - Balance is the default mode. To select a single mode use none first, followed by that mode.
   - Valid parameters for --call include Solidity-like syntax: balanceOf(0x316b...183d), a four-byte followed by parameters: 0x70a08231(0x316b...183d), or encoded input data.
-  - You may specify multiple modes on a single line.`
+  - You may specify multiple modes on a single line.
+  - With --call, the calls to all of the addresses at each block are aggregated using Multicall3 (if it is deployed on the chain). Multicall3 is then the caller (msg.sender) seen by the contracts, so use --no_multicall to call functions whose results depend on the caller.
+  - Values for --slots may be followed by [key] to locate an entry in a mapping (for example, 3[0x316b...183d]). With --layout, a variable's name may be used and followed by [index] or .member to locate array elements and struct members.
+  - With --slots, the --changes option reports a slot only when its value differs from its value at the previous block.
+  - The --diff option requires a node that supports debug_traceBlockByNumber. For code changes, the before and after values are code hashes.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra state
@@ -73,6 +77,10 @@ One or more of [ none | some | all | balance | nonce | code | proxy | deployed |
 	stateCmd.Flags().StringVarP(&statePkg.GetOptions().Call, "call", "l", "", "call a smart contract with a solidity syntax, a four-byte and parameters, or encoded call data")
 	stateCmd.Flags().BoolVarP(&statePkg.GetOptions().Articulate, "articulate", "a", false, "for the --call option only, articulate the retrieved data if ABIs can be found")
 	stateCmd.Flags().StringVarP(&statePkg.GetOptions().ProxyFor, "proxy_for", "r", "", "for the --call option only, redirects calls to this implementation")
+	stateCmd.Flags().BoolVarP(&statePkg.GetOptions().NoMulticall, "no_multicall", "", false, "for the --call option only, call each address with its own eth_call instead of aggregating the calls with Multicall3")
+	stateCmd.Flags().StringSliceVarP(&statePkg.GetOptions().Slots, "slots", "s", nil, "report the value of each of the given storage slots (a slot number or, with --layout, a variable) of the address(es)")
+	stateCmd.Flags().StringVarP(&statePkg.GetOptions().Layout, "layout", "", "", "for the --slots option only, a Solidity storage layout file used to locate variables, mapping entries, and array elements")
+	stateCmd.Flags().BoolVarP(&statePkg.GetOptions().Diff, "diff", "d", false, "for each transaction in the given block(s), report the changes to the address(es) state using the node's prestate tracer")
 	globals.InitGlobals("state", stateCmd, &statePkg.GetOptions().Globals, capabilities)
 
 	stateCmd.SetUsageTemplate(UsageWithNotes(notesState))
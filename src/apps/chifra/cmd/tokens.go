@@ -54,7 +54,8 @@ Notes:
   - If the token contract(s) from which you request balances are not ERC20 compliant, the results are undefined.
   - If the queried node does not store historical state, the results are undefined.
   - Special blocks are detailed under chifra when --list.
-  - If the --parts option is not empty, all addresses are considered tokens and each token's attributes are presented.`
+  - If the --parts option is not empty, all addresses are considered tokens and each token's attributes are presented.
+  - Balances and token attributes are queried in batches using Multicall3 (if it is deployed on the chain).`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra tokens
@@ -52,7 +52,8 @@ Notes:
   - This tool checks for valid input syntax, but does not check that the transaction requested actually exists.
   - If the queried node does not store historical state, the results for most older transactions are undefined.
   - The --traces option, when used with --account_for, will descend into traces to complete reconciliations.
-  - The --decache option removes the all transaction(s) and all traces in those transactions from the cache.`
+  - The --decache option removes the all transaction(s) and all traces in those transactions from the cache.
+  - With --event, each --where condition names an argument (by name or position) followed by one of =, !=, <, <=, >, or >= and a value (for example, value>1e18). Equality conditions on the same argument match any of their values.`
 
 func init() {
 	var capabilities = caps.Default // Additional global caps for chifra transactions
@@ -72,6 +73,8 @@ One of [ from | to ]`)
 	transactionsCmd.Flags().BoolVarP(&transactionsPkg.GetOptions().Logs, "logs", "l", false, "display only the logs found in the transaction(s)")
 	transactionsCmd.Flags().StringSliceVarP(&transactionsPkg.GetOptions().Emitter, "emitter", "m", nil, "for the --logs option only, filter logs to show only those logs emitted by the given address(es)")
 	transactionsCmd.Flags().StringSliceVarP(&transactionsPkg.GetOptions().Topic, "topic", "B", nil, "for the --logs option only, filter logs to show only those with this topic(s)")
+	transactionsCmd.Flags().StringVarP(&transactionsPkg.GetOptions().Event, "event", "", "", "for the --logs option only, filter logs to show only those emitted by this event (for example, Transfer(address indexed from, address indexed to, uint256 value))")
+	transactionsCmd.Flags().StringSliceVarP(&transactionsPkg.GetOptions().Where, "where", "", nil, "for the --event option only, filter logs to show only those whose arguments match these conditions (for example, to=0x... or value>1e18)")
 	transactionsCmd.Flags().StringVarP(&transactionsPkg.GetOptions().AccountFor, "account_for", "A", "", "reconcile the transaction as per the provided address")
 	transactionsCmd.Flags().BoolVarP(&transactionsPkg.GetOptions().CacheTraces, "cache_traces", "", false, "force the transaction's traces into the cache (hidden)")
 	transactionsCmd.Flags().BoolVarP(&transactionsPkg.GetOptions().Source, "source", "s", false, "find the source of the funds sent to the receiver (hidden)")
@@ -0,0 +1,38 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abisPkg
+
+import (
+	"context"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandleExport reports every signature in the signature database. The text output may be imported
+// into another database with --import.
+func (opts *AbisOptions) HandleExport() error {
+	chain := opts.Globals.Chain
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawFunction], errorChan chan error) {
+		db, err := abi.OpenSignatureDb(chain)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+
+		for _, function := range db.All() {
+			function := function
+			modelChan <- &function
+		}
+	}
+
+	extra := map[string]interface{}{
+		"encodingSignatureOnly": true,
+	}
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extra))
+}
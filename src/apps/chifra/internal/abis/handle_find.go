@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	ants "github.com/panjf2000/ants/v2"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
@@ -51,11 +52,10 @@ func (opts *AbisOptions) HandleAbiFind() error {
 					scanBar.Found++
 					logger.Progress(!testMode || len(opts.Find) < 2, "Found", scanBar.Found, "of", scanBar.Wanted, arg, testSig)
 					found := types.SimpleFunction{Encoding: arg, Signature: testSig.(string)}
-					if testMode {
-						mutex.Lock()
-						results = append(results, found)
-						mutex.Unlock()
-					} else {
+					mutex.Lock()
+					results = append(results, found)
+					mutex.Unlock()
+					if !testMode {
 						modelChan <- &found
 					}
 					return
@@ -107,7 +107,7 @@ func (opts *AbisOptions) HandleAbiFind() error {
 			}
 		}
 
-		defer wg.Wait()
+		wg.Wait()
 
 		if opts.Globals.TestMode {
 			// Otherwise the test is not reproducable
@@ -118,6 +118,10 @@ func (opts *AbisOptions) HandleAbiFind() error {
 				modelChan <- &item
 			}
 		}
+
+		if err := opts.saveFound(results); err != nil {
+			errorChan <- err
+		}
 	}
 
 	extra := map[string]interface{}{
@@ -126,6 +130,29 @@ func (opts *AbisOptions) HandleAbiFind() error {
 	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extra))
 }
 
+// saveFound adds the signatures found by the search to the signature database, so they may be used to
+// articulate the calls and events of contracts for which there is no ABI without searching again. The
+// database is left alone in test mode and for API requests.
+func (opts *AbisOptions) saveFound(found []types.SimpleFunction) error {
+	if len(found) == 0 || opts.Globals.TestMode || opts.Globals.IsApiMode() {
+		return nil
+	}
+
+	chain := opts.Globals.Chain
+	db, err := abi.OpenSignatureDb(chain)
+	if err != nil {
+		return err
+	}
+	for _, function := range found {
+		kind := abi.SigFunction
+		if len(function.Encoding) == 66 {
+			kind = abi.SigEvent
+		}
+		db.Insert(function.Signature, kind)
+	}
+	return db.Write(chain)
+}
+
 func (opts *AbisOptions) hitsHint(test string) bool {
 	hits := len(opts.Hint) == 0
 	if !hits {
@@ -0,0 +1,53 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abisPkg
+
+import (
+	"os"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+)
+
+// HandleImport adds signatures to the signature database either from a plain-text signature dump or,
+// if --import is `cache`, from every cached and known ABI.
+func (opts *AbisOptions) HandleImport() error {
+	chain := opts.Globals.Chain
+
+	db, err := abi.OpenSignatureDb(chain)
+	if err != nil {
+		return err
+	}
+
+	if opts.Import == "cache" {
+		added, err := db.ImportAbis(chain)
+		if err != nil {
+			return err
+		}
+		logger.Info("Imported", added, "signatures from cached ABIs")
+
+	} else {
+		fp, err := os.Open(opts.Import)
+		if err != nil {
+			return err
+		}
+		defer fp.Close()
+
+		added, rejected, err := db.ImportSignatures(fp)
+		if err != nil {
+			return err
+		}
+		logger.Info("Imported", added, "signatures from", opts.Import)
+		if rejected > 0 {
+			logger.Warn("Skipped", rejected, "invalid lines in", opts.Import)
+		}
+	}
+
+	if err = db.Write(chain); err != nil {
+		return err
+	}
+	logger.Info("The signature database now holds", db.Len(), "signatures")
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abisPkg
+
+import (
+	"context"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandleLookup reports the signatures in the signature database matching each of the --lookup terms.
+// Four-byte selectors report every colliding signature, 32-byte topics report the event, and anything
+// else is treated as a fragment of the signature's text.
+func (opts *AbisOptions) HandleLookup() error {
+	chain := opts.Globals.Chain
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawFunction], errorChan chan error) {
+		db, err := abi.OpenSignatureDb(chain)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+
+		for _, term := range opts.Lookup {
+			for _, function := range db.Lookup(term) {
+				function := function
+				modelChan <- &function
+			}
+		}
+	}
+
+	extra := map[string]interface{}{
+		"encodingSignatureOnly": true,
+	}
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extra))
+}
@@ -0,0 +1,187 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abisPkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/call"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"golang.org/x/term"
+)
+
+// HandleTransaction builds an EIP-1559 transaction sending the --encode call to the given contract and
+// reports both its unsigned and (if --keystore is provided) signed payloads. Any of the nonce, gas limit,
+// and fees not provided on the command line are read from the node.
+func (opts *AbisOptions) HandleTransaction() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		tx, err := opts.buildTransaction()
+		if err != nil {
+			errorChan <- err
+			cancel()
+			return
+		}
+
+		if len(opts.Keystore) > 0 {
+			password, err := keystorePassword()
+			if err == nil {
+				err = tx.SignWithKeystore(opts.Keystore, password)
+			}
+			if err != nil {
+				errorChan <- err
+				cancel()
+				return
+			}
+		}
+
+		s, err := toTxPayload(tx)
+		if err != nil {
+			errorChan <- err
+			cancel()
+			return
+		}
+		modelChan <- s
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// buildTransaction fills in the transaction from the command line, consulting the node only for those
+// values that were not provided.
+func (opts *AbisOptions) buildTransaction() (*call.Transaction, error) {
+	chain := opts.Globals.Chain
+
+	chainId, err := strconv.ParseUint(config.GetChain(chain).ChainId, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chainId for chain %s: %w", chain, err)
+	}
+
+	tx := call.Transaction{
+		ChainId: chainId,
+		To:      base.HexToAddress(opts.Addrs[0]),
+		Gas:     opts.Gas,
+		Value:   parseWei(opts.Value),
+	}
+
+	if len(opts.From) > 0 {
+		tx.From = base.HexToAddress(opts.From)
+	} else if len(opts.Keystore) > 0 {
+		if tx.From, err = call.KeystoreAddress(opts.Keystore); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Encode != "0x" {
+		contractCall, _, err := call.NewContractCall(opts.Conn, tx.To, opts.Encode)
+		if err != nil {
+			return nil, fmt.Errorf("the --encode value provided (%s) was not found: %w", opts.Encode, err)
+		}
+		if tx.Input, err = contractCall.Pack(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(opts.Nonce) > 0 {
+		tx.Nonce, _ = strconv.ParseUint(opts.Nonce, 0, 64)
+	} else if tx.Nonce, err = opts.Conn.GetPendingNonce(tx.From); err != nil {
+		return nil, err
+	}
+
+	if len(opts.MaxFee) > 0 && len(opts.PriorityFee) > 0 {
+		tx.MaxFee, tx.PriorityFee = parseWei(opts.MaxFee), parseWei(opts.PriorityFee)
+	} else {
+		maxFee, priorityFee, err := opts.Conn.GetFeeSuggestion()
+		if err != nil {
+			return nil, err
+		}
+		tx.MaxFee, tx.PriorityFee = maxFee, priorityFee
+		if len(opts.MaxFee) > 0 {
+			tx.MaxFee = parseWei(opts.MaxFee)
+		}
+		if len(opts.PriorityFee) > 0 {
+			tx.PriorityFee = parseWei(opts.PriorityFee)
+		}
+	}
+
+	if tx.MaxFee.Cmp(tx.PriorityFee) < 0 {
+		return nil, fmt.Errorf("the maximum fee (%s) is less than the priority fee (%s)", tx.MaxFee.String(), tx.PriorityFee.String())
+	}
+
+	if tx.Gas == 0 {
+		if tx.Gas, err = opts.Conn.EstimateGas(tx.From, tx.To, tx.Value, tx.Input); err != nil {
+			return nil, fmt.Errorf("could not estimate gas (provide --gas): %w", err)
+		}
+	}
+
+	return &tx, nil
+}
+
+// toTxPayload converts the transaction into its reportable form
+func toTxPayload(tx *call.Transaction) (*simpleTxPayload, error) {
+	unsigned, err := tx.UnsignedPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &simpleTxPayload{
+		ChainId:              tx.ChainId,
+		Nonce:                tx.Nonce,
+		From:                 tx.From,
+		To:                   tx.To,
+		Value:                *tx.Value,
+		Gas:                  tx.Gas,
+		MaxFeePerGas:         *tx.MaxFee,
+		MaxPriorityFeePerGas: *tx.PriorityFee,
+		Input:                "0x" + base.Bytes2Hex(tx.Input),
+		SigningHash:          tx.SigningHash(),
+		UnsignedTx:           "0x" + base.Bytes2Hex(unsigned),
+	}
+
+	if tx.IsSigned() {
+		signed, hash, err := tx.SignedPayload()
+		if err != nil {
+			return nil, err
+		}
+		s.SignedTx = "0x" + base.Bytes2Hex(signed)
+		s.Hash = hash
+	}
+
+	return s, nil
+}
+
+// parseWei parses a decimal (or 0x prefixed hex) amount of wei. Values are validated before this is called.
+func parseWei(value string) *big.Int {
+	if len(value) == 0 {
+		return new(big.Int)
+	}
+	ret, _ := new(big.Int).SetString(value, 0)
+	return ret
+}
+
+// keystorePassword returns the password for the --keystore file from the environment or the terminal
+func keystorePassword() (string, error) {
+	if password, ok := os.LookupEnv("TB_KEYSTORE_PASSWORD"); ok {
+		return password, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("set TB_KEYSTORE_PASSWORD to sign without a terminal")
+	}
+
+	fmt.Fprint(os.Stderr, "Keystore password: ")
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	return string(password), err
+}
@@ -22,14 +22,25 @@ import (
 
 // AbisOptions provides all command options for the chifra abis command.
 type AbisOptions struct {
-	Addrs   []string              `json:"addrs,omitempty"`   // A list of one or more smart contracts whose ABIs to display
-	Known   bool                  `json:"known,omitempty"`   // Load common 'known' ABIs from cache
-	Find    []string              `json:"find,omitempty"`    // Search for function or event declarations given a four- or 32-byte code(s)
-	Hint    []string              `json:"hint,omitempty"`    // For the --find option only, provide hints to speed up the search
-	Encode  string                `json:"encode,omitempty"`  // Generate the 32-byte encoding for a given cannonical function or event signature
-	Globals globals.GlobalOptions `json:"globals,omitempty"` // The global options
-	Conn    *rpc.Connection       `json:"conn,omitempty"`    // The connection to the RPC server
-	BadFlag error                 `json:"badFlag,omitempty"` // An error flag if needed
+	Addrs       []string              `json:"addrs,omitempty"`       // A list of one or more smart contracts whose ABIs to display
+	Known       bool                  `json:"known,omitempty"`       // Load common 'known' ABIs from cache
+	Find        []string              `json:"find,omitempty"`        // Search for function or event declarations given a four- or 32-byte code(s)
+	Hint        []string              `json:"hint,omitempty"`        // For the --find option only, provide hints to speed up the search
+	Encode      string                `json:"encode,omitempty"`      // Generate the 32-byte encoding for a given cannonical function or event signature
+	Lookup      []string              `json:"lookup,omitempty"`      // Search the signature database by four-byte selector, 32-byte topic, or part of a name
+	Import      string                `json:"import,omitempty"`      // Add signatures to the signature database from a file of signatures or from all cached ABIs
+	Export      bool                  `json:"export,omitempty"`      // Export every signature in the signature database
+	Transaction bool                  `json:"transaction,omitempty"` // With --encode and a single address, build an EIP-1559 transaction calling that contract
+	From        string                `json:"from,omitempty"`        // For --transaction only, the sender of the transaction (defaults to the --keystore address)
+	Value       string                `json:"value,omitempty"`       // For --transaction only, the value in wei sent with the transaction
+	Gas         uint64                `json:"gas,omitempty"`         // For --transaction only, the gas limit of the transaction (estimated by the node if not provided)
+	MaxFee      string                `json:"maxFee,omitempty"`      // For --transaction only, the maximum fee per gas in wei (suggested by the node if not provided)
+	PriorityFee string                `json:"priorityFee,omitempty"` // For --transaction only, the maximum priority fee per gas in wei (suggested by the node if not provided)
+	Nonce       string                `json:"nonce,omitempty"`       // For --transaction only, the nonce of the transaction (read from the node if not provided)
+	Keystore    string                `json:"keystore,omitempty"`    // For --transaction only, sign the transaction with the key in this keystore file
+	Globals     globals.GlobalOptions `json:"globals,omitempty"`     // The global options
+	Conn        *rpc.Connection       `json:"conn,omitempty"`        // The connection to the RPC server
+	BadFlag     error                 `json:"badFlag,omitempty"`     // An error flag if needed
 	// EXISTING_CODE
 	// EXISTING_CODE
 }
@@ -43,6 +54,17 @@ func (opts *AbisOptions) testLog() {
 	logger.TestLog(len(opts.Find) > 0, "Find: ", opts.Find)
 	logger.TestLog(len(opts.Hint) > 0, "Hint: ", opts.Hint)
 	logger.TestLog(len(opts.Encode) > 0, "Encode: ", opts.Encode)
+	logger.TestLog(len(opts.Lookup) > 0, "Lookup: ", opts.Lookup)
+	logger.TestLog(len(opts.Import) > 0, "Import: ", opts.Import)
+	logger.TestLog(opts.Export, "Export: ", opts.Export)
+	logger.TestLog(opts.Transaction, "Transaction: ", opts.Transaction)
+	logger.TestLog(len(opts.From) > 0, "From: ", opts.From)
+	logger.TestLog(len(opts.Value) > 0, "Value: ", opts.Value)
+	logger.TestLog(opts.Gas != 0, "Gas: ", opts.Gas)
+	logger.TestLog(len(opts.MaxFee) > 0, "MaxFee: ", opts.MaxFee)
+	logger.TestLog(len(opts.PriorityFee) > 0, "PriorityFee: ", opts.PriorityFee)
+	logger.TestLog(len(opts.Nonce) > 0, "Nonce: ", opts.Nonce)
+	logger.TestLog(len(opts.Keystore) > 0, "Keystore: ", opts.Keystore)
 	opts.Conn.TestLog(opts.getCaches())
 	opts.Globals.TestLog()
 }
@@ -78,6 +100,31 @@ func abisFinishParseApi(w http.ResponseWriter, r *http.Request) *AbisOptions {
 			}
 		case "encode":
 			opts.Encode = value[0]
+		case "lookup":
+			for _, val := range value {
+				s := strings.Split(val, " ") // may contain space separated items
+				opts.Lookup = append(opts.Lookup, s...)
+			}
+		case "import":
+			opts.Import = value[0]
+		case "export":
+			opts.Export = true
+		case "transaction":
+			opts.Transaction = true
+		case "from":
+			opts.From = value[0]
+		case "value":
+			opts.Value = value[0]
+		case "gas":
+			opts.Gas = globals.ToUint64(value[0])
+		case "maxFee":
+			opts.MaxFee = value[0]
+		case "priorityFee":
+			opts.PriorityFee = value[0]
+		case "nonce":
+			opts.Nonce = value[0]
+		case "keystore":
+			opts.Keystore = value[0]
 		default:
 			if !copy.Globals.Caps.HasKey(key) {
 				opts.BadFlag = validate.Usage("Invalid key ({0}) in {1} route.", key, "abis")
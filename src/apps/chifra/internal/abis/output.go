@@ -56,8 +56,16 @@ func (opts *AbisOptions) AbisInternal() error {
 		err = opts.HandleDecache()
 	} else if len(opts.Find) > 0 {
 		err = opts.HandleAbiFind()
+	} else if opts.Transaction {
+		err = opts.HandleTransaction()
 	} else if len(opts.Encode) > 0 {
 		err = opts.HandleEncode()
+	} else if len(opts.Lookup) > 0 {
+		err = opts.HandleLookup()
+	} else if len(opts.Import) > 0 {
+		err = opts.HandleImport()
+	} else if opts.Export {
+		err = opts.HandleExport()
 	} else {
 		err = opts.HandleShow()
 	}
@@ -0,0 +1,87 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package abisPkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// EXISTING_CODE
+
+type simpleTxPayload struct {
+	ChainId              uint64       `json:"chainId"`
+	From                 base.Address `json:"from"`
+	Gas                  base.Gas     `json:"gas"`
+	Hash                 base.Hash    `json:"hash,omitempty"`
+	Input                string       `json:"input"`
+	MaxFeePerGas         base.Wei     `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas base.Wei     `json:"maxPriorityFeePerGas"`
+	Nonce                uint64       `json:"nonce"`
+	SignedTx             string       `json:"signedTx,omitempty"`
+	SigningHash          base.Hash    `json:"signingHash"`
+	To                   base.Address `json:"to"`
+	UnsignedTx           string       `json:"unsignedTx"`
+	Value                base.Wei     `json:"value"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleTxPayload) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleTxPayload) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"chainId":              s.ChainId,
+		"nonce":                s.Nonce,
+		"from":                 s.From,
+		"to":                   s.To,
+		"value":                s.Value.String(),
+		"gas":                  s.Gas,
+		"maxFeePerGas":         s.MaxFeePerGas.String(),
+		"maxPriorityFeePerGas": s.MaxPriorityFeePerGas.String(),
+		"input":                s.Input,
+		"signingHash":          s.SigningHash,
+		"unsignedTx":           s.UnsignedTx,
+	}
+	order = []string{
+		"chainId",
+		"nonce",
+		"from",
+		"to",
+		"value",
+		"gas",
+		"maxFeePerGas",
+		"maxPriorityFeePerGas",
+		"input",
+		"signingHash",
+		"unsignedTx",
+	}
+
+	if len(s.SignedTx) > 0 {
+		model["hash"] = s.Hash
+		model["signedTx"] = s.SignedTx
+		order = append(order, []string{"hash", "signedTx"}...)
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
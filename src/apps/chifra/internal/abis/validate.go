@@ -5,7 +5,12 @@
 package abisPkg
 
 import (
+	"math/big"
+	"strconv"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -37,7 +42,60 @@ func (opts *AbisOptions) validateAbis() error {
 		}
 	}
 
-	if len(opts.Globals.File) == 0 && len(opts.Encode) == 0 && len(opts.Find) == 0 && !opts.Known && !opts.Globals.Decache {
+	nModes := 0
+	for _, mode := range []bool{len(opts.Find) > 0, len(opts.Encode) > 0, len(opts.Lookup) > 0, len(opts.Import) > 0, opts.Export} {
+		if mode {
+			nModes++
+		}
+	}
+	if nModes > 1 && (len(opts.Lookup) > 0 || len(opts.Import) > 0 || opts.Export) {
+		return validate.Usage("Please choose only one of {0}.", "--find, --encode, --lookup, --import, or --export")
+	}
+
+	if len(opts.Import) > 0 {
+		if opts.Globals.IsApiMode() {
+			return validate.Usage("The {0} option is not available{1}.", "--import", " in API mode")
+		}
+		if opts.Import != "cache" && !file.FileExists(opts.Import) {
+			return validate.Usage("The {0} option ({1}) must be an existing file or {2}.", "--import", opts.Import, "cache")
+		}
+	}
+
+	isTx := len(opts.From) > 0 || len(opts.Value) > 0 || opts.Gas != 0 || len(opts.MaxFee) > 0 || len(opts.PriorityFee) > 0 || len(opts.Nonce) > 0 || len(opts.Keystore) > 0
+	if isTx && !opts.Transaction {
+		return validate.Usage("The {0} options require {1}.", "transaction", "--transaction")
+	}
+
+	if opts.Transaction {
+		if len(opts.Encode) == 0 || len(opts.Addrs) != 1 {
+			return validate.Usage("The {0} option requires {1}.", "--transaction", "--encode and a single contract address")
+		}
+		if len(opts.Keystore) > 0 {
+			if opts.Globals.IsApiMode() {
+				return validate.Usage("The {0} option is not available{1}.", "--keystore", " in API mode")
+			}
+			if !file.FileExists(opts.Keystore) {
+				return validate.Usage("The {0} option ({1}) must {2}.", "--keystore", opts.Keystore, "be an existing file")
+			}
+		}
+		if len(opts.From) > 0 && !base.IsValidAddress(opts.From) {
+			return validate.Usage("The {0} option ({1}) must {2}.", "--from", opts.From, "be a valid address")
+		}
+		if len(opts.From) == 0 && len(opts.Keystore) == 0 && (len(opts.Nonce) == 0 || opts.Gas == 0) {
+			return validate.Usage("Please provide {0} unless both {1} are provided.", "--from or --keystore", "--nonce and --gas")
+		}
+		for _, pair := range [][]string{{"--value", opts.Value}, {"--max_fee", opts.MaxFee}, {"--priority_fee", opts.PriorityFee}} {
+			if v, ok := new(big.Int).SetString(pair[1], 0); len(pair[1]) > 0 && (!ok || v.Sign() < 0) {
+				return validate.Usage("The {0} option ({1}) must {2}.", pair[0], pair[1], "be a non-negative amount in wei")
+			}
+		}
+		if _, err := strconv.ParseUint(opts.Nonce, 0, 64); len(opts.Nonce) > 0 && err != nil {
+			return validate.Usage("The {0} option ({1}) must {2}.", "--nonce", opts.Nonce, "be a non-negative integer")
+		}
+	}
+
+	isSigDb := len(opts.Lookup) > 0 || len(opts.Import) > 0 || opts.Export
+	if len(opts.Globals.File) == 0 && len(opts.Encode) == 0 && len(opts.Find) == 0 && !opts.Known && !opts.Globals.Decache && !isSigDb {
 		// If we're not find and not known we better have at least one address
 		err := validate.ValidateAtLeastOneAddr(opts.Addrs)
 		if err != nil {
@@ -5,8 +5,6 @@
 package blocksPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/decache"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -21,7 +19,7 @@ func (opts *BlocksOptions) HandleDecache() error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := opts.Globals.Context()
 	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
 		if msg, err := decache.Decache(opts.Conn, itemsToRemove, silent, opts.getCacheType()); err != nil {
 			errorChan <- err
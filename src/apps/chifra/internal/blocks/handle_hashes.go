@@ -21,7 +21,7 @@ func (opts *BlocksOptions) HandleHashes() error {
 	testMode := opts.Globals.TestMode
 	nErrors := 0
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawBlock], errorChan chan error) {
 		if sliceOfMaps, cnt, err := identifiers.AsSliceOfMaps[types.SimpleBlock[string]](chain, opts.BlockIds); err != nil {
 			errorChan <- err
@@ -57,7 +57,7 @@ func (opts *BlocksOptions) HandleHashes() error {
 				}
 
 				iterErrorChan := make(chan error)
-				iterCtx, iterCancel := context.WithCancel(context.Background())
+				iterCtx, iterCancel := context.WithCancel(ctx)
 				defer iterCancel()
 				go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 				for err := range iterErrorChan {
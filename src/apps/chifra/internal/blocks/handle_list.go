@@ -28,7 +28,7 @@ func (opts *BlocksOptions) HandleList() error {
 		end = 0
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawBlock], errorChan chan error) {
 		for bn := start; bn > end; bn-- {
 			block, err := opts.Conn.GetBlockHeaderByNumber(bn)
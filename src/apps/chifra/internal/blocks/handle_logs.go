@@ -36,8 +36,11 @@ func (opts *BlocksOptions) HandleLogs() error {
 		Emitters: emitters,
 		Topics:   topics,
 	}
+	if opts.EventFilter != nil {
+		logFilter.EventTopics = opts.EventFilter.Topics()
+	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawLog], errorChan chan error) {
 		if sliceOfMaps, cnt, err := identifiers.AsSliceOfMaps[types.SimpleTransaction](chain, opts.BlockIds); err != nil {
 			errorChan <- err
@@ -65,11 +68,16 @@ func (opts *BlocksOptions) HandleLogs() error {
 					}
 
 					bn := uint64(app.BlockNumber)
-					ts := opts.Conn.GetBlockTimestamp(bn)
-					if logs, err := opts.Conn.GetLogsByNumber(bn, ts); err != nil {
+					if logs, err := opts.getLogs(bn, logFilter); err != nil {
 						delete(thisMap, app)
 						return fmt.Errorf("block at %d returned an error: %w", bn, err)
 
+					} else if len(logs) == 0 && opts.EventFilter != nil {
+						// the node found no logs of the event in the block, which is not an error
+						delete(thisMap, app)
+						bar.Tick()
+						return nil
+
 					} else if len(logs) == 0 {
 						delete(thisMap, app)
 						return fmt.Errorf("block at %d has no logs", bn)
@@ -91,7 +99,7 @@ func (opts *BlocksOptions) HandleLogs() error {
 				}
 
 				iterErrorChan := make(chan error)
-				iterCtx, iterCancel := context.WithCancel(context.Background())
+				iterCtx, iterCancel := context.WithCancel(ctx)
 				defer iterCancel()
 				go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 				for err := range iterErrorChan {
@@ -121,6 +129,9 @@ func (opts *BlocksOptions) HandleLogs() error {
 					if !logFilter.PassesFilter(&item) {
 						continue
 					}
+					if opts.EventFilter != nil && !opts.EventFilter.Passes(&item) {
+						continue
+					}
 					modelChan <- &item
 				}
 			}
@@ -134,3 +145,16 @@ func (opts *BlocksOptions) HandleLogs() error {
 
 	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extra))
 }
+
+// getLogs returns the logs of the block. If an event is given, the node returns only the logs of that
+// event (whose indexed arguments match the event's conditions). Otherwise, the block's logs are returned
+// (from the cache if possible) and are filtered by the caller.
+func (opts *BlocksOptions) getLogs(bn base.Blknum, logFilter types.SimpleLogFilter) ([]types.SimpleLog, error) {
+	if opts.EventFilter == nil {
+		return opts.Conn.GetLogsByNumber(bn, opts.Conn.GetBlockTimestamp(bn))
+	}
+
+	logFilter.FromBlock = bn
+	logFilter.ToBlock = bn
+	return opts.Conn.GetLogsByFilter(logFilter)
+}
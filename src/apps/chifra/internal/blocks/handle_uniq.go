@@ -22,7 +22,7 @@ func (opts *BlocksOptions) HandleUniq() error {
 	testMode := opts.Globals.TestMode
 	nErrors := 0
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawAppearance], errorChan chan error) {
 		if sliceOfMaps, cnt, err := identifiers.AsSliceOfMaps[types.SimpleAppearance](chain, opts.BlockIds); err != nil {
 			errorChan <- err
@@ -61,7 +61,7 @@ func (opts *BlocksOptions) HandleUniq() error {
 				}
 
 				iterErrorChan := make(chan error)
-				iterCtx, iterCancel := context.WithCancel(context.Background())
+				iterCtx, iterCancel := context.WithCancel(ctx)
 				defer iterCancel()
 				go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 				for err := range iterErrorChan {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/globals"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/caps"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/identifiers"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
@@ -32,6 +33,8 @@ type BlocksOptions struct {
 	Logs        bool                     `json:"logs,omitempty"`        // Display only the logs found in the block(s)
 	Emitter     []string                 `json:"emitter,omitempty"`     // For the --logs option only, filter logs to show only those logs emitted by the given address(es)
 	Topic       []string                 `json:"topic,omitempty"`       // For the --logs option only, filter logs to show only those with this topic(s)
+	Event       string                   `json:"event,omitempty"`       // For the --logs option only, filter logs to show only those emitted by this event (for example, Transfer(address indexed from, address indexed to, uint256 value))
+	Where       []string                 `json:"where,omitempty"`       // For the --event option only, filter logs to show only those whose arguments match these conditions (for example, to=0x... or value>1e18)
 	Withdrawals bool                     `json:"withdrawals,omitempty"` // Export the withdrawals from the block as opposed to the block data
 	Articulate  bool                     `json:"articulate,omitempty"`  // For the --logs option only, articulate the retrieved data if ABIs can be found
 	BigRange    uint64                   `json:"bigRange,omitempty"`    // For the --logs option only, allow for block ranges larger than 500
@@ -44,6 +47,7 @@ type BlocksOptions struct {
 	Conn        *rpc.Connection          `json:"conn,omitempty"`        // The connection to the RPC server
 	BadFlag     error                    `json:"badFlag,omitempty"`     // An error flag if needed
 	// EXISTING_CODE
+	EventFilter *filter.EventFilter `json:"-"`
 	// EXISTING_CODE
 }
 
@@ -62,6 +66,8 @@ func (opts *BlocksOptions) testLog() {
 	logger.TestLog(opts.Logs, "Logs: ", opts.Logs)
 	logger.TestLog(len(opts.Emitter) > 0, "Emitter: ", opts.Emitter)
 	logger.TestLog(len(opts.Topic) > 0, "Topic: ", opts.Topic)
+	logger.TestLog(len(opts.Event) > 0, "Event: ", opts.Event)
+	logger.TestLog(len(opts.Where) > 0, "Where: ", opts.Where)
 	logger.TestLog(opts.Withdrawals, "Withdrawals: ", opts.Withdrawals)
 	logger.TestLog(opts.Articulate, "Articulate: ", opts.Articulate)
 	logger.TestLog(opts.BigRange != 500, "BigRange: ", opts.BigRange)
@@ -116,6 +122,13 @@ func blocksFinishParseApi(w http.ResponseWriter, r *http.Request) *BlocksOptions
 				s := strings.Split(val, " ") // may contain space separated items
 				opts.Topic = append(opts.Topic, s...)
 			}
+		case "event":
+			opts.Event = value[0]
+		case "where":
+			for _, val := range value {
+				s := strings.Split(val, " ") // may contain space separated items
+				opts.Where = append(opts.Where, s...)
+			}
 		case "withdrawals":
 			opts.Withdrawals = true
 		case "articulate":
@@ -141,6 +154,8 @@ func blocksFinishParseApi(w http.ResponseWriter, r *http.Request) *BlocksOptions
 	opts.Conn = opts.Globals.FinishParseApi(w, r, opts.getCaches())
 
 	// EXISTING_CODE
+	// the API splits list options on spaces, which splits conditions such as `value > 1e18`
+	opts.Where = filter.RejoinConditions(opts.Where)
 	// EXISTING_CODE
 	opts.Emitter, _ = opts.Conn.GetEnsAddresses(opts.Emitter)
 
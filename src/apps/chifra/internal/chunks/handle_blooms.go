@@ -28,10 +28,6 @@ func (opts *ChunksOptions) HandleBlooms(blockNums []uint64) error {
 
 			var bl index.Bloom
 			_ = bl.Read(path)
-			nInserted := 0
-			for _, bl := range bl.Blooms {
-				nInserted += int(bl.NInserted)
-			}
 
 			if opts.Globals.Verbose {
 				displayBloom(&bl, 1)
@@ -48,8 +44,11 @@ func (opts *ChunksOptions) HandleBlooms(blockNums []uint64) error {
 				Size:      stats.BloomSz,
 				Range:     base.RangeFromFilename(path).String(),
 				NBlooms:   stats.NBlooms,
-				ByteWidth: index.BLOOM_WIDTH_IN_BYTES,
-				NInserted: uint64(nInserted),
+				ByteWidth: bl.WidthInBytes(),
+				NInserted: bl.NInserted(),
+				Format:    bl.Params.Format.String(),
+				NHashes:   uint64(bl.Params.NHashes),
+				FpRate:    bl.MeasureFalsePositiveRate(nFalsePositiveTests),
 			}
 
 			modelChan <- &s
@@ -71,22 +70,21 @@ func (opts *ChunksOptions) HandleBlooms(blockNums []uint64) error {
 	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
 }
 
+// nFalsePositiveTests is the number of random addresses tested against each bloom to measure its false positive rate
+const nFalsePositiveTests = 10000
+
 func displayBloom(bl *index.Bloom, verbose int) {
 	var bytesPerLine = (2048 / 16) /* 128 */
 	if verbose > 0 && verbose <= 4 {
 		bytesPerLine = 32
 	}
 
-	nInserted := uint32(0)
-	for i := uint32(0); i < bl.Count; i++ {
-		nInserted += bl.Blooms[i].NInserted
-	}
 	fmt.Println("range:", bl.Range)
 	fmt.Println("nBlooms:", bl.Count)
-	fmt.Println("byteWidth:", index.BLOOM_WIDTH_IN_BYTES)
-	fmt.Println("nInserted:", nInserted)
+	fmt.Println("byteWidth:", bl.WidthInBytes())
+	fmt.Println("nInserted:", bl.NInserted())
 	if verbose > 0 {
-		for i := uint32(0); i < bl.Count; i++ {
+		for i := range bl.Blooms {
 			for j := 0; j < len(bl.Blooms[i].Bytes); j++ {
 				if (j % bytesPerLine) == 0 {
 					if j != 0 {
@@ -0,0 +1,169 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package chunksPkg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/manifest"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/progress"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
+)
+
+// HandleManifestDiff compares the local manifest to the manifest published by the publisher
+// chunk by chunk. With --sync, it downloads only the differing chunks and rewrites the local
+// manifest.
+func (opts *ChunksOptions) HandleManifestDiff(blockNums []uint64) error {
+	chain := opts.Globals.Chain
+
+	local, err := manifest.ReadManifest(chain, opts.PublisherAddr, manifest.LocalCache)
+	if err != nil {
+		return err
+	}
+
+	remote, err := manifest.ReadManifest(chain, opts.PublisherAddr, manifest.TempContract)
+	if err != nil {
+		return err
+	}
+
+	diffs := filterDiffs(local.Diff(remote), blockNums)
+
+	if opts.Sync {
+		if err := opts.syncManifest(local, remote, diffs); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for i := range diffs {
+			s := newSimpleChunkDiff(&diffs[i])
+			modelChan <- &s
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// filterDiffs returns only those differences whose range intersects one of the given blocks. If
+// no blocks are given, all differences are returned.
+func filterDiffs(diffs []manifest.ChunkDiff, blockNums []uint64) []manifest.ChunkDiff {
+	if len(blockNums) == 0 {
+		return diffs
+	}
+
+	ret := []manifest.ChunkDiff{}
+	for _, diff := range diffs {
+		rng := base.RangeFromRangeString(diff.Range)
+		for _, bn := range blockNums {
+			if rng.IntersectsB(bn) {
+				ret = append(ret, diff)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// syncManifest downloads the differing remote chunks alongside the local chunks and, only once
+// every download succeeded, removes the local chunks that do not survive reconciliation with the
+// remote manifest, puts the downloaded chunks in place, and rewrites the local manifest.
+func (opts *ChunksOptions) syncManifest(local, remote *manifest.Manifest, diffs []manifest.ChunkDiff) error {
+	chain := opts.Globals.Chain
+
+	toDownload := []types.SimpleChunkRecord{}
+	for _, diff := range diffs {
+		if diff.NeedsDownload() {
+			toDownload = append(toDownload, *diff.Remote)
+		}
+	}
+
+	if len(toDownload) > 0 {
+		logger.Info("Downloading", len(toDownload), "differing chunks")
+		for _, chunkType := range []walk.CacheType{walk.Index_Bloom, walk.Index_Final} {
+			if err := downloadChunks(chain, toDownload, chunkType); err != nil {
+				index.RemovePendingChunks(chain, toDownload)
+				return err
+			}
+		}
+	}
+
+	merged, removed := local.Reconcile(remote)
+	for _, chunk := range removed {
+		indexPath := config.PathToIndex(chain) + "finalized/" + chunk.Range + ".bin"
+		for _, path := range []string{index.ToIndexPath(indexPath), index.ToBloomPath(indexPath)} {
+			if file.FileExists(path) {
+				if err := os.Remove(path); err != nil {
+					index.RemovePendingChunks(chain, toDownload)
+					return err
+				}
+			}
+		}
+		logger.Info("Removed local chunk", chunk.Range)
+	}
+
+	if err := index.CommitPendingChunks(chain, toDownload); err != nil {
+		return err
+	}
+
+	if err := merged.SaveManifest(chain, config.PathToManifest(chain)); err != nil {
+		return err
+	}
+
+	if len(removed)+len(toDownload) > 0 {
+		if err := index.RemovePostingLists(chain); err != nil {
+			return err
+		}
+		logger.Warn("The on-disk index has changed. You must invalidate your monitor cache by removing it.")
+		logger.Warn("Posting lists for hot addresses were removed. Rebuild them with chifra chunks postings.")
+	}
+
+	return nil
+}
+
+// downloadChunks downloads the given chunks of the given type alongside the local chunks (see
+// index.DownloadPendingChunks) and reports progress. It returns an error if any of the chunks
+// failed to download.
+func downloadChunks(chain string, chunks []types.SimpleChunkRecord, chunkType walk.CacheType) error {
+	progressChannel := progress.MakeChan()
+	defer close(progressChannel)
+
+	go index.DownloadPendingChunks(chain, chunks, chunkType, runtime.NumCPU()*2, progressChannel)
+
+	nFailed := 0
+	for event := range progressChannel {
+		chunk, ok := event.Payload.(*types.SimpleChunkRecord)
+		switch event.Event {
+		case progress.Cancelled:
+			return fmt.Errorf("download of %s files was cancelled", chunkType)
+		case progress.AllDone:
+			if nFailed > 0 {
+				return fmt.Errorf("%d %s files failed to download", nFailed, chunkType)
+			}
+			return nil
+		case progress.Error:
+			logger.Error(event.Error)
+			if ok {
+				nFailed++
+			}
+		case progress.Finished:
+			if ok {
+				logger.Info("Finished download of", chunkType, chunk.Range)
+			}
+		}
+	}
+
+	return nil
+}
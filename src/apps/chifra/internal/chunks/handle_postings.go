@@ -0,0 +1,41 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package chunksPkg
+
+import (
+	"context"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandlePostings builds (or brings up to date) the posting lists for the chain's hot addresses and
+// reports on each of them.
+func (opts *ChunksOptions) HandlePostings(blockNums []uint64) error {
+	chain := opts.Globals.Chain
+	ctx, cancel := context.WithCancel(context.Background())
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		lists, err := index.BuildPostingLists(chain, index.HotAddresses(chain))
+		if err != nil {
+			errorChan <- err
+			cancel()
+			return
+		}
+
+		for _, pl := range lists {
+			s := simpleChunkPosting{
+				Address:      pl.Address,
+				NextBlock:    pl.NextBlock,
+				NAppearances: uint64(len(pl.Appearances)),
+				FileSize:     uint64(file.FileSize(index.ToPostingPath(chain, pl.Address))),
+			}
+			modelChan <- &s
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
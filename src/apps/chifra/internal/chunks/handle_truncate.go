@@ -133,6 +133,11 @@ func (opts *ChunksOptions) HandleTruncate(blockNums []uint64) error {
 			bar.Prefix = fmt.Sprintf("Truncated monitors to %d                                        ", opts.Truncate)
 			bar.Finish(true /* newLine */)
 
+			// The posting lists of hot addresses must also end where the truncated index ends.
+			if err := index.TruncatePostingLists(chain, latestChunk+1); err != nil {
+				errorChan <- err
+			}
+
 			// All that's left to do is report on what happened.
 			fin := "."
 			if nChunksRemoved > 0 {
@@ -35,7 +35,8 @@ type ChunksOptions struct {
 	Truncate   uint64                   `json:"truncate,omitempty"`   // Truncate the entire index at this block (requires a block identifier)
 	Remote     bool                     `json:"remote,omitempty"`     // Prior to processing, retreive the manifest from the Unchained Index smart contract
 	Belongs    []string                 `json:"belongs,omitempty"`    // In index mode only, checks the address(es) for inclusion in the given index chunk
-	Diff       bool                     `json:"diff,omitempty"`       // Compare two index portions (see notes)
+	Diff       bool                     `json:"diff,omitempty"`       // Compare two index portions or, in manifest mode, the local and remote manifests (see notes)
+	Sync       bool                     `json:"sync,omitempty"`       // For manifest --diff mode only, download the differing chunks and rewrite the local manifest
 	FirstBlock uint64                   `json:"firstBlock,omitempty"` // First block to process (inclusive)
 	LastBlock  uint64                   `json:"lastBlock,omitempty"`  // Last block to process (inclusive)
 	MaxAddrs   uint64                   `json:"maxAddrs,omitempty"`   // The max number of addresses to process in a given chunk
@@ -72,6 +73,7 @@ func (opts *ChunksOptions) testLog() {
 	logger.TestLog(opts.Remote, "Remote: ", opts.Remote)
 	logger.TestLog(len(opts.Belongs) > 0, "Belongs: ", opts.Belongs)
 	logger.TestLog(opts.Diff, "Diff: ", opts.Diff)
+	logger.TestLog(opts.Sync, "Sync: ", opts.Sync)
 	logger.TestLog(opts.FirstBlock != 0, "FirstBlock: ", opts.FirstBlock)
 	logger.TestLog(opts.LastBlock != 0 && opts.LastBlock != utils.NOPOS, "LastBlock: ", opts.LastBlock)
 	logger.TestLog(opts.MaxAddrs != utils.NOPOS, "MaxAddrs: ", opts.MaxAddrs)
@@ -129,6 +131,8 @@ func chunksFinishParseApi(w http.ResponseWriter, r *http.Request) *ChunksOptions
 			}
 		case "diff":
 			opts.Diff = true
+		case "sync":
+			opts.Sync = true
 		case "firstBlock":
 			opts.FirstBlock = globals.ToUint64(value[0])
 		case "lastBlock":
@@ -79,6 +79,9 @@ func (opts *ChunksOptions) ChunksInternal() error {
 	} else if len(opts.Tag) > 0 {
 		err = opts.HandleTag(blockNums)
 
+	} else if opts.Diff && opts.Mode == "manifest" {
+		err = opts.HandleManifestDiff(blockNums)
+
 	} else if opts.Diff {
 		err = opts.HandleDiff(blockNums)
 
@@ -111,6 +114,9 @@ func (opts *ChunksOptions) ChunksInternal() error {
 		case "stats":
 			err = opts.HandleStats(blockNums)
 
+		case "postings":
+			err = opts.HandlePostings(blockNums)
+
 		default:
 			logger.Fatal("should not happen ==> in NamesInternal")
 		}
@@ -18,9 +18,12 @@ import (
 
 type simpleChunkBloom struct {
 	ByteWidth uint64    `json:"byteWidth"`
+	Format    string    `json:"format"`
+	FpRate    float64   `json:"fpRate"`
 	Hash      base.Hash `json:"hash"`
 	Magic     string    `json:"magic"`
 	NBlooms   uint64    `json:"nBlooms"`
+	NHashes   uint64    `json:"nHashes"`
 	NInserted uint64    `json:"nInserted"`
 	Range     string    `json:"range"`
 	Size      uint64    `json:"size"`
@@ -45,6 +48,9 @@ func (s *simpleChunkBloom) Model(chain, format string, verbose bool, extraOption
 		"nInserted": s.NInserted,
 		"size":      s.Size,
 		"byteWidth": s.ByteWidth,
+		"format":    s.Format,
+		"nHashes":   s.NHashes,
+		"fpRate":    s.FpRate,
 	}
 	order = []string{
 		"range",
@@ -54,6 +60,9 @@ func (s *simpleChunkBloom) Model(chain, format string, verbose bool, extraOption
 		"nInserted",
 		"size",
 		"byteWidth",
+		"format",
+		"nHashes",
+		"fpRate",
 	}
 	// EXISTING_CODE
 
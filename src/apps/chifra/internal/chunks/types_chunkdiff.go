@@ -0,0 +1,81 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package chunksPkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/manifest"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// EXISTING_CODE
+
+type simpleChunkDiff struct {
+	LocalBloomHash  base.IpfsHash `json:"localBloomHash"`
+	LocalIndexHash  base.IpfsHash `json:"localIndexHash"`
+	Range           string        `json:"range"`
+	Reason          string        `json:"reason"`
+	RemoteBloomHash base.IpfsHash `json:"remoteBloomHash"`
+	RemoteIndexHash base.IpfsHash `json:"remoteIndexHash"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleChunkDiff) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleChunkDiff) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"range":           s.Range,
+		"reason":          s.Reason,
+		"localBloomHash":  s.LocalBloomHash,
+		"remoteBloomHash": s.RemoteBloomHash,
+		"localIndexHash":  s.LocalIndexHash,
+		"remoteIndexHash": s.RemoteIndexHash,
+	}
+	order = []string{
+		"range",
+		"reason",
+		"localBloomHash",
+		"remoteBloomHash",
+		"localIndexHash",
+		"remoteIndexHash",
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+func newSimpleChunkDiff(diff *manifest.ChunkDiff) simpleChunkDiff {
+	s := simpleChunkDiff{
+		Range:  diff.Range,
+		Reason: string(diff.Reason),
+	}
+	if diff.Local != nil {
+		s.LocalBloomHash = diff.Local.BloomHash
+		s.LocalIndexHash = diff.Local.IndexHash
+	}
+	if diff.Remote != nil {
+		s.RemoteBloomHash = diff.Remote.BloomHash
+		s.RemoteIndexHash = diff.Remote.IndexHash
+	}
+	return s
+}
+
+// EXISTING_CODE
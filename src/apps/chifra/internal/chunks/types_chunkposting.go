@@ -0,0 +1,58 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package chunksPkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// EXISTING_CODE
+
+type simpleChunkPosting struct {
+	Address      base.Address `json:"address"`
+	FileSize     uint64       `json:"fileSize"`
+	NAppearances uint64       `json:"nAppearances"`
+	NextBlock    base.Blknum  `json:"nextBlock"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleChunkPosting) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleChunkPosting) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"address":      s.Address,
+		"nextBlock":    s.NextBlock,
+		"nAppearances": s.NAppearances,
+		"fileSize":     s.FileSize,
+	}
+	order = []string{
+		"address",
+		"nextBlock",
+		"nAppearances",
+		"fileSize",
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
@@ -104,7 +104,7 @@ func GetChunkStats(chain, path string) (s simpleChunkStats, err error) {
 		NBlooms:  uint64(chunk.Bloom.Count),
 		BloomSz:  uint64(file.FileSize(index.ToBloomPath(path))),
 		ChunkSz:  uint64(file.FileSize(index.ToIndexPath(path))),
-		RecWid:   4 + index.BLOOM_WIDTH_IN_BYTES,
+		RecWid:   4 + chunk.Bloom.WidthInBytes(),
 	}
 
 	if s.NBlocks > 0 {
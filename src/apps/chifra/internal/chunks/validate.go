@@ -38,6 +38,9 @@ func (opts *ChunksOptions) validateChunks() error {
 		if opts.Mode == "pins" {
 			return validate.Usage("The {0} mode is not available {1}.", "pins", "in api mode")
 		}
+		if opts.Mode == "postings" {
+			return validate.Usage("The {0} mode is not available {1}.", "postings", "in api mode")
+		}
 	} else if len(opts.Tag) > 0 {
 		if !version.IsValidVersion(opts.Tag) {
 			return validate.Usage("The {0} ({1}) must be a valid version string.", "--tag", opts.Tag)
@@ -80,17 +83,30 @@ func (opts *ChunksOptions) validateChunks() error {
 	}
 
 	if len(opts.Mode) == 0 {
-		return validate.Usage("Please choose at least one of {0}.", "[manifest|index|blooms|pins|addresses|appearances|stats]")
+		return validate.Usage("Please choose at least one of {0}.", "[manifest|index|blooms|pins|addresses|appearances|stats|postings]")
 	}
 
-	err := validate.ValidateEnum("mode", opts.Mode, "[manifest|index|blooms|pins|addresses|appearances|stats]")
+	err := validate.ValidateEnum("mode", opts.Mode, "[manifest|index|blooms|pins|addresses|appearances|stats|postings]")
 	if err != nil {
 		return err
 	}
 
-	if opts.Diff {
+	if opts.Mode == "postings" && len(index.HotAddresses(chain)) == 0 {
+		return validate.Usage("The {0} mode requires {1}.", "postings", "at least one address in "+index.HotAddressesPath(chain))
+	}
+
+	if opts.Sync && (!opts.Diff || opts.Mode != "manifest") {
+		return validate.Usage("The {0} option requires {1}.", "--sync", "manifest mode and --diff")
+	}
+
+	if opts.Sync && len(opts.Blocks) > 0 {
+		// reconciliation removes local chunks and rewrites the whole manifest, so it cannot be limited to blocks
+		return validate.Usage("The {0} option is not available{1}.", "--sync", " with block identifiers")
+	}
+
+	if opts.Diff && opts.Mode != "manifest" {
 		if opts.Mode != "index" {
-			return validate.Usage("The {0} option is only available in {1} mode.", "--diff", "index")
+			return validate.Usage("The {0} option is only available in {1} mode.", "--diff", "index or manifest")
 		}
 		path := os.Getenv("TB_CHUNKS_DIFFPATH")
 		if path == "" {
@@ -218,7 +234,7 @@ func (opts *ChunksOptions) validateChunks() error {
 		return err
 	}
 
-	if opts.Diff && len(opts.BlockIds) != 1 {
+	if opts.Diff && opts.Mode == "index" && len(opts.BlockIds) != 1 {
 		return validate.Usage("The {0} option requires exactly one block identifier.", "--diff")
 	}
 
@@ -266,6 +282,9 @@ func (opts *ChunksOptions) isDisallowed(test bool, mode string) error {
 		if opts.Truncate != utils.NOPOS {
 			return validate.Usage("The {0} option is not available in {1} mode.", "--truncate", mode)
 		}
+		if opts.Sync {
+			return validate.Usage("The {0} option is not available in {1} mode.", "--sync", mode)
+		}
 	}
 	return nil
 }
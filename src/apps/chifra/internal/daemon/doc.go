@@ -6,5 +6,5 @@
  * the code inside of 'EXISTING_CODE' tags.
  */
 
-// Package daemonPkg handles the chifra daemon command. It  manages chifra's API server. Each of the chifra commands along with all of its options, are provided not only by the command line, but also the API server. We call this process the flame server, which is written in Go. chifra serve is an alias for the  command. In the future, this daemon may also manage other long-running processes such as chifra scrape and chifra monitors, but for now, it's only managing the API server. The --grpc option turns on a GRPC server that may speed up certain command such as chifra names, although this option is experimental and therefore not recommended for production use. If the default port for the API server is in use, you may change it with the --port option. To get help for any command, please see the API documentation on our website. But, you may also run chifra --help or chifra <cmd> --help on your command line to get help. See below for an example of converting command line options to a call to the API. There's a one-to-one correspondence between the command line tools and options and the API routes and their options. 
+// Package daemonPkg handles the chifra daemon command. It  manages chifra's API server. Each of the chifra commands along with all of its options, are provided not only by the command line, but also the API server. We call this process the flame server, which is written in Go. chifra serve is an alias for the  command. The daemon may also manage the scraper (with --scrape) and the monitor watcher (with --monitor). Each runs as a separate process that the daemon restarts, waiting longer after each failure, if it exits. --scrape blooms or --scrape index first runs chifra init (with --all for index). The monitor watcher runs chifra monitors --watch with the monitorCommands, monitorWatchlist (default existing), and monitorSleep settings from the [server] group of trueBlocks.toml. GET /services reports on these services, and POST /services/{name}/start or POST /services/{name}/stop starts or stops them. When the daemon is interrupted or terminated (SIGTERM), it stops accepting requests and asks the services to finish cleanly before it exits. The --grpc option turns on a GRPC server that may speed up certain command such as chifra names, although this option is experimental and therefore not recommended for production use. The gRPC server also provides a Chifra service (see proto/chifra.proto) that streams the output of export, list, blocks, transactions, logs, traces, state, tokens, and when as typed messages. Each request carries the same options as the command, and each message carries the same fields as its JSON output. Integers are sent as strings (as protobuf does for 64-bit integers in JSON), so values such as wei amounts keep their precision and each field always has the same type. If indexReader is set in the [settings] group of trueBlocks.toml (to file, memory, or mmap), the API server keeps the most recently used index chunks open in a cache shared by all requests, so that repeated calls to /list and /export do not re-open and re-read the same files. memory reads each chunk into memory and mmap memory-maps it. indexCacheSize sets the number of chunks kept open (default 64). The API server is open to anyone who can reach it unless you configure it otherwise in the [server] group of trueBlocks.toml. If any keys are listed under [server.apiKeys], every request must carry one (as Authorization: Bearer <key> or in the X-API-Key header). Keys with scope = "read" (the default) may not call /scrape or /init, start or stop services, change names or monitors, edit the configuration, or --decache. Keys with scope = "admin" may do anything. rateLimit and burst limit each client's requests per second (a key may set its own rateLimit), and routeLimits limits individual routes. If tlsCert and tlsKey are set, the server is served over TLS. Routes that produce records (such as /export, /list, and /blocks) return a single JSON document by default. If the request's Accept header is application/x-ndjson, each record is instead sent as a line of JSON as soon as it is produced, and if it is text/event-stream, each record is sent as a Server-Sent Event (model, error, and a final done event carrying the count). Every response carries an X-Request-Id header (the client's, if it sends one). While a streaming request runs, its progress is broadcast to the websocket clients as progress messages with the request's id. If the client disconnects, the request is canceled. If the default port for the API server is in use, you may change it with the --port option. To get help for any command, please see the API documentation on our website. But, you may also run chifra --help or chifra <cmd> --help on your command line to get help. See below for an example of converting command line options to a call to the API. There's a one-to-one correspondence between the command line tools and options and the API routes and their options. 
 package daemonPkg
@@ -0,0 +1,223 @@
+package daemonPkg
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"golang.org/x/time/rate"
+)
+
+var (
+	errNoApiKey        = errors.New("an API key is required (as a bearer token or in the X-API-Key header)")
+	errInvalidApiKey   = errors.New("the API key is not valid")
+	errNotAllowed      = errors.New("the API key's scope does not allow this request")
+	errTooManyRequests = errors.New(http.StatusText(http.StatusTooManyRequests))
+)
+
+// limiterSweep is how often the limiters of clients that have gone quiet are dropped
+const limiterSweep = time.Minute
+
+// adminOptions lists, for each route, the options that change something (the index, the caches, the names
+// database, the monitors, or the configuration) and therefore require an admin key. A nil list means
+// the route requires an admin key whatever its options. Note that decache requires an admin key on
+// every route.
+var adminOptions = map[string][]string{
+	"/scrape":   nil,
+	"/init":     nil,
+	"/names":    {"create", "update", "delete", "undelete", "remove", "autoname", "clean", "rollback", "import"},
+	"/monitors": {"delete", "undelete", "remove", "clean"},
+	"/config":   {},
+	"/chunks":   {"pin", "publish", "truncate", "rewrite", "unpin", "tag"},
+	"/when":     {"truncate", "repair", "update"},
+
+	"/services/{name}/start": nil,
+	"/services/{name}/stop":  nil,
+}
+
+// authorizer authenticates the API server's clients, enforces the scopes of their keys, and limits the
+// rate of their requests
+type authorizer struct {
+	keys        []config.ApiKey
+	names       []string
+	limit       rate.Limit
+	burst       int
+	routeLimits map[string]rate.Limit
+	limiters    map[string]*rate.Limiter
+	lastSweep   time.Time
+	mutex       sync.Mutex
+}
+
+func newAuthorizer(server config.ServerSettings) *authorizer {
+	a := &authorizer{
+		limit:       toLimit(server.RateLimit),
+		burst:       int(server.Burst),
+		routeLimits: make(map[string]rate.Limit, len(server.RouteLimits)),
+		limiters:    map[string]*rate.Limiter{},
+	}
+	for name, key := range server.ApiKeys {
+		a.names = append(a.names, name)
+		a.keys = append(a.keys, key)
+	}
+	for route, limit := range server.RouteLimits {
+		a.routeLimits["/"+strings.Trim(strings.ToLower(route), "/")] = toLimit(limit)
+	}
+	return a
+}
+
+// toLimit converts a configured rate (where zero means no limit) to a limit
+func toLimit(perSecond float64) rate.Limit {
+	if perSecond <= 0 {
+		return rate.Inf
+	}
+	return rate.Limit(perSecond)
+}
+
+// Handler wraps the route's handler, rejecting requests without a valid key (if keys are configured),
+// requests the key's scope does not allow, and requests over the client's rate limits
+func (a *authorizer) Handler(inner http.Handler, route Route) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, limit := clientAddress(r), a.limit
+		if len(a.keys) > 0 {
+			key, name, err := a.authenticate(r)
+			if err != nil {
+				RespondWithError(w, http.StatusUnauthorized, err)
+				return
+			}
+			if requiresAdmin(route, r) && key.Scope != config.ScopeAdmin {
+				RespondWithError(w, http.StatusForbidden, errNotAllowed)
+				return
+			}
+			client = "key:" + name
+			if key.RateLimit > 0 {
+				limit = toLimit(key.RateLimit)
+			}
+		}
+
+		if !a.allow(client, limit) || !a.allow(client+route.Pattern, a.routeLimits[route.Pattern]) {
+			w.Header().Set("Retry-After", "1")
+			RespondWithError(w, http.StatusTooManyRequests, errTooManyRequests)
+			return
+		}
+
+		inner.ServeHTTP(w, r)
+	})
+}
+
+// authenticate returns the key (and its name) presented by the request
+func (a *authorizer) authenticate(r *http.Request) (config.ApiKey, string, error) {
+	presented := r.Header.Get("X-API-Key")
+	if auth := r.Header.Get("Authorization"); len(auth) > 0 {
+		if !strings.HasPrefix(auth, "Bearer ") {
+			return config.ApiKey{}, "", errInvalidApiKey
+		}
+		presented = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if len(presented) == 0 {
+		return config.ApiKey{}, "", errNoApiKey
+	}
+
+	for i, key := range a.keys {
+		if len(key.Key) > 0 && subtle.ConstantTimeCompare([]byte(presented), []byte(key.Key)) == 1 {
+			return key, a.names[i], nil
+		}
+	}
+	return config.ApiKey{}, "", errInvalidApiKey
+}
+
+// allow returns true if the client may make another request under the limit. Limits are tracked
+// separately for each client (and route).
+func (a *authorizer) allow(client string, limit rate.Limit) bool {
+	if limit == rate.Inf || limit == 0 {
+		return true
+	}
+
+	a.mutex.Lock()
+	if now := time.Now(); now.Sub(a.lastSweep) >= limiterSweep {
+		a.sweep(now)
+	}
+	limiter, ok := a.limiters[client]
+	if !ok {
+		burst := a.burst
+		if burst == 0 {
+			burst = int(math.Max(1, math.Ceil(float64(limit))))
+		}
+		limiter = rate.NewLimiter(limit, burst)
+		a.limiters[client] = limiter
+	}
+	a.mutex.Unlock()
+
+	return limiter.Allow()
+}
+
+// sweep drops the limiters whose buckets have refilled by now. A full limiter behaves exactly like a new
+// one, so forgetting it loses nothing, and the map only holds the clients that made requests recently.
+// The caller holds the mutex.
+func (a *authorizer) sweep(now time.Time) {
+	for client, limiter := range a.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(a.limiters, client)
+		}
+	}
+	a.lastSweep = now
+}
+
+// requiresAdmin returns true if the request changes something on the server
+func requiresAdmin(route Route, r *http.Request) bool {
+	query := r.URL.Query()
+	if query.Has("decache") {
+		return true
+	}
+	if route.Pattern == "/names" && route.Method != "GET" {
+		return true
+	}
+	if route.Pattern == "/monitors" && route.Method == "DELETE" {
+		return true
+	}
+
+	options, ok := adminOptions[route.Pattern]
+	if !ok {
+		return false
+	} else if options == nil {
+		return true
+	}
+	for _, option := range options {
+		if query.Has(option) {
+			return true
+		}
+	}
+	// config's mode is positional, so it arrives as a value rather than an option
+	return route.Pattern == "/config" && query.Get("mode") == "edit"
+}
+
+// clientAddress returns the remote address of the request (without the port)
+func clientAddress(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// validateServer reports configurations of the API server that would leave it unusable or insecure
+func validateServer(server config.ServerSettings) error {
+	for name, key := range server.ApiKeys {
+		if len(key.Key) == 0 {
+			return fmt.Errorf("the API key %s has no key", name)
+		}
+		if len(key.Scope) > 0 && key.Scope != config.ScopeRead && key.Scope != config.ScopeAdmin {
+			return fmt.Errorf("the API key %s has an invalid scope (%s); use %s or %s", name, key.Scope, config.ScopeRead, config.ScopeAdmin)
+		}
+	}
+	if (len(server.TlsCert) > 0) != (len(server.TlsKey) > 0) {
+		return fmt.Errorf("both tlsCert and tlsKey must be set to serve TLS")
+	}
+	return nil
+}
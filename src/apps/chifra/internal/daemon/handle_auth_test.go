@@ -0,0 +1,115 @@
+package daemonPkg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+func TestAuthorizer(t *testing.T) {
+	auth := newAuthorizer(config.ServerSettings{
+		ApiKeys: map[string]config.ApiKey{
+			"reader": {Key: "read-key", Scope: config.ScopeRead},
+			"admin":  {Key: "admin-key", Scope: config.ScopeAdmin},
+		},
+	})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		route    Route
+		url      string
+		header   string
+		value    string
+		expected int
+	}{
+		{Route{"RouteBlocks", "GET", "/blocks", nil}, "/blocks?blocks=1", "", "", http.StatusUnauthorized},
+		{Route{"RouteBlocks", "GET", "/blocks", nil}, "/blocks?blocks=1", "X-API-Key", "wrong", http.StatusUnauthorized},
+		{Route{"RouteBlocks", "GET", "/blocks", nil}, "/blocks?blocks=1", "X-API-Key", "read-key", http.StatusOK},
+		{Route{"RouteBlocks", "GET", "/blocks", nil}, "/blocks?blocks=1", "Authorization", "Bearer read-key", http.StatusOK},
+		{Route{"RouteBlocks", "GET", "/blocks", nil}, "/blocks?blocks=1", "Authorization", "Basic read-key", http.StatusUnauthorized},
+		{Route{"RouteBlocks", "GET", "/blocks", nil}, "/blocks?blocks=1&decache", "X-API-Key", "read-key", http.StatusForbidden},
+		{Route{"RouteScrape", "GET", "/scrape", nil}, "/scrape", "X-API-Key", "read-key", http.StatusForbidden},
+		{Route{"RouteScrape", "GET", "/scrape", nil}, "/scrape", "X-API-Key", "admin-key", http.StatusOK},
+		{Route{"RouteNames", "GET", "/names", nil}, "/names?terms=trueblocks", "X-API-Key", "read-key", http.StatusOK},
+		{Route{"RouteNames", "GET", "/names", nil}, "/names?terms=0x1&delete", "X-API-Key", "read-key", http.StatusForbidden},
+		{Route{"CreateName", "POST", "/names", nil}, "/names", "X-API-Key", "read-key", http.StatusForbidden},
+		{Route{"RouteConfig", "GET", "/config", nil}, "/config?mode=show", "X-API-Key", "read-key", http.StatusOK},
+		{Route{"RouteConfig", "GET", "/config", nil}, "/config?mode=edit", "X-API-Key", "read-key", http.StatusForbidden},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(test.route.Method, test.url, nil)
+		if len(test.header) > 0 {
+			r.Header.Set(test.header, test.value)
+		}
+		w := httptest.NewRecorder()
+		auth.Handler(ok, test.route).ServeHTTP(w, r)
+		if w.Code != test.expected {
+			t.Error(test.route.Method, test.url, test.value, "expected", test.expected, "got", w.Code)
+		}
+	}
+}
+
+func TestAuthorizerRateLimits(t *testing.T) {
+	auth := newAuthorizer(config.ServerSettings{
+		RateLimit: 100,
+		Burst:     2,
+	})
+	blocks := Route{"RouteBlocks", "GET", "/blocks", nil}
+	slurp := Route{"RouteSlurp", "GET", "/slurp", nil}
+
+	call := func(route Route, remote string) int {
+		return callWith(auth, route, remote)
+	}
+
+	codes := []int{call(blocks, "1.1.1.1:1"), call(blocks, "1.1.1.1:2"), call(blocks, "1.1.1.1:3")}
+	if codes[0] != http.StatusOK || codes[1] != http.StatusOK || codes[2] != http.StatusTooManyRequests {
+		t.Error("expected the burst to be exhausted on the third call, got", codes)
+	}
+	if code := call(blocks, "2.2.2.2:1"); code != http.StatusOK {
+		t.Error("each client should have its own limit, got", code)
+	}
+
+	// the route's limit has the default burst (one request, for limits under one per second)
+	auth = newAuthorizer(config.ServerSettings{RouteLimits: map[string]float64{"slurp": 0.001}})
+	if code := call(blocks, "3.3.3.3:1"); code != http.StatusOK {
+		t.Error("only the route should be limited, got", code)
+	}
+	if code := call(slurp, "3.3.3.3:1"); code != http.StatusOK {
+		t.Error("expected the first call to slurp to pass, got", code)
+	}
+	if code := call(slurp, "3.3.3.3:1"); code != http.StatusTooManyRequests {
+		t.Error("expected the route's limit to apply, got", code)
+	}
+}
+
+func TestAuthorizerSweep(t *testing.T) {
+	auth := newAuthorizer(config.ServerSettings{RateLimit: 1, Burst: 2})
+	for _, client := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"} {
+		auth.allow(client, auth.limit)
+	}
+	if len(auth.limiters) != 3 {
+		t.Fatal("expected a limiter per client, got", len(auth.limiters))
+	}
+
+	auth.sweep(time.Now())
+	if len(auth.limiters) != 3 {
+		t.Error("limiters that have not refilled should be kept, got", len(auth.limiters))
+	}
+
+	auth.sweep(time.Now().Add(time.Hour))
+	if len(auth.limiters) != 0 {
+		t.Error("idle limiters should be dropped, got", len(auth.limiters))
+	}
+}
+
+func callWith(auth *authorizer, route Route, remote string) int {
+	r := httptest.NewRequest(route.Method, route.Pattern, nil)
+	r.RemoteAddr = remote
+	w := httptest.NewRecorder()
+	auth.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), route).ServeHTTP(w, r)
+	return w.Code
+}
@@ -22,7 +22,7 @@ type chifraRpcServer struct {
 // Search looks up name by given terms
 func (g *chifraRpcServer) Search(ctx context.Context, request *proto.SearchRequest) (*proto.SearchResponse, error) {
 	log("Handling SearchNames")
-	found, err := names.LoadNamesArray("mainnet", names.Parts(request.GetParts()), names.SortByAddress, request.GetTerms())
+	found, err := names.LoadNamesArray("mainnet", names.Parts(request.GetParts()), names.SortByRank, request.GetTerms())
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (g *chifraRpcServer) Search(ctx context.Context, request *proto.SearchReque
 // SearchStream is like Search, but it streams the response
 func (g *chifraRpcServer) SearchStream(request *proto.SearchRequest, stream proto.Names_SearchStreamServer) error {
 	log("Handling SearchStream")
-	found, err := names.LoadNamesArray("mainnet", names.Parts(request.GetParts()), names.SortByAddress, request.GetTerms())
+	found, err := names.LoadNamesArray("mainnet", names.Parts(request.GetParts()), names.SortByRank, request.GetTerms())
 	if err != nil {
 		return err
 	}
@@ -127,6 +127,7 @@ func (opts *DaemonOptions) HandleGrpc() error {
 
 	rpcServer := grpc.NewServer()
 	proto.RegisterNamesServer(rpcServer, &chifraRpcServer{})
+	proto.RegisterChifraServer(rpcServer, &chifraServer{})
 
 	listener, err := net.Listen("unix", proto.SocketAddress())
 	if err != nil {
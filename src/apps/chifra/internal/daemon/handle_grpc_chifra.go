@@ -0,0 +1,217 @@
+package daemonPkg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	blocksPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/blocks"
+	exportPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/export"
+	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
+	logsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/logs"
+	statePkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/state"
+	tokensPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/tokens"
+	tracesPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/traces"
+	transactionsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/transactions"
+	whenPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/when"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/proto"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// chifraServer serves the commands that produce data by running the same code that serves them over HTTP,
+// streaming each of the models the command produces as a message
+type chifraServer struct {
+	proto.UnimplementedChifraServer
+}
+
+func (g *chifraServer) Export(request *proto.ExportRequest, stream proto.Chifra_ExportServer) error {
+	log("Handling Export")
+	return serveModels(request, stream, exportPkg.ServeExport)
+}
+
+func (g *chifraServer) List(request *proto.ListRequest, stream proto.Chifra_ListServer) error {
+	log("Handling List")
+	return serveModels(request, stream, listPkg.ServeList)
+}
+
+func (g *chifraServer) Blocks(request *proto.BlocksRequest, stream proto.Chifra_BlocksServer) error {
+	log("Handling Blocks")
+	return serveModels(request, stream, blocksPkg.ServeBlocks)
+}
+
+func (g *chifraServer) Transactions(request *proto.TransactionsRequest, stream proto.Chifra_TransactionsServer) error {
+	log("Handling Transactions")
+	return serveModels(request, stream, transactionsPkg.ServeTransactions)
+}
+
+func (g *chifraServer) Logs(request *proto.LogsRequest, stream proto.Chifra_LogsServer) error {
+	log("Handling Logs")
+	return serveModels(request, stream, logsPkg.ServeLogs)
+}
+
+func (g *chifraServer) Traces(request *proto.TracesRequest, stream proto.Chifra_TracesServer) error {
+	log("Handling Traces")
+	return serveModels(request, stream, tracesPkg.ServeTraces)
+}
+
+func (g *chifraServer) State(request *proto.StateRequest, stream proto.Chifra_StateServer) error {
+	log("Handling State")
+	return serveModels(request, stream, statePkg.ServeState)
+}
+
+func (g *chifraServer) Tokens(request *proto.TokensRequest, stream proto.Chifra_TokensServer) error {
+	log("Handling Tokens")
+	return serveModels(request, stream, tokensPkg.ServeTokens)
+}
+
+func (g *chifraServer) When(request *proto.WhenRequest, stream proto.Chifra_WhenServer) error {
+	log("Handling When")
+	return serveModels(request, stream, whenPkg.ServeWhen)
+}
+
+// modelStream is the part of the (generated) server streams of the Chifra service that we use
+type modelStream interface {
+	Context() context.Context
+	Send(*proto.Model) error
+}
+
+// serveModels runs the command's API handler with the request's fields as the query, sending the
+// models it produces to the stream. Errors reported while streaming are returned once it finishes.
+func serveModels(request pb.Message, stream modelStream, serve func(http.ResponseWriter, *http.Request) error) error {
+	values := requestToQuery(request)
+	values.Set("fmt", "json")
+	r, err := http.NewRequestWithContext(stream.Context(), "GET", "/?"+values.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	w := &modelWriter{stream: stream, header: http.Header{}}
+	if err := serve(w, r); err != nil {
+		return err
+	}
+	return errors.Join(w.errs...)
+}
+
+// requestToQuery converts the fields of a request to the query of the equivalent API call. The
+// globals are flattened into the query and fields that are not set (or false) are left out.
+func requestToQuery(request pb.Message) url.Values {
+	values := url.Values{}
+	var add func(msg protoreflect.Message)
+	add = func(msg protoreflect.Message) {
+		msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			key := fd.JSONName()
+			switch {
+			case fd.IsList():
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					values.Add(key, list.Get(i).String())
+				}
+			case fd.Kind() == protoreflect.MessageKind:
+				add(v.Message())
+			case fd.Kind() == protoreflect.BoolKind:
+				if v.Bool() {
+					values.Set(key, "true")
+				}
+			default:
+				values.Set(key, v.String())
+			}
+			return true
+		})
+	}
+	add(request.ProtoReflect())
+	return values
+}
+
+// modelWriter is the response writer handed to a command served over gRPC. It sends the models it is
+// given to the stream and collects the errors.
+type modelWriter struct {
+	stream modelStream
+	header http.Header
+	errs   []error
+}
+
+func (w *modelWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *modelWriter) WriteHeader(statusCode int) {
+}
+
+// Write is only called by commands that do not stream models, which are not served over gRPC
+func (w *modelWriter) Write(p []byte) (int, error) {
+	return 0, fmt.Errorf("the command's output cannot be sent over gRPC")
+}
+
+func (w *modelWriter) WriteError(err error) {
+	w.errs = append(w.errs, err)
+}
+
+func (w *modelWriter) WriteModel(model types.Model) error {
+	message, err := toMessage(model)
+	if err != nil {
+		return err
+	}
+	return w.stream.Send(message)
+}
+
+// toMessage converts a model to a message. The model's data is converted through its JSON representation,
+// so the message carries the same fields (and values) as the command's JSON output.
+func toMessage(model types.Model) (*proto.Model, error) {
+	raw, err := json.Marshal(model.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var data map[string]any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	s, err := structpb.NewStruct(convertNumbers(data).(map[string]any))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.Model{
+		Data:  s,
+		Order: model.Order,
+	}, nil
+}
+
+// convertNumbers replaces the numbers in decoded JSON with floats, except for integers, which are kept
+// as strings. A float64 (and therefore a protobuf Value) does not hold large integers (such as wei values)
+// exactly, and converting only those would give a field a different type depending on its value. This is
+// how protobuf's JSON mapping presents 64-bit integers.
+func convertNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+		return v
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return v.String()
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	default:
+		return v
+	}
+}
@@ -0,0 +1,130 @@
+package daemonPkg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/proto"
+)
+
+func TestRequestToQuery(t *testing.T) {
+	values := requestToQuery(&proto.ListRequest{
+		Globals:     &proto.Globals{Chain: "sepolia", Verbose: true},
+		Addrs:       []string{"0x1", "0x2"},
+		NoZero:      true,
+		FirstRecord: 10,
+	})
+
+	expected := "addrs=0x1&addrs=0x2&chain=sepolia&firstRecord=10&noZero=true&verbose=true"
+	if got := values.Encode(); got != expected {
+		t.Error("wrong query: expected", expected, "got", got)
+	}
+}
+
+func TestToMessage(t *testing.T) {
+	message, err := toMessage(types.Model{
+		Data: map[string]any{
+			"blockNumber": uint64(17000000),
+			"value":       "1",
+			"wei":         uint64(12000000000000000000),
+			"isError":     true,
+			"price":       1.5,
+		},
+		Order: []string{"blockNumber", "value", "wei", "isError", "price"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := message.Data.GetFields()
+	if got := fields["blockNumber"].GetStringValue(); got != "17000000" {
+		t.Error("integers should be strings, got", fields["blockNumber"])
+	}
+	if got := fields["wei"].GetStringValue(); got != "12000000000000000000" {
+		t.Error("large integers should be strings, got", fields["wei"])
+	}
+	if got := fields["price"].GetNumberValue(); got != 1.5 {
+		t.Error("wrong price", fields["price"])
+	}
+	if got := fields["isError"].GetBoolValue(); !got {
+		t.Error("wrong isError", got)
+	}
+	if len(message.Order) != 5 {
+		t.Error("wrong order", message.Order)
+	}
+}
+
+type testStream struct {
+	sent    []*proto.Model
+	sendErr error
+}
+
+func (s *testStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testStream) Send(model *proto.Model) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, model)
+	return nil
+}
+
+func TestServeModels(t *testing.T) {
+	stream := &testStream{}
+	serve := func(w http.ResponseWriter, r *http.Request) error {
+		if r.URL.Query().Get("fmt") != "json" {
+			t.Error("commands served over gRPC should produce json")
+		}
+		return output.StreamModel(w, types.Model{
+			Data:  map[string]any{"blockNumber": r.URL.Query().Get("blocks")},
+			Order: []string{"blockNumber"},
+		}, output.OutputOptions{Format: "json"})
+	}
+
+	err := serveModels(&proto.WhenRequest{Blocks: []string{"100"}}, stream, serve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.sent) != 1 || stream.sent[0].Data.GetFields()["blockNumber"].GetStringValue() != "100" {
+		t.Error("wrong models sent", stream.sent)
+	}
+
+	failing := func(w http.ResponseWriter, r *http.Request) error {
+		w.(output.ModelWriter).WriteError(errors.New("bad block"))
+		return nil
+	}
+	if err := serveModels(&proto.WhenRequest{}, stream, failing); err == nil || err.Error() != "bad block" {
+		t.Error("expected the streamed error, got", err)
+	}
+}
+
+func TestServeModelsSendFails(t *testing.T) {
+	stream := &testStream{sendErr: errors.New("client went away")}
+	done := make(chan bool)
+	serve := func(w http.ResponseWriter, r *http.Request) error {
+		fetchData := func(modelChan chan types.Modeler[types.RawReceipt], errorChan chan error) {
+			for i := 0; i < 3; i++ {
+				modelChan <- &types.SimpleReceipt{BlockNumber: uint64(i)}
+			}
+			errorChan <- errors.New("not reported")
+			close(done)
+		}
+		return output.StreamMany(context.Background(), fetchData, output.OutputOptions{Writer: w, Format: "json"})
+	}
+
+	if err := serveModels(&proto.WhenRequest{}, stream, serve); err == nil || err.Error() != "client went away" {
+		t.Error("expected the send error, got", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Error("the fetcher was left blocked after the send failed")
+	}
+}
@@ -1,11 +1,41 @@
 package daemonPkg
 
-// HandleMonitor starts and manages the monitor process
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+// HandleMonitor adds the monitor watcher (chifra monitors --watch, configured in the [server] group) to
+// the daemon's services, starting it if --monitor is on
 func (opts *DaemonOptions) HandleMonitor() error {
-	// monitorOpts := monitorsPkg.GetMonitorsOptions([]string{}, &opts.Globals)
-	// monitorOpts.Watch:   true,
-	// monitorOpts.Sleep:   opts.Sleep,
-	// err, _ := monitorOpts.MonitorsInternal()
-	// return err
+	server := config.GetServer()
+	if len(server.MonitorCommands) == 0 {
+		return nil
+	}
+
+	commands, err := filepath.Abs(server.MonitorCommands)
+	if err != nil {
+		return err
+	}
+	watchlist := server.MonitorWatchlist
+	if len(watchlist) == 0 {
+		watchlist = "existing"
+	} else if watchlist != "existing" {
+		if watchlist, err = filepath.Abs(watchlist); err != nil {
+			return err
+		}
+	}
+
+	args := []string{"monitors", "--watch", "--commands", commands, "--watchlist", watchlist, "--chain", opts.Globals.Chain}
+	if server.MonitorSleep > 0 {
+		args = append(args, "--sleep", fmt.Sprint(server.MonitorSleep))
+	}
+
+	watcher := daemonServices.Add("monitor", nil, args...)
+	if opts.Monitor {
+		watcher.Start()
+	}
 	return nil
 }
@@ -1,14 +1,22 @@
 package daemonPkg
 
-import scrapePkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/scrape"
-
-// HandleScraper starts and manages the scraper process
+// HandleScraper adds the scraper to the daemon's services, starting it if --scrape is blooms or index. Before
+// the scraper first starts, chifra init downloads the Unchained Index's Bloom filters (blooms) or its
+// entire index (index).
 func (opts *DaemonOptions) HandleScraper() error {
-	if len(opts.Scrape) == 0 {
-		return nil
+	chain := opts.Globals.Chain
+
+	var setup []string
+	switch opts.Scrape {
+	case "blooms":
+		setup = []string{"init", "--chain", chain}
+	case "index":
+		setup = []string{"init", "--all", "--chain", chain}
 	}
 
-	scrapeOpts := scrapePkg.GetScrapeOptions([]string{}, &opts.Globals)
-	err := scrapeOpts.ScrapeInternal()
-	return err
+	scraper := daemonServices.Add("scraper", setup, "scrape", "--chain", chain)
+	if len(setup) > 0 {
+		scraper.Start()
+	}
+	return nil
 }
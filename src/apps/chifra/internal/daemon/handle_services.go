@@ -0,0 +1,313 @@
+package daemonPkg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/gorilla/mux"
+)
+
+// The states of a service
+const (
+	serviceStopped    = "stopped"
+	serviceStarting   = "starting"
+	serviceRunning    = "running"
+	serviceRestarting = "restarting"
+)
+
+// minBackoff and maxBackoff bound the wait before a failed service is restarted. The wait doubles with
+// each failure and is reset once the service has run for at least stableRun.
+var (
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+	stableRun  = time.Minute
+	stopDelay  = 30 * time.Second // how long a service has to finish after being asked to stop
+)
+
+var errUnknownService = errors.New("unknown service")
+
+// service is a long-running chifra command (such as the scraper) that the daemon runs as a child process,
+// restarting it (with backoff) if it fails
+type service struct {
+	name     string
+	exe      string   // the executable (chifra itself if empty)
+	setup    []string // run (until it succeeds) before the service first starts
+	args     []string
+	mutex    sync.Mutex
+	state    string
+	pid      int
+	started  time.Time
+	restarts uint64
+	lastErr  error
+	isSetup  bool
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// serviceStatus reports the status of a service
+type serviceStatus struct {
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Command   string `json:"command"`
+	Pid       int    `json:"pid,omitempty"`
+	Started   int64  `json:"started,omitempty"`
+	Restarts  uint64 `json:"restarts"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// serviceManager holds the daemon's services
+type serviceManager struct {
+	services map[string]*service
+	mutex    sync.Mutex
+}
+
+// daemonServices are the services managed by the running daemon
+var daemonServices = newServiceManager()
+
+func newServiceManager() *serviceManager {
+	return &serviceManager{services: map[string]*service{}}
+}
+
+// Add adds a (stopped) service that runs chifra with the args
+func (m *serviceManager) Add(name string, setup []string, args ...string) *service {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	s := &service{name: name, setup: setup, args: args, state: serviceStopped}
+	m.services[name] = s
+	return s
+}
+
+// Get returns the named service
+func (m *serviceManager) Get(name string) (*service, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	s, ok := m.services[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnknownService, name)
+	}
+	return s, nil
+}
+
+// Status returns the status of each service sorted by name
+func (m *serviceManager) Status() []serviceStatus {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	ret := make([]serviceStatus, 0, len(m.services))
+	for _, s := range m.services {
+		ret = append(ret, s.Status())
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// StopAll stops all of the services, waiting for them to finish
+func (m *serviceManager) StopAll() {
+	m.mutex.Lock()
+	services := make([]*service, 0, len(m.services))
+	for _, s := range m.services {
+		services = append(services, s)
+	}
+	m.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	for _, s := range services {
+		wg.Add(1)
+		go func(s *service) {
+			defer wg.Done()
+			s.Stop()
+		}(s)
+	}
+	wg.Wait()
+}
+
+// Start starts the service if it is not already running
+func (s *service) Start() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	s.state = serviceStarting
+	s.restarts = 0
+	s.lastErr = nil
+	go s.supervise(ctx)
+}
+
+// Stop stops the service (asking it to finish what it is doing) and waits for it to exit
+func (s *service) Stop() {
+	s.mutex.Lock()
+	cancel, done := s.cancel, s.done
+	s.mutex.Unlock()
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+// Status returns the service's status
+func (s *service) Status() serviceStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	status := serviceStatus{
+		Name:     s.name,
+		State:    s.state,
+		Command:  "chifra " + strings.Join(s.args, " "),
+		Restarts: s.restarts,
+	}
+	if s.state == serviceRunning {
+		status.Pid = s.pid
+		status.Started = s.started.Unix()
+	}
+	if s.lastErr != nil {
+		status.LastError = s.lastErr.Error()
+	}
+	return status
+}
+
+// supervise runs the service until it is stopped, restarting it with increasing backoff when it fails
+func (s *service) supervise(ctx context.Context) {
+	defer func() {
+		s.mutex.Lock()
+		s.state = serviceStopped
+		s.cancel = nil
+		s.pid = 0
+		close(s.done)
+		s.mutex.Unlock()
+		logger.Info(fmt.Sprintf("Service %s stopped", s.name))
+	}()
+
+	backoff := minBackoff
+	for {
+		start := time.Now()
+		err := s.runOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("exited")
+		}
+		if time.Since(start) >= stableRun {
+			backoff = minBackoff
+		}
+
+		s.mutex.Lock()
+		s.state = serviceRestarting
+		s.lastErr = err
+		s.restarts++
+		s.pid = 0
+		s.mutex.Unlock()
+		logger.Warn(fmt.Sprintf("Service %s failed (%s). Restarting in %s.", s.name, err, backoff))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// runOnce runs the service's setup (if it has not yet succeeded) and then the service itself, returning
+// when it exits
+func (s *service) runOnce(ctx context.Context) error {
+	s.mutex.Lock()
+	needsSetup := len(s.setup) > 0 && !s.isSetup
+	s.mutex.Unlock()
+
+	if needsSetup {
+		if err := s.run(ctx, s.setup, false); err != nil {
+			return fmt.Errorf("setup (chifra %s) failed: %w", strings.Join(s.setup, " "), err)
+		}
+		s.mutex.Lock()
+		s.isSetup = true
+		s.mutex.Unlock()
+	}
+	return s.run(ctx, s.args, true)
+}
+
+// run runs chifra with the args as a child process. When the context is canceled, the process is
+// interrupted (as if by control+c) so it may finish cleanly, and is killed if it has not exited
+// after stopDelay.
+func (s *service) run(ctx context.Context, args []string, isService bool) error {
+	exe := s.exe
+	if len(exe) == 0 {
+		var err error
+		if exe, err = os.Executable(); err != nil {
+			return err
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, exe, args...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = stopDelay
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	if isService {
+		s.mutex.Lock()
+		s.state = serviceRunning
+		s.pid = cmd.Process.Pid
+		s.started = time.Now()
+		s.mutex.Unlock()
+		logger.Info(fmt.Sprintf("Service %s started (pid %d)", s.name, cmd.Process.Pid))
+	}
+	return cmd.Wait()
+}
+
+// RouteServices reports the status of the daemon's services
+func RouteServices(w http.ResponseWriter, r *http.Request) {
+	respondWithServices(w, daemonServices.Status())
+}
+
+// RouteStartService starts one of the daemon's services
+func RouteStartService(w http.ResponseWriter, r *http.Request) {
+	s, err := daemonServices.Get(mux.Vars(r)["name"])
+	if err != nil {
+		RespondWithError(w, http.StatusNotFound, err)
+		return
+	}
+	s.Start()
+	respondWithServices(w, []serviceStatus{s.Status()})
+}
+
+// RouteStopService stops one of the daemon's services, waiting for it to exit
+func RouteStopService(w http.ResponseWriter, r *http.Request) {
+	s, err := daemonServices.Get(mux.Vars(r)["name"])
+	if err != nil {
+		RespondWithError(w, http.StatusNotFound, err)
+		return
+	}
+	s.Stop()
+	respondWithServices(w, []serviceStatus{s.Status()})
+}
+
+func respondWithServices(w http.ResponseWriter, statuses []serviceStatus) {
+	marshalled, _ := json.MarshalIndent(map[string][]serviceStatus{"data": statuses}, "", "  ")
+	_, _ = w.Write(marshalled)
+}
@@ -0,0 +1,74 @@
+package daemonPkg
+
+import (
+	"testing"
+	"time"
+)
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServiceStartStop(t *testing.T) {
+	s := &service{name: "sleeper", exe: "sleep", args: []string{"30"}, state: serviceStopped}
+
+	s.Start()
+	waitFor(t, "the service to run", func() bool {
+		return s.Status().State == serviceRunning
+	})
+	if status := s.Status(); status.Pid == 0 || status.Started == 0 {
+		t.Error("a running service should report its pid and start time", status)
+	}
+
+	start := time.Now()
+	s.Stop()
+	if status := s.Status(); status.State != serviceStopped || status.Pid != 0 || status.Restarts != 0 {
+		t.Error("wrong status after stopping", status)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Error("the service should stop when interrupted")
+	}
+}
+
+func TestServiceRestarts(t *testing.T) {
+	saved := minBackoff
+	minBackoff = 10 * time.Millisecond
+	defer func() { minBackoff = saved }()
+
+	s := &service{name: "failing", exe: "sh", setup: []string{"-c", "exit 0"}, args: []string{"-c", "exit 3"}, state: serviceStopped}
+	s.Start()
+	waitFor(t, "the service to be restarted", func() bool {
+		return s.Status().Restarts >= 2
+	})
+	s.Stop()
+
+	status := s.Status()
+	if status.State != serviceStopped || status.LastError != "exit status 3" {
+		t.Error("wrong status for a failing service", status)
+	}
+	if !s.isSetup {
+		t.Error("the setup should have succeeded")
+	}
+}
+
+func TestServiceManager(t *testing.T) {
+	m := newServiceManager()
+	m.Add("scraper", nil, "scrape")
+	m.Add("monitor", nil, "monitors", "--watch")
+
+	if _, err := m.Get("missing"); err == nil {
+		t.Error("expected an error for an unknown service")
+	}
+
+	statuses := m.Status()
+	if len(statuses) != 2 || statuses[0].Name != "monitor" || statuses[1].Command != "chifra scrape" {
+		t.Error("wrong statuses", statuses)
+	}
+	m.StopAll() // stopping stopped services does nothing
+}
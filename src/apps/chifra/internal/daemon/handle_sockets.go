@@ -21,7 +21,8 @@ const (
 	CommandErrorMessage MessageType = "command_error"
 	// CommandOutputMessage is currently not used, but may in the future carry the actual data
 	CommandOutputMessage MessageType = "output"
-	// ProgressMessage is a message carried on the stderr stream
+	// ProgressMessage is a message carried on the stderr stream. It also reports the progress of streaming
+	// API requests, in which case its ID is the request's X-Request-Id
 	ProgressMessage MessageType = "progress"
 )
 
@@ -0,0 +1,230 @@
+package daemonPkg
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// The response modes of the API server. By default a route's response is a single JSON document. If the
+// request's Accept header asks for NDJSON or Server-Sent Events, each record is instead sent (and flushed)
+// as soon as it is produced.
+type streamMode int
+
+const (
+	streamNone streamMode = iota
+	streamNdjson
+	streamSse
+)
+
+// progressInterval is how often a streaming request's progress is broadcast to the websocket clients
+var progressInterval = time.Second
+
+// The states of a streaming request reported in its progress messages
+const (
+	progressRunning  = "running"
+	progressDone     = "done"
+	progressCanceled = "canceled"
+	progressFailed   = "failed"
+)
+
+// progress is the content of the progress messages sent (as JSON) for streaming requests
+type progress struct {
+	Route  string `json:"route"`
+	Count  uint64 `json:"count"`
+	Errors uint64 `json:"errors,omitempty"`
+	State  string `json:"state"`
+}
+
+// getStreamMode returns the response mode the request's Accept header asks for
+func getStreamMode(r *http.Request) streamMode {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "application/x-ndjson", "application/ndjson":
+			return streamNdjson
+		case "text/event-stream":
+			return streamSse
+		}
+	}
+	return streamNone
+}
+
+// StreamHandler identifies each request (with the X-Request-Id header, which is generated if the client
+// does not send one) and, if the client asks for NDJSON or Server-Sent Events, streams the route's records
+// as they are produced
+func StreamHandler(inner http.Handler, route Route) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestId := r.Header.Get("X-Request-Id")
+		if len(requestId) == 0 {
+			requestId = newRequestId()
+		}
+		w.Header().Set("X-Request-Id", requestId)
+
+		mode := getStreamMode(r)
+		if mode == streamNone || route.Method != "GET" {
+			inner.ServeHTTP(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		if query.Has("raw") {
+			RespondWithError(w, http.StatusBadRequest, errors.New("the raw option is not available when streaming"))
+			return
+		}
+		// the records are streamed as JSON whatever the requested format
+		query.Set("fmt", "json")
+		r.URL.RawQuery = query.Encode()
+
+		sw := newStreamWriter(w, mode, requestId, route.Pattern)
+		inner.ServeHTTP(sw, r)
+		sw.finish(r.Context().Err())
+	})
+}
+
+func newRequestId() string {
+	bytes := make([]byte, 8)
+	_, _ = rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
+
+// streamWriter is the response writer for streaming requests. It sends each model it is given as a line of
+// NDJSON or as a Server-Sent Event.
+type streamWriter struct {
+	w            http.ResponseWriter
+	mode         streamMode
+	requestId    string
+	route        string
+	count        uint64
+	errors       uint64
+	lastProgress time.Time
+	started      bool
+	mutex        sync.Mutex
+}
+
+func newStreamWriter(w http.ResponseWriter, mode streamMode, requestId, route string) *streamWriter {
+	contentType := "application/x-ndjson"
+	if mode == streamSse {
+		contentType = "text/event-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // keep proxies from buffering the stream
+
+	return &streamWriter{
+		w:            w,
+		mode:         mode,
+		requestId:    requestId,
+		route:        route,
+		lastProgress: time.Now(),
+	}
+}
+
+func (sw *streamWriter) Header() http.Header {
+	return sw.w.Header()
+}
+
+// WriteHeader sends the status only if nothing has yet been streamed
+func (sw *streamWriter) WriteHeader(statusCode int) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+	if !sw.started {
+		sw.started = true
+		sw.w.WriteHeader(statusCode)
+	}
+}
+
+// Write passes through the output of commands which do not produce models
+func (sw *streamWriter) Write(p []byte) (int, error) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+	sw.started = true
+	return sw.w.Write(p)
+}
+
+func (sw *streamWriter) WriteModel(model types.Model) error {
+	data, err := json.Marshal(model.Data)
+	if err != nil {
+		return err
+	}
+
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+	sw.count++
+	if err := sw.writeEvent("model", data); err != nil {
+		return err
+	}
+	if time.Since(sw.lastProgress) >= progressInterval {
+		sw.lastProgress = time.Now()
+		sw.sendProgress(progressRunning)
+	}
+	return nil
+}
+
+func (sw *streamWriter) WriteError(err error) {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+	sw.errors++
+	_ = sw.writeEvent("error", data)
+}
+
+// writeEvent writes and flushes a line of NDJSON or an event
+func (sw *streamWriter) writeEvent(event string, data []byte) (err error) {
+	sw.started = true
+	if sw.mode == streamSse {
+		_, err = fmt.Fprintf(sw.w, "id: %d\nevent: %s\ndata: %s\n\n", sw.count, event, data)
+	} else {
+		_, err = fmt.Fprintf(sw.w, "%s\n", data)
+	}
+	if flusher, ok := sw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return err
+}
+
+// finish ends the stream (with a done event for Server-Sent Events) and reports the final progress
+func (sw *streamWriter) finish(ctxErr error) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	state := progressDone
+	if ctxErr != nil {
+		state = progressCanceled
+	} else if sw.errors > 0 {
+		state = progressFailed
+	}
+	if ctxErr == nil && sw.mode == streamSse {
+		data, _ := json.Marshal(map[string]uint64{"count": sw.count})
+		_ = sw.writeEvent("done", data)
+	}
+	sw.sendProgress(state)
+}
+
+// sendProgress broadcasts the request's progress to the websocket clients
+func (sw *streamWriter) sendProgress(state string) {
+	content, _ := json.Marshal(progress{
+		Route:  sw.route,
+		Count:  sw.count,
+		Errors: sw.errors,
+		State:  state,
+	})
+	select {
+	case connectionPool.broadcast <- &Message{Action: ProgressMessage, ID: sw.requestId, Content: string(content)}:
+	case <-time.After(100 * time.Millisecond):
+		// no one is listening (or the websockets are busy), progress is only advisory
+	}
+}
@@ -0,0 +1,91 @@
+package daemonPkg
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func TestGetStreamMode(t *testing.T) {
+	tests := map[string]streamMode{
+		"":                                 streamNone,
+		"application/json":                 streamNone,
+		"application/x-ndjson":             streamNdjson,
+		"application/ndjson; charset=utf8": streamNdjson,
+		"text/html, text/event-stream":     streamSse,
+	}
+	for accept, expected := range tests {
+		r := httptest.NewRequest("GET", "/export", nil)
+		r.Header.Set("Accept", accept)
+		if mode := getStreamMode(r); mode != expected {
+			t.Error("wrong mode for", accept, "expected", expected, "got", mode)
+		}
+	}
+}
+
+func TestStreamHandler(t *testing.T) {
+	saved := progressInterval
+	progressInterval = 0
+	defer func() { progressInterval = saved }()
+
+	route := Route{"RouteExport", "GET", "/export", nil}
+	var query string
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		mw, ok := w.(output.ModelWriter)
+		if !ok {
+			return
+		}
+		_ = mw.WriteModel(types.Model{Data: map[string]any{"blockNumber": 1}})
+		mw.WriteError(errors.New("oops"))
+		_ = mw.WriteModel(types.Model{Data: map[string]any{"blockNumber": 2}})
+	})
+
+	call := func(accept, url string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", url, nil)
+		r.Header.Set("Accept", accept)
+		r.Header.Set("X-Request-Id", "abc")
+		w := httptest.NewRecorder()
+		StreamHandler(inner, route).ServeHTTP(w, r)
+		return w
+	}
+
+	w := call("application/x-ndjson", "/export?addrs=trueblocks.eth&fmt=csv")
+	expected := "{\"blockNumber\":1}\n{\"error\":\"oops\"}\n{\"blockNumber\":2}\n"
+	if w.Body.String() != expected || w.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Errorf("wrong NDJSON response %q", w.Body.String())
+	}
+	if w.Header().Get("X-Request-Id") != "abc" || !strings.Contains(query, "fmt=json") {
+		t.Error("wrong request id or query", w.Header().Get("X-Request-Id"), query)
+	}
+
+	w = call("text/event-stream", "/export?addrs=trueblocks.eth")
+	expected = "id: 1\nevent: model\ndata: {\"blockNumber\":1}\n\n" +
+		"id: 1\nevent: error\ndata: {\"error\":\"oops\"}\n\n" +
+		"id: 2\nevent: model\ndata: {\"blockNumber\":2}\n\n" +
+		"id: 2\nevent: done\ndata: {\"count\":2}\n\n"
+	if w.Body.String() != expected || w.Header().Get("Content-Type") != "text/event-stream" {
+		t.Errorf("wrong SSE response %q", w.Body.String())
+	}
+
+	if w = call("application/x-ndjson", "/export?addrs=trueblocks.eth&raw"); w.Code != http.StatusBadRequest {
+		t.Error("raw should not be streamed, got", w.Code)
+	}
+	if w = call("application/json", "/export"); w.Header().Get("X-Request-Id") != "abc" || len(w.Header().Get("Content-Type")) > 0 {
+		t.Error("a request that does not ask to stream should be passed through")
+	}
+}
+
+func TestStreamErrorBeforeStart(t *testing.T) {
+	w := httptest.NewRecorder()
+	sw := newStreamWriter(w, streamNdjson, "abc", "/export")
+	RespondWithError(sw, http.StatusBadRequest, errors.New("bad option"))
+	if w.Code != http.StatusBadRequest || w.Body.String() != "{\"error\":\"bad option\"}\n" {
+		t.Error("wrong error response", w.Code, w.Body.String())
+	}
+}
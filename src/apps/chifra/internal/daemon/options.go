@@ -23,8 +23,8 @@ type DaemonOptions struct {
 	Url     string                `json:"url,omitempty"`     // Specify the API server's url and optionally its port
 	Api     string                `json:"api,omitempty"`     // Instruct the node to start the API server
 	Scrape  string                `json:"scrape,omitempty"`  // Start the scraper, initialize it with either just blooms or entire index, generate for new blocks
-	Monitor bool                  `json:"monitor,omitempty"` // Instruct the node to start the monitors tool
-	Grpc    bool                  `json:"grpc,omitempty"`    // Run gRPC server to serve names
+	Monitor bool                  `json:"monitor,omitempty"` // Start the monitor watcher configured in the [server] group of the configuration
+	Grpc    bool                  `json:"grpc,omitempty"`    // Run gRPC server to serve names and stream the output of other commands
 	Port    string                `json:"port,omitempty"`    // Deprecated please use --url flag instead
 	Globals globals.GlobalOptions `json:"globals,omitempty"` // The global options
 	Conn    *rpc.Connection       `json:"conn,omitempty"`    // The connection to the RPC server
@@ -10,12 +10,19 @@ package daemonPkg
 
 // EXISTING_CODE
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/globals"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	outputHelpers "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output/helpers"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
@@ -69,6 +76,19 @@ func (opts *DaemonOptions) DaemonInternal() error {
 	logger.InfoTable("Cache Path:        ", config.PathToCache(chain))
 	logger.InfoTable("Index Path:        ", config.PathToIndex(chain))
 
+	if server := config.GetServer(); len(server.ApiKeys) > 0 || len(server.TlsCert) > 0 {
+		logger.InfoTable("Security:          ", fmt.Sprintf("%d API keys, TLS %t", len(server.ApiKeys), len(server.TlsCert) > 0))
+	}
+
+	if settings := config.GetSettings(); len(settings.IndexReader) > 0 {
+		mode, err := index.ParseReaderMode(settings.IndexReader)
+		if err != nil {
+			return err
+		}
+		cache := index.EnableChunkCache(mode, int(settings.IndexCacheSize))
+		logger.InfoTable("Index Reader:      ", fmt.Sprintf("%s (caching %d chunks)", mode, cache.MaxSize()))
+	}
+
 	meta, err := opts.Conn.GetMetaData(false)
 	if err != nil {
 		msg := fmt.Sprintf("%sCould not load RPC provider: %s%s", colors.Red, err, colors.Off)
@@ -83,24 +103,55 @@ func (opts *DaemonOptions) DaemonInternal() error {
 		logger.InfoTable("Progress:          ", msg)
 	}
 
-	go func() {
-		_ = opts.HandleScraper()
-	}()
-	go func() {
-		_ = opts.HandleMonitor()
-	}()
+	// Listen before starting the services so that a port that is already taken leaves nothing running
+	listener, err := net.Listen("tcp", opts.Url)
+	if err != nil {
+		return err
+	}
+
+	if err = opts.HandleScraper(); err != nil {
+		listener.Close()
+		daemonServices.StopAll()
+		return err
+	}
+	if err = opts.HandleMonitor(); err != nil {
+		listener.Close()
+		daemonServices.StopAll()
+		return err
+	}
 	go func() {
 		_ = opts.HandleGrpc()
 	}()
 
-	// do not remove, this fixes a lint warning that happens in the boilerplate because of the Fatal just below
-	timer.Report(msg)
-
 	// Start listening to the web sockets
 	RunWebsocketPool()
-	// Start listening for requests
-	logger.Fatal(http.ListenAndServe(opts.Url, NewRouter()))
 
+	// Serve requests until we are interrupted (or terminated), then stop the services
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	server := config.GetServer()
+	httpServer := &http.Server{Addr: opts.Url, Handler: NewRouter(server)}
+	go func() {
+		var serveErr error
+		if len(server.TlsCert) > 0 {
+			serveErr = httpServer.ServeTLS(listener, server.TlsCert, server.TlsKey)
+		} else {
+			serveErr = httpServer.Serve(listener)
+		}
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			daemonServices.StopAll()
+			logger.Fatal(serveErr)
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Shutting down...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), stopDelay)
+	defer cancel()
+	if err = httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warn(err)
+	}
+	daemonServices.StopAll()
 	// EXISTING_CODE
 	timer.Report(msg)
 
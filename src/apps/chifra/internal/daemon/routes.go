@@ -24,6 +24,7 @@ import (
 	explorePkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/explore"
 	exportPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/export"
 	initPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/init"
+	lineagePkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/lineage"
 	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
 	logsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/logs"
 	monitorsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/monitors"
@@ -38,9 +39,9 @@ import (
 	transactionsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/transactions"
 	whenPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/when"
 	// END_ROUTE_PKGS
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/gorilla/mux"
-	"golang.org/x/time/rate"
 )
 
 // BEG_ROUTE_CODE
@@ -136,6 +137,13 @@ func RouteTokens(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// RouteLineage Report the creation and the tree of contracts created by one or more contracts.
+func RouteLineage(w http.ResponseWriter, r *http.Request) {
+	if err := lineagePkg.ServeLineage(w, r); err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err)
+	}
+}
+
 // RouteConfig Report on and edit the configuration of the TrueBlocks system.
 func RouteConfig(w http.ResponseWriter, r *http.Request) {
 	if err := configPkg.ServeConfig(w, r); err != nil {
@@ -235,6 +243,7 @@ var routes = Routes{
 	Route{"RouteWhen", "GET", "/when", RouteWhen},
 	Route{"RouteState", "GET", "/state", RouteState},
 	Route{"RouteTokens", "GET", "/tokens", RouteTokens},
+	Route{"RouteLineage", "GET", "/lineage", RouteLineage},
 	Route{"RouteConfig", "GET", "/config", RouteConfig},
 	Route{"RouteStatus", "GET", "/status", RouteStatus},
 	Route{"RouteScrape", "GET", "/scrape", RouteScrape},
@@ -244,6 +253,9 @@ var routes = Routes{
 	Route{"RouteSlurp", "GET", "/slurp", RouteSlurp},
 	// END_ROUTE_ITEMS
 	Route{"DeleteMonitors", "DELETE", "/monitors", RouteMonitors},
+	Route{"Services", "GET", "/services", RouteServices},
+	Route{"StartService", "POST", "/services/{name}/start", RouteStartService},
+	Route{"StopService", "POST", "/services/{name}/stop", RouteStopService},
 }
 
 // By removing, inserting into, or altering any lines of code in this
@@ -253,6 +265,12 @@ var routes = Routes{
 // RespondWithError marshals an err into JSON and returns the bytes
 // back to the caller httpStatus HTTP error status code
 func RespondWithError(w http.ResponseWriter, httpStatus int, err error) {
+	if sw, ok := w.(*streamWriter); ok {
+		// the status is only sent if the stream has not yet started
+		sw.WriteHeader(httpStatus)
+		sw.WriteError(err)
+		return
+	}
 	type ErrorResponse struct {
 		Errors []string `json:"errors,omitempty"`
 	}
@@ -272,8 +290,10 @@ type Route struct {
 // Routes An array of Route structures
 type Routes []Route
 
-// NewRouter Creates a new router given the routes array
-func NewRouter() *mux.Router {
+// NewRouter Creates a new router given the routes array. The routes are guarded by the server's API keys
+// and rate limits (if any), and stream their results if the client asks for NDJSON or Server-Sent Events.
+func NewRouter(server config.ServerSettings) *mux.Router {
+	auth := newAuthorizer(server)
 	router := mux.NewRouter().StrictSlash(true)
 	router.Use(CorsHandler)
 	router.
@@ -285,6 +305,8 @@ func NewRouter() *mux.Router {
 		var handler http.Handler
 		handler = route.HandlerFunc
 		handler = Logger(handler, route.Name)
+		handler = StreamHandler(handler, route)
+		handler = auth.Handler(handler, route)
 		router.
 			Methods(route.Method).
 			Path(route.Pattern).
@@ -297,7 +319,8 @@ func NewRouter() *mux.Router {
 
 func addCorsHeaders(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept")
+	w.Header().Set("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept, Authorization, X-API-Key, X-Request-Id")
+	w.Header().Set("Access-Control-Expose-Headers", "X-Request-Id")
 	w.Header().Set("Access-Control-Allow-Methods", "PUT, POST, GET, DELETE, OPTIONS")
 }
 
@@ -338,11 +361,6 @@ var nProcessed int
 // Logger sends information to the server's console
 func Logger(inner http.Handler, name string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var limiter = rate.NewLimiter(1, 3)
-		if !limiter.Allow() {
-			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
-			return
-		}
 		start := time.Now()
 		inner.ServeHTTP(w, r)
 		t := ""
@@ -6,6 +6,7 @@ package daemonPkg
 
 import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -26,16 +27,24 @@ func (opts *DaemonOptions) validateDaemon() error {
 		return validate.Usage("The {0} option is not available{1}.", "daemon", " in api mode")
 	}
 
-	// validate.ValidateEnum("scrape", opts.Scrape, "[off|blooms|index]")
 	// validate.ValidateEnum("api", opts.Api, "[off|on]")
 	opts.Api = "on"
 
-	if len(opts.Scrape) > 0 {
-		return validate.Usage("The {0} option is currenlty not available. Use {1} instead.", "--scrape", "chifra scrape")
+	if err := validate.ValidateEnum("--scrape", opts.Scrape, "[off|blooms|index]"); err != nil {
+		return err
 	}
 
 	if opts.Monitor {
-		return validate.Usage("The {0} option is currenlty not available. Use {1} instead.", "--monitor", "chifra monitors --watch")
+		commands := config.GetServer().MonitorCommands
+		if len(commands) == 0 {
+			return validate.Usage("The {0} option requires {1}.", "--monitor", "monitorCommands in the [server] group of the configuration")
+		} else if !file.FileExists(commands) {
+			return validate.Usage("The {0} option requires {1} to exist.", "--monitor", commands)
+		}
+	}
+
+	if err := validateServer(config.GetServer()); err != nil {
+		return err
 	}
 
 	return opts.Globals.Validate()
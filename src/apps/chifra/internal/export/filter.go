@@ -5,7 +5,7 @@ import (
 )
 
 func (opts *ExportOptions) matchesFilter(log *types.SimpleLog) bool {
-	return opts.matchesTopic(log) && opts.matchesEmitter(log)
+	return opts.matchesTopic(log) && opts.matchesEmitter(log) && opts.matchesEvent(log)
 }
 
 func (opts *ExportOptions) matchesEmitter(log *types.SimpleLog) bool {
@@ -30,3 +30,7 @@ func (opts *ExportOptions) matchesTopic(log *types.SimpleLog) bool {
 
 	return len(opts.Topics) == 0
 }
+
+func (opts *ExportOptions) matchesEvent(log *types.SimpleLog) bool {
+	return opts.EventFilter == nil || opts.EventFilter.Passes(log)
+}
@@ -5,7 +5,6 @@
 package exportPkg
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/articulate"
@@ -36,7 +35,7 @@ func (opts *ExportOptions) HandleAccounting(monitorArray []monitor.Monitor) erro
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx := context.Background()
+	ctx := opts.Globals.Context()
 	fetchData := func(modelChan chan types.Modeler[types.RawTransaction], errorChan chan error) {
 		visitAppearance := func(app *types.SimpleAppearance) error {
 			if tx, err := opts.Conn.GetTransactionByAppearance(app, false); err != nil {
@@ -106,7 +105,7 @@ func (opts *ExportOptions) HandleAccounting(monitorArray []monitor.Monitor) erro
 	}
 
 	if opts.Globals.Verbose || opts.Globals.Format == "json" {
-		parts := names.Custom | names.Prefund | names.Regular
+		parts := names.Custom | names.Prefund | names.Regular | names.Ens
 		namesMap, err := names.LoadNamesMap(chain, parts, nil)
 		if err != nil {
 			return err
@@ -5,7 +5,6 @@
 package exportPkg
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -28,7 +27,7 @@ func (opts *ExportOptions) HandleAppearances(monitorArray []monitor.Monitor) err
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx := context.Background()
+	ctx := opts.Globals.Context()
 	fetchData := func(modelChan chan types.Modeler[types.RawAppearance], errorChan chan error) {
 		currentBn := uint32(0)
 		for _, mon := range monitorArray {
@@ -58,7 +57,7 @@ func (opts *ExportOptions) HandleAppearances(monitorArray []monitor.Monitor) err
 	}
 
 	if opts.Globals.Verbose || opts.Globals.Format == "json" {
-		parts := names.Custom | names.Prefund | names.Regular
+		parts := names.Custom | names.Prefund | names.Regular | names.Ens
 		namesMap, err := names.LoadNamesMap(chain, parts, nil)
 		if err != nil {
 			return err
@@ -34,7 +34,7 @@ func (opts *ExportOptions) HandleBalances(monitorArray []monitor.Monitor) error
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawToken], errorChan chan error) {
 		currentBn := uint64(0)
 		prevBalance := big.NewInt(0)
@@ -90,7 +90,7 @@ func (opts *ExportOptions) HandleBalances(monitorArray []monitor.Monitor) error
 					}
 
 					iterErrorChan := make(chan error)
-					iterCtx, iterCancel := context.WithCancel(context.Background())
+					iterCtx, iterCancel := context.WithCancel(ctx)
 					defer iterCancel()
 					go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 					for err := range iterErrorChan {
@@ -135,7 +135,7 @@ func (opts *ExportOptions) HandleBalances(monitorArray []monitor.Monitor) error
 	}
 
 	if opts.Globals.Verbose || opts.Globals.Format == "json" {
-		parts := names.Custom | names.Prefund | names.Regular
+		parts := names.Custom | names.Prefund | names.Regular | names.Ens
 		if namesMap, err := names.LoadNamesMap(chain, parts, nil); err != nil {
 			return err
 		} else {
@@ -5,7 +5,6 @@
 package exportPkg
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"path"
@@ -26,7 +25,7 @@ import (
 func (opts *ExportOptions) HandleDecache(monitorArray []monitor.Monitor) error {
 	silent := opts.Globals.TestMode || len(opts.Globals.File) > 0
 
-	ctx := context.Background()
+	ctx := opts.Globals.Context()
 	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
 		for _, mon := range monitorArray {
 			mon := mon
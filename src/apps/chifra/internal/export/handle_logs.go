@@ -37,7 +37,7 @@ func (opts *ExportOptions) HandleLogs(monitorArray []monitor.Monitor) error {
 		addrArray = append(addrArray, mon.Address)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawLog], errorChan chan error) {
 		for _, mon := range monitorArray {
 			if sliceOfMaps, cnt, err := monitor.AsSliceOfMaps[types.SimpleTransaction](&mon, filter); err != nil {
@@ -77,7 +77,7 @@ func (opts *ExportOptions) HandleLogs(monitorArray []monitor.Monitor) error {
 					}
 
 					// Set up and interate over the map calling iterFunc for each appearance
-					iterCtx, iterCancel := context.WithCancel(context.Background())
+					iterCtx, iterCancel := context.WithCancel(ctx)
 					defer iterCancel()
 					errChan := make(chan error)
 					go utils.IterateOverMap(iterCtx, errChan, thisMap, iterFunc)
@@ -138,7 +138,7 @@ func (opts *ExportOptions) HandleLogs(monitorArray []monitor.Monitor) error {
 	}
 
 	if opts.Globals.Verbose || opts.Globals.Format == "json" {
-		parts := names.Custom | names.Prefund | names.Regular
+		parts := names.Custom | names.Prefund | names.Regular | names.Ens
 		if namesMap, err := names.LoadNamesMap(chain, parts, nil); err != nil {
 			return err
 		} else {
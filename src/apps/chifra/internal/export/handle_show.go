@@ -23,6 +23,7 @@ import (
 func (opts *ExportOptions) HandleShow(monitorArray []monitor.Monitor) error {
 	chain := opts.Globals.Chain
 	abiCache := articulate.NewAbiCache(opts.Conn, opts.Articulate)
+	abiCache.ReplayReverts = opts.Articulate
 	testMode := opts.Globals.TestMode
 	filter := filter.NewFilter(
 		opts.Reversed,
@@ -32,7 +33,7 @@ func (opts *ExportOptions) HandleShow(monitorArray []monitor.Monitor) error {
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(opts.Globals.Context())
 	fetchData := func(modelChan chan types.Modeler[types.RawTransaction], errorChan chan error) {
 		for _, mon := range monitorArray {
 			if sliceOfMaps, cnt, err := monitor.AsSliceOfMaps[types.SimpleTransaction](&mon, filter); err != nil {
@@ -72,7 +73,7 @@ func (opts *ExportOptions) HandleShow(monitorArray []monitor.Monitor) error {
 					}
 
 					// Set up and interate over the map calling iterFunc for each appearance
-					iterCtx, iterCancel := context.WithCancel(context.Background())
+					iterCtx, iterCancel := context.WithCancel(ctx)
 					defer iterCancel()
 					errChan := make(chan error)
 					go utils.IterateOverMap(iterCtx, errChan, thisMap, iterFunc)
@@ -118,7 +119,7 @@ func (opts *ExportOptions) HandleShow(monitorArray []monitor.Monitor) error {
 	}
 
 	if opts.Globals.Verbose || opts.Globals.Format == "json" {
-		parts := names.Custom | names.Prefund | names.Regular
+		parts := names.Custom | names.Prefund | names.Regular | names.Ens
 		namesMap, err := names.LoadNamesMap(chain, parts, nil)
 		if err != nil {
 			return err
@@ -14,6 +14,7 @@ import (
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/globals"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/caps"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
@@ -43,6 +44,8 @@ type ExportOptions struct {
 	Emitter     []string              `json:"emitter,omitempty"`     // For log export only, export only logs if emitted by one of these address(es)
 	Reverted    bool                  `json:"reverted,omitempty"`    // Export only transactions that were reverted
 	Topic       []string              `json:"topic,omitempty"`       // For log export only, export only logs with this topic(s)
+	Event       string                `json:"event,omitempty"`       // For log export only, export only logs emitted by this event (for example, Transfer(address indexed from, address indexed to, uint256 value))
+	Where       []string              `json:"where,omitempty"`       // For the --event option only, export only logs whose arguments match these conditions (for example, to=0x... or value>1e18)
 	Asset       []string              `json:"asset,omitempty"`       // For the accounting options only, export statements only for this asset
 	Flow        string                `json:"flow,omitempty"`        // For the accounting options only, export statements with incoming, outgoing, or zero value
 	Factory     bool                  `json:"factory,omitempty"`     // For --traces only, report addresses created by (or self-destructed by) the given address(es)
@@ -56,6 +59,7 @@ type ExportOptions struct {
 	Conn        *rpc.Connection       `json:"conn,omitempty"`        // The connection to the RPC server
 	BadFlag     error                 `json:"badFlag,omitempty"`     // An error flag if needed
 	// EXISTING_CODE
+	EventFilter *filter.EventFilter `json:"-"`
 	// EXISTING_CODE
 }
 
@@ -87,6 +91,8 @@ func (opts *ExportOptions) testLog() {
 	logger.TestLog(len(opts.Emitter) > 0, "Emitter: ", opts.Emitter)
 	logger.TestLog(opts.Reverted, "Reverted: ", opts.Reverted)
 	logger.TestLog(len(opts.Topic) > 0, "Topic: ", opts.Topic)
+	logger.TestLog(len(opts.Event) > 0, "Event: ", opts.Event)
+	logger.TestLog(len(opts.Where) > 0, "Where: ", opts.Where)
 	logger.TestLog(len(opts.Asset) > 0, "Asset: ", opts.Asset)
 	logger.TestLog(len(opts.Flow) > 0, "Flow: ", opts.Flow)
 	logger.TestLog(opts.Factory, "Factory: ", opts.Factory)
@@ -173,6 +179,13 @@ func exportFinishParseApi(w http.ResponseWriter, r *http.Request) *ExportOptions
 				s := strings.Split(val, " ") // may contain space separated items
 				opts.Topic = append(opts.Topic, s...)
 			}
+		case "event":
+			opts.Event = value[0]
+		case "where":
+			for _, val := range value {
+				s := strings.Split(val, " ") // may contain space separated items
+				opts.Where = append(opts.Where, s...)
+			}
 		case "asset":
 			for _, val := range value {
 				s := strings.Split(val, " ") // may contain space separated items
@@ -203,6 +216,8 @@ func exportFinishParseApi(w http.ResponseWriter, r *http.Request) *ExportOptions
 	opts.Conn = opts.Globals.FinishParseApi(w, r, opts.getCaches())
 
 	// EXISTING_CODE
+	// the API splits list options on spaces, which splits conditions such as `value > 1e18`
+	opts.Where = filter.RejoinConditions(opts.Where)
 	// EXISTING_CODE
 	opts.Addrs, _ = opts.Conn.GetEnsAddresses(opts.Addrs)
 	opts.Emitter, _ = opts.Conn.GetEnsAddresses(opts.Emitter)
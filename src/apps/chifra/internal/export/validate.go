@@ -11,6 +11,7 @@ import (
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
@@ -138,6 +139,21 @@ func (opts *ExportOptions) validateExport() error {
 		}
 	}
 
+	if len(opts.Where) > 0 && len(opts.Event) == 0 {
+		return validate.Usage("The {0} option is only available with the {1} option.", "--where", "--event")
+	}
+
+	if len(opts.Event) > 0 {
+		if !opts.Logs {
+			return validate.Usage("The {0} option is only available with the {1} option.", "--event", "--logs")
+		}
+		eventFilter, err := filter.NewEventFilter(opts.Event, opts.Where)
+		if err != nil {
+			return validate.Usage("The {0} option ({1}) is invalid: {2}.", "--event", opts.Event, err.Error())
+		}
+		opts.EventFilter = eventFilter
+	}
+
 	if !opts.Traces {
 		if opts.Factory {
 			return validate.Usage("The {0} option is only available with the {1} option.", "--factory", "--traces")
@@ -5,6 +5,7 @@
 package globals
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -31,6 +32,16 @@ type GlobalOptions struct {
 	Decache bool            `json:"decache,omitempty"`
 	Caps    caps.Capability `json:"-"`
 	output.OutputOptions
+	ctx context.Context
+}
+
+// Context returns the context of the API request being served (which is canceled if the client goes away)
+// or the background context on the command line
+func (opts *GlobalOptions) Context() context.Context {
+	if opts.ctx == nil {
+		return context.Background()
+	}
+	return opts.ctx
 }
 
 func (opts *GlobalOptions) TestLog() {
@@ -145,6 +156,7 @@ func InitGlobals(whoAmI string, cmd *cobra.Command, opts *GlobalOptions, c caps.
 func (opts *GlobalOptions) FinishParseApi(w http.ResponseWriter, r *http.Request, caches map[string]bool) *rpc.Connection {
 	opts.TestMode = r.Header.Get("User-Agent") == "testRunner"
 	opts.Writer = w
+	opts.ctx = r.Context()
 
 	for key, value := range r.URL.Query() {
 		switch key {
@@ -282,7 +282,7 @@ func checkHeader(path string) (InitReason, error) {
 		if err != nil {
 			return FILE_ERROR, err
 		}
-		if magic != file.SmallMagicNumber {
+		if magic != file.SmallMagicNumber && magic != file.ParamsMagicNumber {
 			return WRONG_MAGIC, nil
 		}
 
@@ -0,0 +1,10 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+// Package lineagePkg handles the chifra lineage command. It The  tool reports where one or more smart contracts came from and what they created. For each contract, the tool reports its creator (an EOA or a factory contract), the sender of the creating transaction (the deployer), the creation transaction itself, the hash of the contract's creation code, and whether it was created with CREATE or CREATE2. If the contract self-destructed, the block and the beneficiary of its balance are also reported. The tool then reports, one level at a time, each of the contracts created by the given contracts, each of the contracts those contracts created, and so on, to three levels by default. Use --depth to report more or fewer levels (zero reports the entire tree, which may be very large for busy factories). The contracts created by a contract are found in the traces of its appearances in the Unchained Index, so the tool is only as complete as your index and requires a tracing node. Because a contract's nonce grows with each contract it creates, only the appearances at which its nonce or its code changed are traced. A contract created with CREATE2 has an address derived from a salt chosen by its creator. The salt cannot be recovered from the address, but the tool tries the most common patterns (for example, the arguments of the factory call or the hash of a sorted pair of token addresses) and reports the salt if one of them matches. 
+package lineagePkg
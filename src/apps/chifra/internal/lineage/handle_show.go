@@ -0,0 +1,304 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package lineagePkg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// nonceWindow is the number of nonces tried when checking if a contract created by another contract
+// was created with CREATE (the creator may have created other contracts earlier in the same block)
+const nonceWindow = 256
+
+// HandleShow reports the lineage of each of the given contracts followed by the lineage of each of the
+// contracts they created, one level of the tree at a time. The appearances of each level are freshened
+// together so the index is scanned only once per level.
+func (opts *LineageOptions) HandleShow() error {
+	testMode := opts.Globals.TestMode
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		addrs := make([]base.Address, 0, len(opts.Addrs))
+		for _, addr := range opts.Addrs {
+			addrs = append(addrs, base.HexToAddress(addr))
+		}
+
+		report := func(contract *simpleLineage) {
+			modelChan <- contract
+		}
+		reportErr := func(err error) {
+			errorChan <- err
+		}
+		if err := walkLineage(&chainSource{opts: opts}, addrs, opts.Depth, !testMode && !utils.IsTerminal(), report, reportErr); err != nil {
+			errorChan <- err
+			cancel()
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// walkLineage reports the lineage of each of the contracts and of the contracts they created to the given
+// depth (all of them if maxDepth is zero). Errors concerning a single contract are reported and the walk
+// continues. An error is returned only if the appearances of a level could not be read.
+func walkLineage(source lineageSource, addrs []base.Address, maxDepth uint64, showProgress bool, report func(*simpleLineage), reportErr func(error)) error {
+	visited := make(map[base.Address]bool, len(addrs))
+	level := make([]*simpleLineage, 0, len(addrs))
+	for _, address := range addrs {
+		if !visited[address] {
+			visited[address] = true
+			level = append(level, &simpleLineage{Address: address})
+		}
+	}
+
+	for depth := uint64(0); len(level) > 0; depth++ {
+		levelAddrs := make([]base.Address, 0, len(level))
+		for _, contract := range level {
+			levelAddrs = append(levelAddrs, contract.Address)
+		}
+		appearances, err := source.Appearances(levelAddrs)
+		if err != nil {
+			return err
+		}
+
+		next := make([]*simpleLineage, 0)
+		for _, contract := range level {
+			children, err := traceLineage(source, contract, appearances[contract.Address], showProgress)
+			if err != nil {
+				reportErr(err)
+				continue
+			}
+			if depth == 0 && contract.CodeHash.IsZero() {
+				reportErr(fmt.Errorf("the creation of %s was not found (is it a contract?)", contract.Address.Hex()))
+				continue
+			}
+
+			report(contract)
+			if maxDepth == 0 || depth < maxDepth {
+				for _, child := range children {
+					if !visited[child.Address] {
+						visited[child.Address] = true
+						next = append(next, child)
+					}
+				}
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
+// accountState is the part of an account's state that changes when it is created, when it creates
+// another contract (which increments its nonce), and when it self-destructs
+type accountState struct {
+	nonce   uint64
+	hasCode bool
+}
+
+// changedBlocks returns the block of the contract's first appearance (where it was created) and the blocks
+// of the appearances at the end of which the contract's nonce or code differed from the previous appearance.
+// Only the transactions in those blocks can have created the contract, created another contract, or
+// destroyed the contract, so only they need to be traced. The blocks are found by bisection, so a contract
+// with many appearances but few creations needs few queries.
+func changedBlocks(source lineageSource, addr base.Address, apps []types.SimpleAppearance) (map[base.Blknum]bool, error) {
+	blocks := make([]base.Blknum, 0, len(apps))
+	for _, app := range apps {
+		bn := base.Blknum(app.BlockNumber)
+		if len(blocks) == 0 || blocks[len(blocks)-1] != bn {
+			blocks = append(blocks, bn)
+		}
+	}
+
+	changed := make(map[base.Blknum]bool)
+	if len(blocks) == 0 {
+		return changed, nil
+	}
+
+	stateAt := func(bn base.Blknum) (accountState, error) {
+		state, err := source.State(addr, bn)
+		if err != nil {
+			return accountState{}, err
+		}
+		return accountState{nonce: state.Nonce, hasCode: len(state.Code) > 0}, nil
+	}
+
+	// Compares the state at the end of the block at index lo with the state at the end of the block at index hi
+	var bisect func(lo, hi int, before, after accountState) error
+	bisect = func(lo, hi int, before, after accountState) error {
+		if before == after {
+			return nil
+		}
+		if hi == lo+1 {
+			changed[blocks[hi]] = true
+			return nil
+		}
+		mid := (lo + hi) / 2
+		middle, err := stateAt(blocks[mid])
+		if err != nil {
+			return err
+		}
+		if err := bisect(lo, mid, before, middle); err != nil {
+			return err
+		}
+		return bisect(mid, hi, middle, after)
+	}
+
+	// The state before the contract's creation is the same as the state after its self-destruct, so the
+	// bisection starts after the first appearance (which is always traced)
+	changed[blocks[0]] = true
+	if len(blocks) == 1 {
+		return changed, nil
+	}
+	first, err := stateAt(blocks[0])
+	if err != nil {
+		return nil, err
+	}
+	last, err := stateAt(blocks[len(blocks)-1])
+	if err != nil {
+		return nil, err
+	}
+	if err := bisect(0, len(blocks)-1, first, last); err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// traceLineage visits the traces of the contract's appearances in blocks where its state changed recording
+// the contract's creation (if not already known), its self-destruct (if any), and the contracts it created
+// (which are returned)
+func traceLineage(source lineageSource, contract *simpleLineage, apps []types.SimpleAppearance, showProgress bool) ([]*simpleLineage, error) {
+	changed, err := changedBlocks(source, contract.Address, apps)
+	if err != nil {
+		return nil, err
+	}
+
+	traced := make([]types.SimpleAppearance, 0, len(changed))
+	for _, app := range apps {
+		if changed[base.Blknum(app.BlockNumber)] {
+			traced = append(traced, app)
+		}
+	}
+
+	bar := logger.NewBar(logger.BarOptions{
+		Prefix:  contract.Address.Hex(),
+		Enabled: showProgress,
+		Total:   int64(len(traced)),
+	})
+	defer bar.Finish(true /* newLine */)
+
+	children := make([]*simpleLineage, 0)
+	for _, app := range traced {
+		app := app
+		tx, err := source.Transaction(&app)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range tx.Traces {
+			trace := &tx.Traces[index]
+			if trace.Action == nil || len(trace.Error) > 0 {
+				continue
+			}
+
+			switch trace.TraceType {
+			case "create":
+				if trace.Result == nil || trace.Result.Address.IsZero() {
+					continue
+				}
+				if trace.Result.Address == contract.Address && contract.CodeHash.IsZero() {
+					setCreation(source, contract, tx, index)
+				} else if trace.Action.From == contract.Address {
+					child := &simpleLineage{
+						Address: trace.Result.Address,
+						Depth:   contract.Depth + 1,
+					}
+					setCreation(source, child, tx, index)
+					children = append(children, child)
+					contract.NCreated++
+				}
+			case "suicide":
+				if trace.Action.Address == contract.Address {
+					contract.DestructBlock = tx.BlockNumber
+					contract.Beneficiary = trace.Action.RefundAddress
+				}
+			}
+		}
+
+		bar.Tick()
+	}
+	return children, nil
+}
+
+// setCreation records the details of the creation of a contract by the index'th trace of the transaction
+func setCreation(source lineageSource, contract *simpleLineage, tx *types.SimpleTransaction, index int) {
+	trace := &tx.Traces[index]
+	contract.Creator = trace.Action.From
+	contract.Deployer = tx.From
+	contract.BlockNumber = tx.BlockNumber
+	contract.TransactionIndex = tx.TransactionIndex
+	contract.TransactionHash = tx.Hash
+	contract.Timestamp = tx.Timestamp
+	contract.CodeHash = base.BytesToHash(crypto.Keccak256(base.Hex2Bytes(strings.TrimPrefix(trace.Action.Init, "0x"))))
+
+	if salt, ok := deriveSalt(contract.Creator, contract.Address, contract.CodeHash, callerInput(tx.Traces, index)); ok {
+		contract.CreationType = "create2"
+		contract.Salt = salt
+		return
+	}
+
+	if len(trace.TraceAddress) == 0 {
+		// Created by the transaction itself, so by an EOA using the transaction's nonce
+		contract.CreationType = "create"
+		return
+	}
+
+	// The address of a contract created with CREATE is determined by the creator's nonce. If no nearby nonce
+	// yields the address, the contract was created with CREATE2 (with a salt we could not derive).
+	contract.CreationType = "create2"
+	if tx.BlockNumber > 0 {
+		if state, err := source.State(contract.Creator, tx.BlockNumber-1); err == nil {
+			if isCreateAddress(contract.Creator, contract.Address, state.Nonce, nonceWindow) {
+				contract.CreationType = "create"
+			}
+		}
+	}
+}
+
+// callerInput returns the input of the call that issued the index'th trace (the trace whose trace address
+// is the index'th trace's address less its last element)
+func callerInput(traces []types.SimpleTrace, index int) string {
+	address := traces[index].TraceAddress
+	if len(address) == 0 {
+		return ""
+	}
+	parent := address[:len(address)-1]
+	for i := index - 1; i >= 0; i-- {
+		if traces[i].Action != nil && equalTraceAddresses(traces[i].TraceAddress, parent) {
+			return traces[i].Action.Input
+		}
+	}
+	return ""
+}
+
+func equalTraceAddresses(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package lineagePkg
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testSource is a lineageSource whose accounts change state at the given blocks
+type testSource struct {
+	apps   map[base.Address][]types.SimpleAppearance
+	states map[base.Address]map[base.Blknum]types.SimpleState
+	txs    map[base.Blknum]*types.SimpleTransaction
+	traced []base.Blknum
+}
+
+func (s *testSource) Appearances(addrs []base.Address) (map[base.Address][]types.SimpleAppearance, error) {
+	ret := make(map[base.Address][]types.SimpleAppearance, len(addrs))
+	for _, addr := range addrs {
+		ret[addr] = s.apps[addr]
+	}
+	return ret, nil
+}
+
+func (s *testSource) State(addr base.Address, bn base.Blknum) (*types.SimpleState, error) {
+	state := types.SimpleState{}
+	latest := base.Blknum(0)
+	for changedAt, changed := range s.states[addr] {
+		if changedAt <= bn && changedAt >= latest {
+			state, latest = changed, changedAt
+		}
+	}
+	return &state, nil
+}
+
+func (s *testSource) Transaction(app *types.SimpleAppearance) (*types.SimpleTransaction, error) {
+	s.traced = append(s.traced, base.Blknum(app.BlockNumber))
+	return s.txs[base.Blknum(app.BlockNumber)], nil
+}
+
+func (s *testSource) appear(addr base.Address, blocks ...uint32) {
+	for _, bn := range blocks {
+		s.apps[addr] = append(s.apps[addr], types.SimpleAppearance{Address: addr, BlockNumber: bn})
+	}
+}
+
+func (s *testSource) change(addr base.Address, bn base.Blknum, nonce uint64, code string) {
+	if s.states[addr] == nil {
+		s.states[addr] = make(map[base.Blknum]types.SimpleState)
+	}
+	s.states[addr][bn] = types.SimpleState{Nonce: nonce, Code: code}
+}
+
+// transact adds a transaction sent by the EOA to the called address in which the trace is issued
+func (s *testSource) transact(bn base.Blknum, eoa, called base.Address, trace types.SimpleTrace) {
+	trace.TraceAddress = []uint64{0}
+	s.txs[bn] = &types.SimpleTransaction{
+		BlockNumber: bn,
+		From:        eoa,
+		Traces: []types.SimpleTrace{
+			{TraceType: "call", Action: &types.SimpleTraceAction{From: eoa, To: called, Input: "0x"}},
+			trace,
+		},
+	}
+}
+
+func createTrace(creator, created base.Address) types.SimpleTrace {
+	return types.SimpleTrace{
+		TraceType: "create",
+		Action:    &types.SimpleTraceAction{From: creator, Init: "0x6001"},
+		Result:    &types.SimpleTraceResult{Address: created},
+	}
+}
+
+func TestWalkLineage(t *testing.T) {
+	eoa := base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b")
+	factory := base.BytesToAddress(crypto.CreateAddress(eoa.Common(), 0).Bytes())
+	a := base.BytesToAddress(crypto.CreateAddress(factory.Common(), 1).Bytes())
+	b := base.BytesToAddress(crypto.CreateAddress(factory.Common(), 2).Bytes())
+	c := base.BytesToAddress(crypto.CreateAddress(a.Common(), 1).Bytes())
+
+	source := &testSource{
+		apps:   make(map[base.Address][]types.SimpleAppearance),
+		states: make(map[base.Address]map[base.Blknum]types.SimpleState),
+		txs:    make(map[base.Blknum]*types.SimpleTransaction),
+	}
+
+	// The factory is created by the EOA and creates a and b. It also appears in transactions that
+	// create nothing (which should not be traced).
+	source.appear(factory, 10, 20, 30, 40, 50)
+	source.change(factory, 10, 1, "0x60")
+	source.change(factory, 20, 2, "0x60")
+	source.change(factory, 40, 3, "0x60")
+	source.txs[10] = &types.SimpleTransaction{
+		BlockNumber: 10,
+		From:        eoa,
+		Traces:      []types.SimpleTrace{createTrace(eoa, factory)},
+	}
+	source.transact(20, eoa, factory, createTrace(factory, a))
+	source.transact(40, eoa, factory, createTrace(factory, b))
+
+	// a creates c and then self-destructs
+	source.appear(a, 20, 25, 60)
+	source.change(a, 20, 1, "0x60")
+	source.change(a, 25, 2, "0x60")
+	source.change(a, 60, 0, "")
+	source.transact(25, eoa, a, createTrace(a, c))
+	source.transact(60, eoa, a, types.SimpleTrace{
+		TraceType: "suicide",
+		Action:    &types.SimpleTraceAction{Address: a, RefundAddress: eoa},
+	})
+
+	source.appear(b, 40, 45)
+	source.change(b, 40, 1, "0x60")
+	source.appear(c, 25)
+	source.change(c, 25, 1, "0x60")
+
+	walk := func(maxDepth uint64) []*simpleLineage {
+		source.traced = nil
+		reported := make([]*simpleLineage, 0)
+		report := func(contract *simpleLineage) {
+			reported = append(reported, contract)
+		}
+		reportErr := func(err error) {
+			t.Error(err)
+		}
+		if err := walkLineage(source, []base.Address{factory}, maxDepth, false, report, reportErr); err != nil {
+			t.Fatal(err)
+		}
+		return reported
+	}
+
+	reported := walk(0)
+	expected := []struct {
+		address  base.Address
+		depth    uint64
+		nCreated uint64
+	}{
+		{factory, 0, 2}, {a, 1, 1}, {b, 1, 0}, {c, 2, 0},
+	}
+	if len(reported) != len(expected) {
+		t.Fatal("expected", len(expected), "contracts, got", len(reported))
+	}
+	for i, want := range expected {
+		got := reported[i]
+		if got.Address != want.address || got.Depth != want.depth || got.NCreated != want.nCreated {
+			t.Error("wrong contract", i, got.Address.Hex(), got.Depth, got.NCreated)
+		}
+		if got.CreationType != "create" {
+			t.Error("wrong creation type for", got.Address.Hex(), got.CreationType)
+		}
+	}
+	if reported[0].Creator != eoa || reported[1].Creator != factory || reported[1].BlockNumber != 20 {
+		t.Error("wrong creation", reported[0].Creator.Hex(), reported[1].Creator.Hex(), reported[1].BlockNumber)
+	}
+	if reported[1].DestructBlock != 60 || reported[1].Beneficiary != eoa {
+		t.Error("wrong self-destruct", reported[1].DestructBlock, reported[1].Beneficiary.Hex())
+	}
+
+	for _, bn := range source.traced {
+		if bn == 30 || bn == 45 || bn == 50 {
+			t.Error("traced block", bn, "in which nothing changed")
+		}
+	}
+	if len(source.traced) != 8 {
+		t.Error("expected 8 traced transactions, got", source.traced)
+	}
+
+	// The contracts created by the contracts the given contract created are deeper than one level
+	reported = walk(1)
+	if len(reported) != 3 || reported[2].Address != b {
+		t.Error("expected the factory, a, and b, got", len(reported))
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package lineagePkg
+
+// EXISTING_CODE
+import (
+	"net/http"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/globals"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	outputHelpers "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output/helpers"
+	"github.com/spf13/cobra"
+)
+
+// EXISTING_CODE
+
+// RunLineage handles the lineage command for the command line. Returns error only as per cobra.
+func RunLineage(cmd *cobra.Command, args []string) error {
+	opts := lineageFinishParse(args)
+	outputHelpers.EnableCommand("lineage", true)
+	// EXISTING_CODE
+	// EXISTING_CODE
+	outputHelpers.SetWriterForCommand("lineage", &opts.Globals)
+	return opts.LineageInternal()
+}
+
+// ServeLineage handles the lineage command for the API. Returns an error.
+func ServeLineage(w http.ResponseWriter, r *http.Request) error {
+	opts := lineageFinishParseApi(w, r)
+	outputHelpers.EnableCommand("lineage", true)
+	// EXISTING_CODE
+	// EXISTING_CODE
+	outputHelpers.InitJsonWriterApi("lineage", w, &opts.Globals)
+	err := opts.LineageInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("lineage", err, &opts.Globals)
+	return err
+}
+
+// LineageInternal handles the internal workings of the lineage command.  Returns an error.
+func (opts *LineageOptions) LineageInternal() error {
+	var err error
+	if err = opts.validateLineage(); err != nil {
+		return err
+	}
+
+	timer := logger.NewTimer()
+	msg := "chifra lineage"
+	// EXISTING_CODE
+	err = opts.HandleShow()
+	// EXISTING_CODE
+	timer.Report(msg)
+
+	return err
+}
+
+// GetLineageOptions returns the options for this tool so other tools may use it.
+func GetLineageOptions(args []string, g *globals.GlobalOptions) *LineageOptions {
+	ret := lineageFinishParse(args)
+	if g != nil {
+		ret.Globals = *g
+	}
+	return ret
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
@@ -0,0 +1,102 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package lineagePkg
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// isCreateAddress returns true if created is the address of a contract created with CREATE by creator
+// using one of the nonces in [nonce, nonce+window)
+func isCreateAddress(creator, created base.Address, nonce, window uint64) bool {
+	for n := nonce; n < nonce+window; n++ {
+		if base.BytesToAddress(crypto.CreateAddress(creator.Common(), n).Bytes()) == created {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveSalt looks for the CREATE2 salt that, given the creator and the hash of the creation code, yields
+// the created address. A salt cannot be recovered from the address, so the candidates are taken from the
+// input of the call that created the contract: each of its 32-byte arguments, the hash of all of its
+// arguments, and the hash of the addresses among its arguments, packed in the order given or sorted (as is
+// common for pairs of tokens).
+func deriveSalt(creator, created base.Address, codeHash base.Hash, input string) (base.Hash, bool) {
+	for _, salt := range saltCandidates(input) {
+		if base.BytesToAddress(crypto.CreateAddress2(creator.Common(), salt, codeHash.Bytes()).Bytes()) == created {
+			return base.BytesToHash(salt[:]), true
+		}
+	}
+	return base.Hash{}, false
+}
+
+// saltCandidates returns the possible CREATE2 salts found in the input of a call (see deriveSalt)
+func saltCandidates(input string) [][32]byte {
+	data := base.Hex2Bytes(strings.TrimPrefix(input, "0x"))
+	if len(data) < 4 {
+		return nil
+	}
+	args := data[4:]
+
+	var words, addresses [][]byte
+	var positions []int
+	for i := 0; i+32 <= len(args); i += 32 {
+		word := args[i : i+32]
+		words = append(words, word)
+		if bytes.Equal(word[:12], make([]byte, 12)) && !bytes.Equal(word[12:], make([]byte, 20)) {
+			addresses = append(addresses, word[12:])
+			positions = append(positions, i)
+		}
+	}
+
+	ret := make([][32]byte, 0, len(words)+4+len(addresses)*len(addresses))
+	add := func(salt []byte) {
+		var s [32]byte
+		copy(s[:], salt)
+		ret = append(ret, s)
+	}
+
+	for _, word := range words {
+		add(word)
+	}
+	if len(args) > 0 {
+		add(crypto.Keccak256(args))
+	}
+
+	if len(addresses) > 1 {
+		add(crypto.Keccak256(addresses...))
+		sorted := make([][]byte, len(addresses))
+		copy(sorted, addresses)
+		sort.Slice(sorted, func(i, j int) bool {
+			return bytes.Compare(sorted[i], sorted[j]) < 0
+		})
+		add(crypto.Keccak256(sorted...))
+
+		// The arguments with the addresses sorted in place (for example, abi.encode(token0, token1, fee))
+		sortedArgs := make([]byte, len(args))
+		copy(sortedArgs, args)
+		for i, pos := range positions {
+			copy(sortedArgs[pos+12:pos+32], sorted[i])
+		}
+		add(crypto.Keccak256(sortedArgs))
+
+		// Pairs of addresses (for example, the two tokens of a pool) in either order
+		for i := range addresses {
+			for j := range addresses {
+				if i != j {
+					add(crypto.Keccak256(addresses[i], addresses[j]))
+				}
+			}
+		}
+	}
+
+	return ret
+}
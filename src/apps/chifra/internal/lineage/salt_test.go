@@ -0,0 +1,54 @@
+package lineagePkg
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDeriveSalt(t *testing.T) {
+	factory := base.HexToAddress("0x5c69bee701ef814a2b6a3edd4b1652cb9cc5aa6f")
+	token0 := base.HexToAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	token1 := base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	codeHash := base.HexToHash("0x96e8ac4277198ff8b6f785478aa9a39f403cb768dd02cbee326c3e7da348845f")
+
+	// createPair(tokenA, tokenB) salts the pair with keccak(token0, token1) with the tokens sorted
+	input := "0xc9c65396" +
+		"000000000000000000000000" + token0.Hex()[2:] +
+		"000000000000000000000000" + token1.Hex()[2:]
+	sorted := crypto.Keccak256(token1.Bytes(), token0.Bytes())
+	var expected [32]byte
+	copy(expected[:], sorted)
+	pair := base.BytesToAddress(crypto.CreateAddress2(factory.Common(), expected, codeHash.Bytes()).Bytes())
+
+	salt, ok := deriveSalt(factory, pair, codeHash, input)
+	if !ok {
+		t.Fatal("expected the salt to be derived")
+	}
+	if want := base.BytesToHash(sorted); salt != want {
+		t.Error("expected salt", want.Hex(), "got", salt.Hex())
+	}
+
+	if _, ok := deriveSalt(factory, token0, codeHash, input); ok {
+		t.Error("expected no salt for an address not created by the factory")
+	}
+	if _, ok := deriveSalt(factory, pair, codeHash, "0x"); ok {
+		t.Error("expected no salt for an empty input")
+	}
+}
+
+func TestIsCreateAddress(t *testing.T) {
+	creator := base.HexToAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	created := base.BytesToAddress(crypto.CreateAddress(creator.Common(), 10).Bytes())
+
+	if !isCreateAddress(creator, created, 10, 1) {
+		t.Error("expected the address to be created at nonce 10")
+	}
+	if !isCreateAddress(creator, created, 5, 10) {
+		t.Error("expected the address to be found in the window")
+	}
+	if isCreateAddress(creator, created, 11, 256) {
+		t.Error("expected the address not to be found after its nonce")
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package lineagePkg
+
+import (
+	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// lineageSource provides the data the lineage of a contract is built from
+type lineageSource interface {
+	// Appearances returns the appearances of each of the addresses sorted by block and transaction
+	Appearances(addrs []base.Address) (map[base.Address][]types.SimpleAppearance, error)
+	// State returns the nonce and the code of the address at the end of the block
+	State(addr base.Address, bn base.Blknum) (*types.SimpleState, error)
+	// Transaction returns the transaction at the appearance with its traces
+	Transaction(app *types.SimpleAppearance) (*types.SimpleTransaction, error)
+}
+
+// chainSource reads the lineage from the Unchained Index and the node
+type chainSource struct {
+	opts *LineageOptions
+}
+
+func (s *chainSource) Appearances(addrs []base.Address) (map[base.Address][]types.SimpleAppearance, error) {
+	return listPkg.ReadAppearances(s.opts.Globals, addrs)
+}
+
+func (s *chainSource) State(addr base.Address, bn base.Blknum) (*types.SimpleState, error) {
+	return s.opts.Conn.GetState(rpc.Nonce|rpc.Code, addr, bn, rpc.StateFilters{})
+}
+
+func (s *chainSource) Transaction(app *types.SimpleAppearance) (*types.SimpleTransaction, error) {
+	return s.opts.Conn.GetTransactionByAppearance(app, true)
+}
@@ -0,0 +1,95 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package lineagePkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+// EXISTING_CODE
+
+type simpleLineage struct {
+	Address          base.Address   `json:"address"`
+	Beneficiary      base.Address   `json:"beneficiary,omitempty"`
+	BlockNumber      base.Blknum    `json:"blockNumber"`
+	CodeHash         base.Hash      `json:"codeHash"`
+	CreationType     string         `json:"creationType"`
+	Creator          base.Address   `json:"creator"`
+	Deployer         base.Address   `json:"deployer"`
+	Depth            uint64         `json:"depth"`
+	DestructBlock    base.Blknum    `json:"destructBlock,omitempty"`
+	NCreated         uint64         `json:"nCreated"`
+	Salt             base.Hash      `json:"salt,omitempty"`
+	Timestamp        base.Timestamp `json:"timestamp"`
+	TransactionHash  base.Hash      `json:"transactionHash"`
+	TransactionIndex base.Txnum     `json:"transactionIndex"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleLineage) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleLineage) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"depth":            s.Depth,
+		"address":          s.Address,
+		"creator":          s.Creator,
+		"deployer":         s.Deployer,
+		"blockNumber":      s.BlockNumber,
+		"transactionIndex": s.TransactionIndex,
+		"transactionHash":  s.TransactionHash,
+		"timestamp":        s.Timestamp,
+		"date":             utils.FormattedDate(s.Timestamp),
+		"creationType":     s.CreationType,
+		"codeHash":         s.CodeHash,
+		"nCreated":         s.NCreated,
+	}
+	order = []string{
+		"depth",
+		"address",
+		"creator",
+		"deployer",
+		"blockNumber",
+		"transactionIndex",
+		"transactionHash",
+		"timestamp",
+		"date",
+		"creationType",
+		"codeHash",
+	}
+
+	if format != "json" || !s.Salt.IsZero() {
+		model["salt"] = s.Salt
+		order = append(order, "salt")
+	}
+	if format != "json" || s.DestructBlock != 0 {
+		model["destructBlock"] = s.DestructBlock
+		model["beneficiary"] = s.Beneficiary
+		order = append(order, []string{"destructBlock", "beneficiary"}...)
+	}
+	order = append(order, "nCreated")
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
@@ -0,0 +1,50 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package lineagePkg
+
+import (
+	"errors"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
+)
+
+func (opts *LineageOptions) validateLineage() error {
+	chain := opts.Globals.Chain
+
+	opts.testLog()
+
+	if opts.BadFlag != nil {
+		return opts.BadFlag
+	}
+
+	if !config.IsChainConfigured(chain) {
+		return validate.Usage("chain {0} is not properly configured.", chain)
+	}
+
+	if err := validate.ValidateAtLeastOneAddr(opts.Addrs); err != nil {
+		return err
+	}
+
+	if err := validate.ValidateAddresses(opts.Addrs); err != nil {
+		return err
+	}
+
+	if !opts.Conn.IsNodeTracing() {
+		return validate.Usage("{0} requires tracing, err: {1}", "chifra lineage", rpc.ErrTraceBlockMissing)
+	}
+
+	if err := index.IsInitialized(chain, config.ExpectedVersion()); err != nil {
+		if (errors.Is(err, index.ErrNotInitialized) || errors.Is(err, index.ErrIncorrectHash)) && !opts.Globals.IsApiMode() {
+			logger.Fatal(err)
+		}
+		return err
+	}
+
+	return opts.Globals.Validate()
+}
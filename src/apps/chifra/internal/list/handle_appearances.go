@@ -0,0 +1,73 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package listPkg
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/globals"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// ReadAppearances returns the appearances of each of the addresses sorted by block and transaction. It
+// freshens the addresses' monitors to find them, but removes the monitors of addresses that were not
+// already monitored, so tools that look up the appearances of addresses they come across (such as the
+// contracts created by a factory) do not leave monitors behind.
+func ReadAppearances(globalOpts globals.GlobalOptions, addrs []base.Address) (map[base.Address][]types.SimpleAppearance, error) {
+	chain := globalOpts.Chain
+
+	strs := make([]string, 0, len(addrs))
+	created := make([]base.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		mon := monitor.Monitor{Address: addr, Chain: chain}
+		if !file.FileExists(mon.Path()) {
+			created = append(created, addr)
+		}
+		strs = append(strs, addr.Hex())
+	}
+	defer func() {
+		for _, addr := range created {
+			mon := monitor.Monitor{Address: addr, Chain: chain}
+			file.Remove(mon.Path())
+			mon.Staged = true
+			file.Remove(mon.Path())
+		}
+	}()
+
+	listOpts := ListOptions{
+		Addrs:   strs,
+		Silent:  true,
+		Globals: globalOpts,
+	}
+	monitorArray := make([]monitor.Monitor, 0, len(strs))
+	if canceled, err := listOpts.HandleFreshenMonitors(&monitorArray); err != nil {
+		return nil, err
+	} else if canceled {
+		return nil, fmt.Errorf("the user canceled the query")
+	}
+
+	ret := make(map[base.Address][]types.SimpleAppearance, len(monitorArray))
+	for _, mon := range monitorArray {
+		mon := mon
+		apps, _, err := mon.ReadAndFilterAppearances(filter.NewEmptyFilter())
+		mon.Close()
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(apps, func(i, j int) bool {
+			if apps[i].BlockNumber == apps[j].BlockNumber {
+				return apps[i].TransactionIndex < apps[j].TransactionIndex
+			}
+			return apps[i].BlockNumber < apps[j].BlockNumber
+		})
+		ret[mon.Address] = apps
+	}
+	return ret, nil
+}
@@ -36,8 +36,10 @@ type AddressMonitorMap map[base.Address]*monitor.Monitor
 type MonitorUpdate struct {
 	MaxTasks   int
 	MonitorMap AddressMonitorMap
+	Addresses  []base.Address
 	Options    *ListOptions
 	FirstBlock uint64
+	Covered    map[base.Address]uint64 // for addresses with a posting list, the first block not covered by it
 }
 
 const maxTestingBlock = 17000000
@@ -120,6 +122,22 @@ func (opts *ListOptions) HandleFreshenMonitors(monitorArray *[]monitor.Monitor)
 		}
 	}
 
+	updater.Addresses = make([]base.Address, 0, len(updater.MonitorMap))
+	for addr := range updater.MonitorMap {
+		updater.Addresses = append(updater.Addresses, addr)
+	}
+
+	// Hot addresses may have a posting list (see chifra chunks postings) which we read in a single
+	// pass instead of visiting every chunk. The chunks it covers are then skipped for that address.
+	if !opts.Globals.TestMode && updater.applyPostingLists() {
+		updater.FirstBlock = utils.NOPOS
+		for _, mon := range updater.MonitorMap {
+			if uint64(mon.LastScanned) < updater.FirstBlock {
+				updater.FirstBlock = uint64(mon.LastScanned)
+			}
+		}
+	}
+
 	bloomPath := filepath.Join(config.PathToIndex(chain), "blooms/")
 	files, err := os.ReadDir(bloomPath)
 	if err != nil {
@@ -235,67 +253,153 @@ func (updater *MonitorUpdate) visitChunkToFreshenFinal(fileName string, resultCh
 
 	bloomFilename := index.ToBloomPath(fileName)
 
-	// We open the bloom filter and read its header but we do not read any of the
-	// actual bits in the blooms. The IsMember function reads individual bytes to
-	// check individual bits.
-	bl, err := index.OpenBloom(bloomFilename, true /* check */)
-	if err != nil {
-		results = append(results, index.AppearanceResult{Range: bl.Range, Err: err})
-		bl.Close()
-		return
+	// Addresses whose posting list already covers this chunk need not be searched for.
+	addrs := updater.Addresses
+	if len(updater.Covered) > 0 {
+		chunkRange := base.RangeFromFilename(bloomFilename)
+		addrs = make([]base.Address, 0, len(updater.Addresses))
+		for _, addr := range updater.Addresses {
+			if updater.Covered[addr] <= chunkRange.Last {
+				addrs = append(addrs, addr)
+			}
+		}
+		if len(addrs) == 0 {
+			results = append(results, index.AppearanceResult{Range: chunkRange})
+			return
+		}
 	}
 
-	// We check each address against the bloom to see if there are any hits...
-	var bloomHits = false
-	for _, mon := range updater.MonitorMap {
-		if bl.IsMember(mon.Address) {
-			bloomHits = true
-			break
+	// If a shared cache of open chunks is enabled (for example, by the API server), we search the
+	// cached chunk rather than opening (and closing) the files for every request.
+	var handle *index.ChunkHandle
+	if cache := index.SharedChunkCache(); cache != nil {
+		var err error
+		if handle, err = cache.Acquire(bloomFilename); err != nil {
+			results = append(results, index.AppearanceResult{Range: base.RangeFromFilename(bloomFilename), Err: err})
+			return
 		}
+		defer handle.Release()
 	}
 
-	// We're done with the bloom and we want to close it as soon as we can (therefore
-	// we don't defer this close, but close it right away -- otherwise too many files
-	// are open and we get an error).
-	// TODO: Must we always be closing these files?
-	bl.Close()
+	var rng base.FileRange
+	var hits []base.Address
+	if handle != nil {
+		rng = handle.Range
+		hits, _ = handle.Bloom.FilterMembers(addrs) // the cached bloom is in memory, so this cannot fail
+
+	} else {
+		// We open the bloom filter and read its header. FilterMembers reads the bloom into
+		// memory once (if there are enough addresses to make that worthwhile) and tests
+		// every monitored address against it in a single pass.
+		bl, err := index.OpenBloom(bloomFilename, true /* check */)
+		if err != nil {
+			results = append(results, index.AppearanceResult{Range: bl.Range, Err: err})
+			bl.Close()
+			return
+		}
+		rng = bl.Range
+
+		hits, err = bl.FilterMembers(addrs)
+		if err != nil {
+			results = append(results, index.AppearanceResult{Range: rng, Err: err})
+			bl.Close()
+			return
+		}
+
+		// We're done with the bloom and we want to close it as soon as we can (therefore
+		// we don't defer this close, but close it right away -- otherwise too many files
+		// are open and we get an error).
+		// TODO: Must we always be closing these files?
+		bl.Close()
+	}
 
 	// If none of the addresses hit, we're finished with this index chunk. We want the
 	// caller to note this range even though there was no hit. In this way, we keep
 	// track of the last index portion we've seen. Because none of the addresses hit,
 	// we don't need to send a specific message.
-	if !bloomHits {
-		results = append(results, index.AppearanceResult{Range: bl.Range})
+	if len(hits) == 0 {
+		results = append(results, index.AppearanceResult{Range: rng})
 		return
 	}
 
 	indexFilename := index.ToIndexPath(fileName)
 	if !file.FileExists(indexFilename) {
 		chain := updater.Options.Globals.Chain
-		var man *manifest.Manifest
-		man, err = manifest.ReadManifest(chain, updater.Options.PublisherAddr, manifest.LocalCache)
+		man, err := manifest.ReadManifest(chain, updater.Options.PublisherAddr, manifest.LocalCache)
 		if err != nil {
-			results = append(results, index.AppearanceResult{Range: bl.Range, Err: err})
+			results = append(results, index.AppearanceResult{Range: rng, Err: err})
 			return
 		}
 
-		_, err = index.DownloadOneChunk(chain, man, bl.Range)
+		_, err = index.DownloadOneChunk(chain, man, rng)
 		if err != nil {
-			results = append(results, index.AppearanceResult{Range: bl.Range, Err: err})
+			results = append(results, index.AppearanceResult{Range: rng, Err: err})
 			return
 		}
 	}
 
-	indexChunk, err := index.OpenIndex(indexFilename, true /* check */)
-	if err != nil {
-		results = append(results, index.AppearanceResult{Range: bl.Range, Err: err})
-		return
+	var indexChunk *index.Index
+	if handle != nil {
+		var err error
+		if indexChunk, err = handle.Index(); err != nil {
+			results = append(results, index.AppearanceResult{Range: rng, Err: err})
+			return
+		}
+
+	} else {
+		opened, err := index.OpenIndex(indexFilename, true /* check */)
+		if err != nil {
+			results = append(results, index.AppearanceResult{Range: rng, Err: err})
+			return
+		}
+		defer opened.Close()
+		indexChunk = &opened
 	}
-	defer indexChunk.Close()
 
-	for _, mon := range updater.MonitorMap {
-		results = append(results, *indexChunk.ReadAppearances(mon.Address))
+	// We search the index once for all of the bloom hits. The remaining monitors get an
+	// empty result so their headers are updated for this range.
+	results = indexChunk.ReadAppearancesBatch(hits)
+	wasHit := make(map[base.Address]bool, len(hits))
+	for _, addr := range hits {
+		wasHit[addr] = true
+	}
+	for _, addr := range addrs {
+		if !wasHit[addr] {
+			results = append(results, index.AppearanceResult{Address: addr, Range: indexChunk.Range})
+		}
+	}
+}
+
+// applyPostingLists writes the appearances found in the posting lists of any of the monitored addresses
+// to their monitors and records the blocks covered in updater.Covered. It returns true if any posting
+// list was used.
+func (updater *MonitorUpdate) applyPostingLists() bool {
+	chain := updater.Options.Globals.Chain
+	updater.Covered = make(map[base.Address]uint64)
+	for addr, mon := range updater.MonitorMap {
+		pl, err := index.ReadPostingList(chain, addr)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				logger.Warn("Ignoring posting list:", err)
+			}
+			continue
+		}
+
+		lastScanned := uint64(mon.LastScanned)
+		if pl.NextBlock <= lastScanned {
+			continue
+		}
+
+		apps := append([]index.AppearanceRecord{}, pl.Since(lastScanned)...)
+		result := index.AppearanceResult{
+			Address:    addr,
+			Range:      base.FileRange{First: lastScanned, Last: pl.NextBlock - 1},
+			AppRecords: &apps,
+		}
+		updater.updateMonitors(&result)
+		updater.Covered[addr] = pl.NextBlock
 	}
+	return len(updater.Covered) > 0
 }
 
 // updateMonitors writes an array of appearances to the Monitor file updating the header for lastScanned. It
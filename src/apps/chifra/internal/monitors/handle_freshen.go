@@ -6,6 +6,8 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
 )
 
+// FreshenMonitorsForWatch freshens the given addresses together, so each bloom and index chunk is
+// searched once for all of them.
 func (opts *MonitorsOptions) FreshenMonitorsForWatch(addrs []base.Address) (bool, error) {
 	strs := make([]string, 0, len(addrs))
 	for _, addr := range addrs {
@@ -124,31 +124,26 @@ func (opts *MonitorsOptions) Refresh(monitors []monitor.Monitor) (bool, error) {
 		return false, err
 	}
 
-	batches := batchSlice[monitor.Monitor](monitors, opts.BatchSize)
-	for i := 0; i < len(batches); i++ {
-		addrs := []base.Address{}
-		countsBefore := []int64{}
-		for _, mon := range batches[i] {
-			addrs = append(addrs, mon.Address)
-			countsBefore = append(countsBefore, mon.Count())
-		}
+	// We freshen the entire watchlist at once (rather than batch by batch) so that each
+	// bloom filter and index chunk is read only once per refresh no matter how many
+	// addresses are being watched.
+	allAddrs := make([]base.Address, 0, len(monitors))
+	allCountsBefore := make([]int64, 0, len(monitors))
+	for _, mon := range monitors {
+		allAddrs = append(allAddrs, mon.Address)
+		allCountsBefore = append(allCountsBefore, mon.Count())
+	}
 
-		batchSize := int(opts.BatchSize)
-		fmt.Printf("%s%d-%d of %d:%s chifra export --freshen",
-			colors.BrightBlue,
-			i*batchSize,
-			utils.Min(((i+1)*batchSize)-1, len(monitors)),
-			len(monitors),
-			colors.Green)
-		for _, addr := range addrs {
-			fmt.Printf(" %s", addr.Hex())
-		}
-		fmt.Println(colors.Off)
+	fmt.Printf("%s%d addresses:%s chifra export --freshen%s\n", colors.BrightBlue, len(allAddrs), colors.Green, colors.Off)
+	canceled, err := opts.FreshenMonitorsForWatch(allAddrs)
+	if canceled || err != nil {
+		return canceled, err
+	}
 
-		canceled, err := opts.FreshenMonitorsForWatch(addrs)
-		if canceled || err != nil {
-			return canceled, err
-		}
+	batches := batchSlice[monitor.Monitor](monitors, opts.BatchSize)
+	countBatches := batchSlice[int64](allCountsBefore, opts.BatchSize)
+	for i := 0; i < len(batches); i++ {
+		countsBefore := countBatches[i]
 
 		for j := 0; j < len(batches[i]); j++ {
 			mon := batches[i][j]
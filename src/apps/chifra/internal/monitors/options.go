@@ -31,7 +31,7 @@ type MonitorsOptions struct {
 	Watch     bool                  `json:"watch,omitempty"`     // Continually scan for new blocks and extract data as per the command file
 	Watchlist string                `json:"watchlist,omitempty"` // Available with --watch option only, a file containing the addresses to watch
 	Commands  string                `json:"commands,omitempty"`  // Available with --watch option only, the file containing the list of commands to apply to each watched address
-	BatchSize uint64                `json:"batchSize,omitempty"` // Available with --watch option only, the number of monitors to process in each batch
+	BatchSize uint64                `json:"batchSize,omitempty"` // Available with --watch option only, the number of monitors whose commands run in each batch (all monitors are freshened together)
 	RunCount  uint64                `json:"runCount,omitempty"`  // Available with --watch option only, run the monitor this many times, then quit
 	Sleep     float64               `json:"sleep,omitempty"`     // Available with --watch option only, the number of seconds to sleep between runs
 	Globals   globals.GlobalOptions `json:"globals,omitempty"`   // The global options
@@ -0,0 +1,51 @@
+package namesPkg
+
+import (
+	"context"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandleHistory shows the logged changes to the custom names database, oldest first, for the given
+// addresses (or for all addresses if none are given)
+func (opts *NamesOptions) HandleHistory() error {
+	addrs := make([]base.Address, 0, len(opts.Terms))
+	for _, term := range opts.Terms {
+		addrs = append(addrs, base.HexToAddress(term))
+	}
+
+	history, err := names.ReadHistory(opts.Globals.Chain, addrs)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for index := range history {
+			modelChan <- newSimpleNameChange(&history[index])
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// HandleRollback restores the custom names database to its state at the given time and shows the
+// changes made to do so
+func (opts *NamesOptions) HandleRollback() error {
+	changes, err := names.Rollback(opts.Globals.Chain, opts.rollbackTs)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for index := range changes {
+			modelChan <- newSimpleNameChange(&changes[index])
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
@@ -0,0 +1,224 @@
+package namesPkg
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+// maxDepositTxs is the number of the most recent transactions of a possible deposit address that are
+// examined for the sweeping pattern
+const maxDepositTxs = 25
+
+// maxLabelTxs is the number of the most recent transactions of each monitored address that are searched
+// for counterparties
+const maxLabelTxs = 1000
+
+// HandleLabel proposes names, from on-chain evidence, for the unnamed counterparties found in the histories
+// of the monitored addresses (the terms). The proposals are shown for review and, with --pack, the most
+// confident proposal for each address is written to a name pack which may later be imported.
+func (opts *NamesOptions) HandleLabel() error {
+	chain := opts.Globals.Chain
+	testMode := opts.Globals.TestMode
+
+	known, err := names.LoadNamesMap(chain, names.Regular|names.Custom|names.Prefund, nil)
+	if err != nil {
+		return err
+	}
+
+	addrs := make([]base.Address, 0, len(opts.Terms))
+	for _, term := range opts.Terms {
+		addrs = append(addrs, base.HexToAddress(term))
+	}
+
+	appearances, err := listPkg.ReadAppearances(opts.Globals, addrs)
+	if err != nil {
+		return err
+	}
+
+	counterparties := opts.findCounterparties(appearances, known)
+
+	l := labeler{conn: opts.Conn, named: known}
+	bar := logger.NewBar(logger.BarOptions{
+		Prefix:  "Labeling",
+		Enabled: !testMode && !utils.IsTerminal(),
+		Total:   int64(len(counterparties)),
+	})
+	paid := make([]base.Address, 0)
+	for _, cp := range counterparties {
+		if cp.IsContract {
+			l.labelToken(cp)
+			l.labelSafe(cp)
+			l.labelFactory(cp)
+			l.labelProxy(cp)
+		} else if cp.Paid {
+			paid = append(paid, cp.Address)
+		}
+		bar.Tick()
+	}
+	bar.Finish(true /* newLine */)
+
+	if err = l.labelEns(counterparties); err != nil {
+		return err
+	}
+
+	if len(paid) > 0 {
+		depositApps, err := listPkg.ReadAppearances(opts.Globals, paid)
+		if err != nil {
+			return err
+		}
+		for _, cp := range counterparties {
+			apps, ok := depositApps[cp.Address]
+			if !ok {
+				continue
+			}
+			if len(apps) > maxDepositTxs {
+				apps = apps[len(apps)-maxDepositTxs:]
+			}
+			txs := make([]types.SimpleTransaction, 0, len(apps))
+			for _, app := range apps {
+				app := app
+				if tx, err := opts.Conn.GetTransactionByAppearance(&app, false); err != nil {
+					logger.Warn("Skipping transaction", app.BlockNumber, app.TransactionIndex, "of", cp.Address.Hex()+":", err)
+				} else {
+					txs = append(txs, *tx)
+				}
+			}
+			l.labelDeposit(cp, txs)
+		}
+	}
+
+	proposals := l.proposed
+	sort.SliceStable(proposals, func(i, j int) bool {
+		if proposals[i].Name.Address == proposals[j].Name.Address {
+			return proposals[i].Confidence > proposals[j].Confidence
+		}
+		return proposals[i].Name.Address.Hex() < proposals[j].Name.Address.Hex()
+	})
+
+	if len(opts.Pack) > 0 {
+		if err = opts.writeProposals(bestProposals(proposals)); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for index := range proposals {
+			modelChan <- &proposals[index]
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// findCounterparties returns the addresses (other than the monitored addresses and those we already have
+// names for) that sent, received, or emitted something in the most recent maxLabelTxs transactions of each
+// of the monitored addresses. Transactions (and addresses) that cannot be read from the node are skipped.
+func (opts *NamesOptions) findCounterparties(appearances map[base.Address][]types.SimpleAppearance, known map[base.Address]types.SimpleName) []*counterparty {
+	found := map[base.Address]*counterparty{}
+	add := func(address base.Address, paid bool) {
+		if address.IsZero() || base.IsPrecompile(address.Hex()) {
+			return
+		}
+		if _, ok := appearances[address]; ok {
+			return
+		}
+		if name, ok := known[address]; ok && len(name.Name) > 0 {
+			return
+		}
+		if cp, ok := found[address]; ok {
+			cp.Paid = cp.Paid || paid
+			return
+		}
+		found[address] = &counterparty{Address: address, Paid: paid}
+	}
+
+	total := 0
+	for monitored, apps := range appearances {
+		if len(apps) > maxLabelTxs {
+			logger.Info("Searching the most recent", maxLabelTxs, "of", len(apps), "transactions of", monitored.Hex())
+			apps = apps[len(apps)-maxLabelTxs:]
+			appearances[monitored] = apps
+		}
+		total += len(apps)
+	}
+
+	bar := logger.NewBar(logger.BarOptions{
+		Prefix:  "Searching",
+		Enabled: !opts.Globals.TestMode && !utils.IsTerminal(),
+		Total:   int64(total),
+	})
+	for monitored, apps := range appearances {
+		for _, app := range apps {
+			app := app
+			bar.Tick()
+			tx, err := opts.Conn.GetTransactionByAppearance(&app, false)
+			if err != nil {
+				logger.Warn("Skipping transaction", app.BlockNumber, app.TransactionIndex, "of", monitored.Hex()+":", err)
+				continue
+			}
+			add(tx.From, false)
+			add(tx.To, tx.From == monitored && tx.Value.Sign() > 0)
+			if tx.Receipt == nil {
+				continue
+			}
+			add(tx.Receipt.ContractAddress, false)
+			for _, log := range tx.Receipt.Logs {
+				add(log.Address, false)
+				if from, to, ok := transferOf(&log); ok {
+					add(from, false)
+					add(to, from == monitored)
+				}
+			}
+		}
+	}
+	bar.Finish(true /* newLine */)
+
+	ret := make([]*counterparty, 0, len(found))
+	for _, cp := range found {
+		err := opts.Conn.IsContractAt(cp.Address, nil)
+		if err != nil && !errors.Is(err, rpc.ErrNotAContract) {
+			logger.Warn("Skipping", cp.Address.Hex()+":", err)
+			continue
+		}
+		cp.IsContract = err == nil
+		ret = append(ret, cp)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Address.Hex() < ret[j].Address.Hex()
+	})
+	return ret
+}
+
+// writeProposals writes the proposals to a (signed, if there is a signing key) name pack for review
+func (opts *NamesOptions) writeProposals(proposals []simpleNameProposal) error {
+	proposed := make([]types.SimpleName, 0, len(proposals))
+	for _, proposal := range proposals {
+		proposed = append(proposed, proposal.Name)
+	}
+
+	pack := names.NewNamePack(opts.Globals.Chain, proposed)
+	if key, err := names.GetSigningKey(); err != nil {
+		return err
+	} else if key != nil {
+		if err = pack.Sign(key); err != nil {
+			return err
+		}
+	}
+
+	if err := names.WritePack(opts.Pack, pack); err != nil {
+		return err
+	}
+	logger.Info("Wrote", len(pack.Names), "proposed names to", opts.Pack)
+	return nil
+}
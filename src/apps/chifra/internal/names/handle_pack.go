@@ -0,0 +1,93 @@
+package namesPkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandlePack exports the names matching the search terms to a (signed, if there is a signing key) name
+// pack and shows the exported names
+func (opts *NamesOptions) HandlePack() error {
+	chain := opts.Globals.Chain
+
+	namesArray, err := names.LoadNamesArray(chain, opts.getType(), names.SortByAddress, opts.Terms)
+	if err != nil {
+		return err
+	}
+
+	pack := names.NewNamePack(chain, namesArray)
+	if key, err := names.GetSigningKey(); err != nil {
+		return err
+	} else if key == nil {
+		logger.Warn("TB_NAMES_SIGNINGKEY is not set. The name pack will not be signed.")
+	} else if err = pack.Sign(key); err != nil {
+		return err
+	}
+
+	if err = names.WritePack(opts.Pack, pack); err != nil {
+		return err
+	}
+	logger.Info("Wrote", len(pack.Names), "names to", opts.Pack)
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawName], errorChan chan error) {
+		for _, name := range namesArray {
+			if name.Deleted {
+				continue
+			}
+			name := name
+			modelChan <- &name
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// HandleImport merges the names in a name pack into the custom names database and shows the changes made
+// (or, with --dry_run, the changes that would be made). Unless --untrusted is given, the pack must be
+// signed by one of the trusted signers.
+func (opts *NamesOptions) HandleImport() error {
+	chain := opts.Globals.Chain
+
+	pack, err := names.ReadPack(opts.Import)
+	if err != nil {
+		return err
+	}
+	settings := config.GetNames()
+	trusted := append(settings.SignerList(), opts.Signer...)
+	if err := pack.VerifyTrusted(trusted); err == nil {
+		logger.Info("The name pack", opts.Import, "was signed by", pack.Signer)
+	} else if !errors.Is(err, names.ErrUntrustedPack) {
+		return err
+	} else if !opts.Untrusted {
+		return fmt.Errorf("%w (trust its signer with --signer or import it anyway with --untrusted)", err)
+	} else {
+		logger.Warn(err.Error()+".", "Importing it anyway (--untrusted).")
+	}
+
+	policy := names.MergePolicy(opts.Policy)
+	if len(policy) == 0 {
+		policy = names.PolicyMine
+	}
+
+	changes, err := names.ImportPack(chain, pack, policy, settings.PriorityList(), opts.DryRun)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for index := range changes {
+			modelChan <- newSimpleNameChange(&changes[index])
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
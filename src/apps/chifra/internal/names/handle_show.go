@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
@@ -25,7 +26,14 @@ func (opts *NamesOptions) HandleShow() error {
 	var client proto.NamesClient
 	var grpcErr error
 
-	if !apiMode {
+	if opts.Ens {
+		if err := opts.resolveEnsTerms(); err != nil {
+			return err
+		}
+	}
+
+	// The ENS names just resolved are not known to a running server, so we do not use it for --ens
+	if !apiMode && !opts.Ens {
 		// Try RPC
 		grpcCtx, grpcCancel := proto.GetContext()
 		defer grpcCancel()
@@ -45,7 +53,7 @@ func (opts *NamesOptions) HandleShow() error {
 			logger.Warn("falling back to file-based search")
 		}
 
-		namesArray, err := names.LoadNamesArray(chain, opts.getType(), names.SortByAddress, opts.Terms)
+		namesArray, err := names.LoadNamesArray(chain, opts.getType(), names.SortByRank, opts.Terms)
 		if err != nil {
 			return err
 		}
@@ -79,7 +87,7 @@ func (opts *NamesOptions) fetchFromGrpc(client proto.NamesClient, modelChan chan
 	stream, err := client.SearchStream(context.Background(), &proto.SearchRequest{
 		Parts: int64(opts.getType()),
 		Terms: opts.Terms,
-		Sort:  int64(names.SortByAddress),
+		Sort:  int64(names.SortByRank),
 	})
 	if err != nil {
 		errorChan <- err
@@ -100,3 +108,19 @@ func (opts *NamesOptions) fetchFromGrpc(client proto.NamesClient, modelChan chan
 		errorChan <- fmt.Errorf("no known names found for %v", opts.Terms)
 	}
 }
+
+// resolveEnsTerms resolves (and caches) the primary ENS name of each of the search terms that is an address
+// so that the names are found by the search
+func (opts *NamesOptions) resolveEnsTerms() error {
+	addrs := make([]base.Address, 0, len(opts.Terms))
+	for _, term := range opts.Terms {
+		if base.IsValidAddress(term) {
+			addrs = append(addrs, base.HexToAddress(term))
+		}
+	}
+	if len(addrs) == 0 {
+		return nil
+	}
+	_, err := names.ResolveEnsNames(opts.Conn, addrs, opts.Conn.GetLatestBlockNumber())
+	return err
+}
@@ -0,0 +1,331 @@
+package namesPkg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// The heuristics used to propose names. Each is recorded (prefixed with labelSource) as the Source of the
+// names it proposes.
+const (
+	labelSource  = "autolabel:"
+	labelToken   = "token"
+	labelSafe    = "safe"
+	labelFactory = "factory"
+	labelEns     = "ens"
+	labelProxy   = "proxy"
+	labelDeposit = "deposit"
+)
+
+const (
+	getOwnersSelector    = "0xa0e67e2b" // getOwners()
+	getThresholdSelector = "0xe75235b8" // getThreshold()
+	factorySelector      = "0xc45a0155" // factory()
+	transferTopic        = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+)
+
+// counterparty is an address found in the history of a monitored address along with what we learned of it
+// while scanning that history
+type counterparty struct {
+	Address    base.Address
+	IsContract bool
+	Paid       bool // true if the monitored address sent it ether or tokens (it may be a deposit address)
+}
+
+// labeler proposes names for counterparties from on-chain evidence
+type labeler struct {
+	conn     *rpc.Connection
+	named    map[base.Address]types.SimpleName // the names we already know (used to name related addresses)
+	proposed []simpleNameProposal
+}
+
+func (l *labeler) propose(heuristic string, confidence float64, evidence string, name types.SimpleName) {
+	name.Source = labelSource + heuristic
+	name.Petname = base.AddrToPetname(name.Address.Hex(), "-")
+	name.IsCustom = true
+	l.proposed = append(l.proposed, simpleNameProposal{
+		Name:       name,
+		Confidence: confidence,
+		Evidence:   evidence,
+	})
+}
+
+// labelToken proposes the token's own name for ERC-20 and ERC-721 contracts
+func (l *labeler) labelToken(cp *counterparty) {
+	token, err := l.conn.GetTokenState(cp.Address, "latest")
+	if err != nil || token == nil || (!token.TokenType.IsErc20() && !token.TokenType.IsErc721()) {
+		return
+	}
+
+	name := types.SimpleName{
+		Address:    cp.Address,
+		Name:       token.Name,
+		Symbol:     token.Symbol,
+		Decimals:   token.Decimals,
+		IsContract: true,
+		IsErc20:    token.TokenType.IsErc20(),
+		IsErc721:   token.TokenType.IsErc721(),
+		Tags:       "50-Tokens:ERC20",
+	}
+	if name.IsErc721 {
+		name.Tags = "50-Tokens:ERC721"
+	}
+
+	confidence := 0.9
+	if len(name.Name) == 0 {
+		if len(name.Symbol) == 0 {
+			return
+		}
+		name.Name = name.Symbol
+		confidence = 0.6
+	}
+	l.propose(labelToken, confidence, "the contract reports its token name and symbol", name)
+}
+
+// labelSafe proposes a name for Gnosis Safe (multisig) wallets
+func (l *labeler) labelSafe(cp *counterparty) {
+	owners := decodeAddresses(l.call(cp.Address, getOwnersSelector))
+	if len(owners) == 0 {
+		return
+	}
+	threshold := decodeUint(l.call(cp.Address, getThresholdSelector))
+	if threshold == 0 || threshold > uint64(len(owners)) {
+		return
+	}
+
+	l.propose(labelSafe, 0.9, fmt.Sprintf("getOwners returns %d owners with a threshold of %d", len(owners), threshold), types.SimpleName{
+		Address:    cp.Address,
+		Name:       fmt.Sprintf("Safe (%d of %d)", threshold, len(owners)),
+		Tags:       "30-Contracts:Safe",
+		IsContract: true,
+	})
+}
+
+// labelFactory proposes a name for contracts which report being created by a factory we know the name of
+// (for example, the pairs of a DEX)
+func (l *labeler) labelFactory(cp *counterparty) {
+	factory := decodeAddress(l.call(cp.Address, factorySelector))
+	if factory.IsZero() || factory == cp.Address {
+		return
+	}
+	known, ok := l.named[factory]
+	if !ok {
+		return
+	}
+
+	l.propose(labelFactory, 0.7, "created by the factory "+factory.Hex(), types.SimpleName{
+		Address:    cp.Address,
+		Name:       known.Name + " Product",
+		Tags:       contractTags(known.Tags),
+		IsContract: true,
+	})
+}
+
+// labelProxy proposes a name for proxies from the name of their implementation
+func (l *labeler) labelProxy(cp *counterparty) {
+	implementation, err := l.conn.GetContractProxyAt(cp.Address, l.conn.GetLatestBlockNumber())
+	if err != nil || implementation.IsZero() {
+		return
+	}
+
+	evidence := "a proxy for the implementation " + implementation.Hex()
+	if known, ok := l.named[implementation]; ok {
+		l.propose(labelProxy, 0.75, evidence, types.SimpleName{
+			Address:    cp.Address,
+			Name:       known.Name + " (Proxy)",
+			Tags:       contractTags(known.Tags),
+			Symbol:     known.Symbol,
+			Decimals:   known.Decimals,
+			IsContract: true,
+			IsErc20:    known.IsErc20,
+			IsErc721:   known.IsErc721,
+		})
+		return
+	}
+
+	l.propose(labelProxy, 0.3, evidence, types.SimpleName{
+		Address:    cp.Address,
+		Name:       "Proxy",
+		Tags:       "30-Contracts",
+		IsContract: true,
+	})
+}
+
+// labelEns proposes the (verified) primary ENS names of the counterparties
+func (l *labeler) labelEns(cps []*counterparty) error {
+	addrs := make([]base.Address, 0, len(cps))
+	for _, cp := range cps {
+		addrs = append(addrs, cp.Address)
+	}
+	resolved, err := names.ResolveEnsNames(l.conn, addrs, l.conn.GetLatestBlockNumber())
+	if err != nil {
+		return err
+	}
+	for _, cp := range cps {
+		if name, ok := resolved[cp.Address]; ok {
+			name.IsContract = cp.IsContract
+			l.propose(labelEns, 0.95, "the address's primary ENS name resolves back to it", name)
+		}
+	}
+	return nil
+}
+
+// labelDeposit proposes a name for an externally owned address whose outgoing transfers (among the given
+// transactions) all go to a single address, which is the pattern of an exchange's deposit addresses
+func (l *labeler) labelDeposit(cp *counterparty, txs []types.SimpleTransaction) {
+	target, sweeps := sweepTarget(cp.Address, txs)
+	if sweeps == 0 {
+		return
+	}
+
+	evidence := fmt.Sprintf("%d outgoing transfers all sent to %s", sweeps, target.Hex())
+	if known, ok := l.named[target]; ok {
+		confidence := 0.6
+		if strings.HasPrefix(known.Tags, "40-Exchanges") {
+			confidence = 0.8
+		}
+		l.propose(labelDeposit, confidence, evidence, types.SimpleName{
+			Address: cp.Address,
+			Name:    known.Name + " Deposit",
+			Tags:    "40-Exchanges:Deposits",
+		})
+		return
+	}
+
+	l.propose(labelDeposit, 0.4, evidence, types.SimpleName{
+		Address: cp.Address,
+		Name:    "Deposit Address",
+		Tags:    "40-Exchanges:Deposits",
+	})
+}
+
+// call makes a read-only call to the contract returning the result (or nil if the call fails)
+func (l *labeler) call(address base.Address, data string) []byte {
+	result, err := query.Query[string](l.conn.Chain, "eth_call", query.Params{
+		map[string]any{
+			"to":   address,
+			"data": data,
+		},
+		"latest",
+	})
+	if err != nil || result == nil {
+		return nil
+	}
+	return base.Hex2Bytes(strings.TrimPrefix(*result, "0x"))
+}
+
+// sweepTarget returns the single address to which all of the outgoing ether and token transfers of the
+// address were sent along with the number of those transfers. It returns zero transfers if there were none
+// or if they went to more than one address.
+func sweepTarget(address base.Address, txs []types.SimpleTransaction) (base.Address, int) {
+	targets := map[base.Address]int{}
+	for _, tx := range txs {
+		if tx.From == address && tx.Value.Sign() > 0 && !tx.To.IsZero() {
+			targets[tx.To]++
+		}
+		if tx.Receipt == nil {
+			continue
+		}
+		for _, log := range tx.Receipt.Logs {
+			if from, to, ok := transferOf(&log); ok && from == address {
+				targets[to]++
+			}
+		}
+	}
+
+	if len(targets) != 1 {
+		return base.Address{}, 0
+	}
+	for target, count := range targets {
+		return target, count
+	}
+	return base.Address{}, 0
+}
+
+// transferOf returns the sender and recipient of an ERC-20 Transfer log
+func transferOf(log *types.SimpleLog) (base.Address, base.Address, bool) {
+	if len(log.Topics) != 3 || log.Topics[0].Hex() != transferTopic {
+		return base.Address{}, base.Address{}, false
+	}
+	return base.HexToAddress(log.Topics[1].Hex()), base.HexToAddress(log.Topics[2].Hex()), true
+}
+
+// bestProposals returns the proposal with the highest confidence for each address sorted by address
+func bestProposals(proposals []simpleNameProposal) []simpleNameProposal {
+	best := map[base.Address]simpleNameProposal{}
+	for _, proposal := range proposals {
+		if existing, ok := best[proposal.Name.Address]; !ok || proposal.Confidence > existing.Confidence {
+			best[proposal.Name.Address] = proposal
+		}
+	}
+	ret := make([]simpleNameProposal, 0, len(best))
+	for _, proposal := range best {
+		ret = append(ret, proposal)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name.Address.Hex() < ret[j].Name.Address.Hex()
+	})
+	return ret
+}
+
+// contractTags returns the tags of a related named address if it is a contract tag and a generic
+// contract tag otherwise
+func contractTags(tags string) string {
+	if strings.HasPrefix(tags, "30-") || strings.HasPrefix(tags, "55-") {
+		return tags
+	}
+	return "30-Contracts"
+}
+
+// decodeAddress decodes an ABI encoded address
+func decodeAddress(data []byte) base.Address {
+	if len(data) != 32 {
+		return base.Address{}
+	}
+	return base.BytesToAddress(data[12:])
+}
+
+// decodeUint decodes an ABI encoded uint (which must fit in 64 bits)
+func decodeUint(data []byte) uint64 {
+	if len(data) != 32 {
+		return 0
+	}
+	for _, b := range data[:24] {
+		if b != 0 {
+			return 0
+		}
+	}
+	var ret uint64
+	for _, b := range data[24:] {
+		ret = ret<<8 | uint64(b)
+	}
+	return ret
+}
+
+// decodeAddresses decodes an ABI encoded (dynamic) array of addresses
+func decodeAddresses(data []byte) []base.Address {
+	if len(data) < 64 {
+		return nil
+	}
+	offset := decodeUint(data[:32])
+	if offset+32 > uint64(len(data)) {
+		return nil
+	}
+	count := decodeUint(data[offset : offset+32])
+	start := offset + 32
+	if count == 0 || count > uint64(len(data))/32 || start+count*32 > uint64(len(data)) {
+		return nil
+	}
+	ret := make([]base.Address, 0, count)
+	for i := uint64(0); i < count; i++ {
+		ret = append(ret, decodeAddress(data[start+i*32:start+(i+1)*32]))
+	}
+	return ret
+}
@@ -0,0 +1,93 @@
+package namesPkg
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func word(hex string) string {
+	return strings.Repeat("0", 64-len(hex)) + hex
+}
+
+func TestDecodeAddresses(t *testing.T) {
+	owner1 := "1f9090aae28b8a3dceadf281b0f12828e676c326"
+	owner2 := "000000000000541e251335090ac5b47176af4f7e"
+	data := base.Hex2Bytes(word("20") + word("2") + word(owner1) + word(owner2))
+
+	owners := decodeAddresses(data)
+	if len(owners) != 2 || owners[0] != base.HexToAddress("0x"+owner1) || owners[1] != base.HexToAddress("0x"+owner2) {
+		t.Error("unexpected owners", owners)
+	}
+
+	if owners := decodeAddresses(base.Hex2Bytes(word("20") + word("5") + word(owner1))); owners != nil {
+		t.Error("expected nil for a truncated array, got", owners)
+	}
+	if owners := decodeAddresses(base.Hex2Bytes(word(owner1))); owners != nil {
+		t.Error("expected nil for a non-array, got", owners)
+	}
+	if n := decodeUint(base.Hex2Bytes(word("ff" + strings.Repeat("0", 60)))); n != 0 {
+		t.Error("expected zero for a value that does not fit in 64 bits, got", n)
+	}
+}
+
+func TestSweepTarget(t *testing.T) {
+	deposit := base.HexToAddress("0x1")
+	hotWallet := base.HexToAddress("0x2")
+	user := base.HexToAddress("0x3")
+
+	transfer := func(from, to base.Address) types.SimpleTransaction {
+		return types.SimpleTransaction{
+			From: user,
+			To:   base.HexToAddress("0x4"),
+			Receipt: &types.SimpleReceipt{
+				Logs: []types.SimpleLog{{
+					Topics: []base.Hash{
+						base.HexToHash(transferTopic),
+						base.HexToHash(from.Hex()),
+						base.HexToHash(to.Hex()),
+					},
+				}},
+			},
+		}
+	}
+	send := func(from, to base.Address) types.SimpleTransaction {
+		return types.SimpleTransaction{From: from, To: to, Value: *big.NewInt(1)}
+	}
+
+	txs := []types.SimpleTransaction{
+		send(user, deposit),
+		send(deposit, hotWallet),
+		transfer(user, deposit),
+		transfer(deposit, hotWallet),
+	}
+	if target, sweeps := sweepTarget(deposit, txs); target != hotWallet || sweeps != 2 {
+		t.Error("expected two sweeps to the hot wallet, got", sweeps, "to", target)
+	}
+
+	txs = append(txs, send(deposit, user))
+	if _, sweeps := sweepTarget(deposit, txs); sweeps != 0 {
+		t.Error("expected no sweep target when sending to more than one address")
+	}
+
+	if _, sweeps := sweepTarget(user, txs[:1]); sweeps != 1 {
+		t.Error("expected a single send to count as a sweep")
+	}
+}
+
+func TestBestProposals(t *testing.T) {
+	a := base.HexToAddress("0x2")
+	b := base.HexToAddress("0x1")
+	proposals := []simpleNameProposal{
+		{Name: types.SimpleName{Address: a, Name: "low"}, Confidence: 0.3},
+		{Name: types.SimpleName{Address: a, Name: "high"}, Confidence: 0.9},
+		{Name: types.SimpleName{Address: b, Name: "only"}, Confidence: 0.5},
+	}
+	best := bestProposals(proposals)
+	if len(best) != 2 || best[0].Name.Name != "only" || best[1].Name.Name != "high" {
+		t.Error("unexpected best proposals", best)
+	}
+}
@@ -25,15 +25,25 @@ type NamesOptions struct {
 	Terms     []string              `json:"terms,omitempty"`     // A space separated list of one or more search terms
 	Expand    bool                  `json:"expand,omitempty"`    // Expand search to include all fields (search name, address, and symbol otherwise)
 	MatchCase bool                  `json:"matchCase,omitempty"` // Do case-sensitive search
+	Prefix    bool                  `json:"prefix,omitempty"`    // Match the terms against the start of words instead of as regular expressions
 	All       bool                  `json:"all,omitempty"`       // Include all (including custom) names in the search
 	Custom    bool                  `json:"custom,omitempty"`    // Include only custom named accounts in the search
 	Prefund   bool                  `json:"prefund,omitempty"`   // Include prefund accounts in the search
+	Ens       bool                  `json:"ens,omitempty"`       // Include ENS names in the search (resolving the primary name of any address terms)
 	Addr      bool                  `json:"addr,omitempty"`      // Display only addresses in the results (useful for scripting, assumes --no_header)
 	Tags      bool                  `json:"tags,omitempty"`      // Export the list of tags and subtags only
 	Clean     bool                  `json:"clean,omitempty"`     // Clean the data (addrs to lower case, sort by addr)
 	Regular   bool                  `json:"regular,omitempty"`   // Only available with --clean, cleans regular names database
-	DryRun    bool                  `json:"dryRun,omitempty"`    // Only available with --clean or --autoname, outputs changes to stdout instead of updating databases
+	DryRun    bool                  `json:"dryRun,omitempty"`    // Only available with --clean, --autoname, or --import, outputs changes to stdout instead of updating databases
 	Autoname  string                `json:"autoname,omitempty"`  // An address assumed to be a token, added automatically to names database if true
+	History   bool                  `json:"history,omitempty"`   // Show the logged changes to the custom names database (for the address terms, if any)
+	Rollback  string                `json:"rollback,omitempty"`  // Undo the changes made to the custom names database after the given date or timestamp
+	Pack      string                `json:"pack,omitempty"`      // Export the names matching the search terms to a name pack in the given file
+	Import    string                `json:"import,omitempty"`    // Import the names in the given name pack into the custom names database
+	Policy    string                `json:"policy,omitempty"`    // With --import, how to resolve names that differ from existing custom names
+	Signer    []string              `json:"signer,omitempty"`    // With --import, the address(es) of signers whose name packs are trusted (in addition to those in the config)
+	Untrusted bool                  `json:"untrusted,omitempty"` // With --import, import the name pack even if it is unsigned or its signer is not trusted
+	Label     bool                  `json:"label,omitempty"`     // Propose names from on-chain evidence for the unnamed counterparties of the monitored address terms
 	Create    bool                  `json:"create,omitempty"`    // Create a new name record
 	Update    bool                  `json:"update,omitempty"`    // Edit an existing name
 	Delete    bool                  `json:"delete,omitempty"`    // Delete a name, but do not remove it
@@ -45,25 +55,38 @@ type NamesOptions struct {
 	// EXISTING_CODE
 	crudData *CrudData
 	AutonameAddr base.Address `json:"-"`
+	rollbackTs   base.Timestamp
 	// EXISTING_CODE
 }
 
-var defaultNamesOptions = NamesOptions{}
+var defaultNamesOptions = NamesOptions{
+	Policy: "mine",
+}
 
 // testLog is used only during testing to export the options for this test case.
 func (opts *NamesOptions) testLog() {
 	logger.TestLog(len(opts.Terms) > 0, "Terms: ", opts.Terms)
 	logger.TestLog(opts.Expand, "Expand: ", opts.Expand)
 	logger.TestLog(opts.MatchCase, "MatchCase: ", opts.MatchCase)
+	logger.TestLog(opts.Prefix, "Prefix: ", opts.Prefix)
 	logger.TestLog(opts.All, "All: ", opts.All)
 	logger.TestLog(opts.Custom, "Custom: ", opts.Custom)
 	logger.TestLog(opts.Prefund, "Prefund: ", opts.Prefund)
+	logger.TestLog(opts.Ens, "Ens: ", opts.Ens)
 	logger.TestLog(opts.Addr, "Addr: ", opts.Addr)
 	logger.TestLog(opts.Tags, "Tags: ", opts.Tags)
 	logger.TestLog(opts.Clean, "Clean: ", opts.Clean)
 	logger.TestLog(opts.Regular, "Regular: ", opts.Regular)
 	logger.TestLog(opts.DryRun, "DryRun: ", opts.DryRun)
 	logger.TestLog(len(opts.Autoname) > 0, "Autoname: ", opts.Autoname)
+	logger.TestLog(opts.History, "History: ", opts.History)
+	logger.TestLog(len(opts.Rollback) > 0, "Rollback: ", opts.Rollback)
+	logger.TestLog(len(opts.Pack) > 0, "Pack: ", opts.Pack)
+	logger.TestLog(len(opts.Import) > 0, "Import: ", opts.Import)
+	logger.TestLog(len(opts.Policy) > 0 && opts.Policy != "mine", "Policy: ", opts.Policy)
+	logger.TestLog(len(opts.Signer) > 0, "Signer: ", opts.Signer)
+	logger.TestLog(opts.Untrusted, "Untrusted: ", opts.Untrusted)
+	logger.TestLog(opts.Label, "Label: ", opts.Label)
 	logger.TestLog(opts.Create, "Create: ", opts.Create)
 	logger.TestLog(opts.Update, "Update: ", opts.Update)
 	logger.TestLog(opts.Delete, "Delete: ", opts.Delete)
@@ -94,12 +117,16 @@ func namesFinishParseApi(w http.ResponseWriter, r *http.Request) *NamesOptions {
 			opts.Expand = true
 		case "matchCase":
 			opts.MatchCase = true
+		case "prefix":
+			opts.Prefix = true
 		case "all":
 			opts.All = true
 		case "custom":
 			opts.Custom = true
 		case "prefund":
 			opts.Prefund = true
+		case "ens":
+			opts.Ens = true
 		case "addr":
 			opts.Addr = true
 		case "tags":
@@ -112,6 +139,25 @@ func namesFinishParseApi(w http.ResponseWriter, r *http.Request) *NamesOptions {
 			opts.DryRun = true
 		case "autoname":
 			opts.Autoname = value[0]
+		case "history":
+			opts.History = true
+		case "rollback":
+			opts.Rollback = value[0]
+		case "pack":
+			opts.Pack = value[0]
+		case "import":
+			opts.Import = value[0]
+		case "policy":
+			opts.Policy = value[0]
+		case "signer":
+			for _, val := range value {
+				s := strings.Split(val, " ") // may contain space separated items
+				opts.Signer = append(opts.Signer, s...)
+			}
+		case "untrusted":
+			opts.Untrusted = true
+		case "label":
+			opts.Label = true
 		case "create":
 			opts.Create = true
 		case "update":
@@ -135,6 +181,7 @@ func namesFinishParseApi(w http.ResponseWriter, r *http.Request) *NamesOptions {
 	// EXISTING_CODE
 	opts.Terms, _ = opts.Conn.GetEnsAddresses(opts.Terms)
 	// EXISTING_CODE
+	opts.Signer, _ = opts.Conn.GetEnsAddresses(opts.Signer)
 
 	return opts
 }
@@ -164,6 +211,7 @@ func namesFinishParse(args []string) *NamesOptions {
 	opts.Terms = append(opts.Terms, args...)
 	opts.Terms, _ = opts.Conn.GetEnsAddresses(opts.Terms)
 	// EXISTING_CODE
+	opts.Signer, _ = opts.Conn.GetEnsAddresses(opts.Signer)
 	if len(opts.Globals.Format) == 0 || opts.Globals.Format == "none" {
 		opts.Globals.Format = defFmt
 	}
@@ -66,6 +66,16 @@ func (opts *NamesOptions) NamesInternal() error {
 	// EXISTING_CODE
 	if opts.anyCrud() {
 		err = opts.HandleCrud()
+	} else if opts.History {
+		err = opts.HandleHistory()
+	} else if len(opts.Rollback) > 0 {
+		err = opts.HandleRollback()
+	} else if opts.Label {
+		err = opts.HandleLabel()
+	} else if len(opts.Pack) > 0 {
+		err = opts.HandlePack()
+	} else if len(opts.Import) > 0 {
+		err = opts.HandleImport()
 	} else if len(opts.Autoname) > 0 {
 		err = opts.HandleAutoname()
 	} else if opts.Clean {
@@ -107,10 +117,18 @@ func (opts *NamesOptions) getType() names.Parts {
 		ret |= names.Regular
 	}
 
+	if opts.Ens {
+		ret |= names.Ens
+	}
+
 	if opts.MatchCase {
 		ret |= names.MatchCase
 	}
 
+	if opts.Prefix {
+		ret |= names.Prefix
+	}
+
 	if opts.Expand {
 		ret |= names.Expanded
 	}
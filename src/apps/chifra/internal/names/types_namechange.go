@@ -0,0 +1,100 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package namesPkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+// EXISTING_CODE
+
+type simpleNameChange struct {
+	Address   base.Address      `json:"address"`
+	New       *types.SimpleName `json:"new,omitempty"`
+	Old       *types.SimpleName `json:"old,omitempty"`
+	Operation string            `json:"operation"`
+	Timestamp base.Timestamp    `json:"timestamp"`
+	User      string            `json:"user"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleNameChange) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleNameChange) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"timestamp": s.Timestamp,
+		"date":      utils.FormattedDate(s.Timestamp),
+		"operation": s.Operation,
+		"user":      s.User,
+		"address":   s.Address,
+	}
+	order = []string{
+		"timestamp",
+		"date",
+		"operation",
+		"user",
+		"address",
+	}
+
+	nameOptions := map[string]any{"expand": true}
+	if format == "json" {
+		if s.Old != nil {
+			model["old"] = s.Old.Model(chain, format, verbose, nameOptions).Data
+			order = append(order, "old")
+		}
+		if s.New != nil {
+			model["new"] = s.New.Model(chain, format, verbose, nameOptions).Data
+			order = append(order, "new")
+		}
+	} else {
+		// The name as it was after the change (or, for a removal, before it)
+		name := s.New
+		if name == nil {
+			name = s.Old
+		}
+		if name == nil {
+			name = &types.SimpleName{}
+		}
+		model["tags"] = name.Tags
+		model["name"] = name.Name
+		model["deleted"] = name.Deleted
+		order = append(order, []string{"tags", "name", "deleted"}...)
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+func newSimpleNameChange(change *names.NameChange) *simpleNameChange {
+	return &simpleNameChange{
+		Address:   change.Address,
+		New:       change.New,
+		Old:       change.Old,
+		Operation: change.Operation,
+		Timestamp: change.Timestamp,
+		User:      change.User,
+	}
+}
+
+// EXISTING_CODE
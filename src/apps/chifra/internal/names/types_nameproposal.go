@@ -0,0 +1,73 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package namesPkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// EXISTING_CODE
+
+type simpleNameProposal struct {
+	Confidence float64          `json:"confidence"`
+	Evidence   string           `json:"evidence"`
+	Name       types.SimpleName `json:"name"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleNameProposal) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleNameProposal) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"address":    s.Name.Address,
+		"tags":       s.Name.Tags,
+		"name":       s.Name.Name,
+		"symbol":     s.Name.Symbol,
+		"decimals":   s.Name.Decimals,
+		"source":     s.Name.Source,
+		"confidence": s.Confidence,
+		"evidence":   s.Evidence,
+	}
+	order = []string{
+		"address",
+		"tags",
+		"name",
+		"symbol",
+		"decimals",
+		"source",
+		"confidence",
+		"evidence",
+	}
+
+	if format == "json" {
+		if len(s.Name.Symbol) == 0 {
+			delete(model, "symbol")
+		}
+		if s.Name.Decimals == 0 {
+			delete(model, "decimals")
+		}
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
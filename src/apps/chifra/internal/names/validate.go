@@ -5,9 +5,13 @@
 package namesPkg
 
 import (
+	"strconv"
+
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -24,9 +28,9 @@ func (opts *NamesOptions) validateNames() error {
 		return validate.Usage("chain {0} is not properly configured.", chain)
 	}
 
-	isDryRunnable := opts.Clean || len(opts.Autoname) > 0
+	isDryRunnable := opts.Clean || len(opts.Autoname) > 0 || len(opts.Import) > 0
 	if opts.DryRun && !isDryRunnable {
-		return validate.Usage("The {0} option is is only available with the {1} options.", "--dry_run", "--clean or --autoname")
+		return validate.Usage("The {0} option is is only available with the {1} options.", "--dry_run", "--clean, --autoname, or --import")
 	}
 
 	if opts.Tags {
@@ -57,12 +61,116 @@ func (opts *NamesOptions) validateNames() error {
 		return validate.Usage("The {0} option requires at least one {1}.", "--match_case", "term")
 	}
 
+	if opts.Prefix && len(opts.Terms) == 0 {
+		return validate.Usage("The {0} option requires at least one {1}.", "--prefix", "term")
+	}
+
 	if opts.Prefund {
 		if opts.Clean || len(opts.Autoname) > 0 || opts.anyCrud() {
 			return validate.Usage("You may not use the {0} option when editing names.", "--prefund")
 		}
 	}
 
+	if opts.Ens {
+		if opts.Clean || len(opts.Autoname) > 0 || opts.anyCrud() {
+			return validate.Usage("You may not use the {0} option when editing names.", "--ens")
+		}
+		if opts.Tags {
+			return validate.Usage("The {0} option is not available with the {1} option.", "--ens", "--tags")
+		}
+	}
+
+	if opts.History || len(opts.Rollback) > 0 {
+		if opts.Clean || len(opts.Autoname) > 0 || opts.anyCrud() || opts.Tags || opts.Addr || opts.Prefund || opts.Ens {
+			return validate.Usage("The {0} options are not available with any other option.", "--history and --rollback")
+		}
+		if opts.History && len(opts.Rollback) > 0 {
+			return validate.Usage("The {0} option is not available with the {1} option.", "--history", "--rollback")
+		}
+	}
+
+	if opts.History {
+		for _, term := range opts.Terms {
+			if !base.IsValidAddress(term) {
+				return validate.Usage("The {0} option requires that all terms be addresses.", "--history")
+			}
+		}
+	}
+
+	if len(opts.Rollback) > 0 {
+		if len(opts.Terms) > 0 {
+			return validate.Usage("The {0} option does not accept any terms.", "--rollback")
+		}
+		if validate.IsDateTimeString(opts.Rollback) {
+			opts.rollbackTs, _ = tslib.FromDateToTs(opts.Rollback)
+		} else if ts, err := strconv.ParseInt(opts.Rollback, 10, 64); err == nil && ts > 0 {
+			opts.rollbackTs = ts
+		} else {
+			return validate.Usage("The {0} option requires a date (YYYY-MM-DDTHH:MM:SS) or a timestamp.", "--rollback")
+		}
+	}
+
+	if opts.Label {
+		if opts.Clean || len(opts.Autoname) > 0 || opts.anyCrud() || opts.Tags || opts.Addr || opts.Ens || opts.History || len(opts.Rollback) > 0 || len(opts.Import) > 0 {
+			return validate.Usage("The {0} option is not available with any other option except {1}.", "--label", "--pack")
+		}
+		if len(opts.Terms) == 0 {
+			return validate.Usage("The {0} option requires at least one {1}.", "--label", "monitored address")
+		}
+		for _, term := range opts.Terms {
+			if !base.IsValidAddress(term) {
+				return validate.Usage("The {0} option requires that all terms be addresses.", "--label")
+			}
+		}
+	}
+
+	if (len(opts.Pack) > 0 && !opts.Label) || len(opts.Import) > 0 {
+		if opts.Clean || len(opts.Autoname) > 0 || opts.anyCrud() || opts.Tags || opts.Addr || opts.Ens || opts.History || len(opts.Rollback) > 0 {
+			return validate.Usage("The {0} options are not available with any other option.", "--pack and --import")
+		}
+		if len(opts.Pack) > 0 && len(opts.Import) > 0 {
+			return validate.Usage("The {0} option is not available with the {1} option.", "--pack", "--import")
+		}
+	}
+
+	if (len(opts.Pack) > 0 || len(opts.Import) > 0) && opts.Globals.IsApiMode() {
+		return validate.Usage("The {0} options are not available{1}.", "--pack and --import", " in API mode")
+	}
+
+	if len(opts.Import) > 0 {
+		if len(opts.Terms) > 0 {
+			return validate.Usage("The {0} option does not accept any terms.", "--import")
+		}
+		if !file.FileExists(opts.Import) {
+			return validate.Usage("The {0} option ({1}) must be an existing file.", "--import", opts.Import)
+		}
+	}
+
+	if len(opts.Policy) > 0 && opts.Policy != "mine" && len(opts.Import) == 0 {
+		return validate.Usage("The {0} option is only available with the {1} option.", "--policy", "--import")
+	}
+
+	if err := validate.ValidateEnum("--policy", opts.Policy, "[mine|theirs|priority]"); err != nil {
+		return err
+	}
+
+	if opts.Policy == "priority" {
+		settings := config.GetNames()
+		if len(settings.PriorityList()) == 0 {
+			return validate.Usage("The {0} option requires {1} in the [names] section of the config.", "--policy priority", "sourcePriority")
+		}
+	}
+
+	if (len(opts.Signer) > 0 || opts.Untrusted) && len(opts.Import) == 0 {
+		return validate.Usage("The {0} options are only available with the {1} option.", "--signer and --untrusted", "--import")
+	}
+
+	for _, signer := range opts.Signer {
+		if !base.IsValidAddress(signer) {
+			return validate.Usage("The {0} option ({1}) must be an address.", "--signer", signer)
+		}
+	}
+
 	if len(opts.Autoname) > 0 {
 		if opts.Regular {
 			return validate.Usage("The {0} option is not available with the {1} option.", "--regular", "--autoname")
@@ -118,6 +118,9 @@ func (bm *BlazeManager) Consolidate(blocks []base.Blknum) (error, bool) {
 				report.FileSize = file.FileSize(chunkPath)
 				report.Report()
 			}
+			if err := index.UpdatePostingLists(chain, chunkRange, appMap); err != nil {
+				logger.Warn("Could not update posting lists:", err)
+			}
 			if err := Notify(notify.Notification[string]{
 				Msg:     notify.MessageChunkWritten,
 				Meta:    bm.meta,
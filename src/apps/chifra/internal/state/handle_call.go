@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/articulate"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -19,6 +20,9 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
 )
 
+// HandleCall calls the --call function on each of the addresses at each block. At a given block, the calls
+// to all of the addresses are aggregated with Multicall (which makes Multicall3 the calls' msg.sender). A
+// call to a single address, or every call with --no_multicall, is made directly.
 func (opts *StateOptions) HandleCall() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
@@ -28,9 +32,13 @@ func (opts *StateOptions) HandleCall() error {
 		return articulate.ArticulateFunction(function, "", str[2:])
 	}
 
-	callAddress := base.HexToAddress(opts.Addrs[0])
-	if opts.ProxyFor != "" {
-		callAddress = base.HexToAddress(opts.ProxyFor)
+	templates := make([]*call.ContractCall, 0, len(opts.Addrs))
+	for _, callAddress := range opts.callAddresses() {
+		contractCall, _, err := call.NewContractCall(opts.Conn, callAddress, opts.Call)
+		if err != nil {
+			return fmt.Errorf("the --call value provided (%s) was not found: %s", opts.Call, err)
+		}
+		templates = append(templates, contractCall)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -49,28 +57,62 @@ func (opts *StateOptions) HandleCall() error {
 				Total:   int64(cnt),
 			})
 
-			for _, thisMap := range sliceOfMaps {
-				thisMap := thisMap
-				for app := range thisMap {
-					thisMap[app] = new(types.SimpleResult)
+			for _, blockMap := range sliceOfMaps {
+				// Each block produces one result per address
+				thisMap := make(map[types.SimpleAppearance]*[]types.SimpleResult, len(blockMap))
+				for app := range blockMap {
+					thisMap[app] = new([]types.SimpleResult)
 				}
 
-				iterFunc := func(app types.SimpleAppearance, value *types.SimpleResult) error {
-					bn := uint64(app.BlockNumber)
-					if contractCall, _, err := call.NewContractCall(opts.Conn, callAddress, opts.Call); err != nil {
+				// Calls that fail at a block do not prevent the others from being reported
+				var callErrs []error
+				var callErrsMutex sync.Mutex
+
+				iterFunc := func(app types.SimpleAppearance, value *[]types.SimpleResult) error {
+					if len(templates) == 1 || opts.NoMulticall {
+						// These calls are made with eth_call directly, which preserves the caller and the
+						// node's report of why a call failed
+						for _, template := range templates {
+							contractCall := *template
+							contractCall.BlockNumber = uint64(app.BlockNumber)
+							result, err := contractCall.Call(artFunc)
+							if err != nil {
+								if len(templates) == 1 {
+									delete(thisMap, app)
+									return err
+								}
+								callErrsMutex.Lock()
+								callErrs = append(callErrs, err)
+								callErrsMutex.Unlock()
+								continue
+							}
+							*value = append(*value, *result)
+						}
+						bar.Tick()
+						return nil
+					}
+
+					calls := make([]*call.ContractCall, 0, len(templates))
+					for _, template := range templates {
+						contractCall := *template
+						contractCall.BlockNumber = uint64(app.BlockNumber)
+						calls = append(calls, &contractCall)
+					}
+
+					results, errs, err := call.CallMany(opts.Conn, calls, artFunc)
+					if err != nil {
 						delete(thisMap, app)
-						return fmt.Errorf("the --call value provided (%s) was not found: %s", opts.Call, err)
-
-					} else {
-						contractCall.BlockNumber = bn
-						results, err := contractCall.Call(artFunc)
-						if err != nil {
-							delete(thisMap, app)
-							return err
-						} else {
-							bar.Tick()
-							*value = *results
+						return err
+					}
+					bar.Tick()
+					for i, result := range results {
+						if errs[i] != nil {
+							callErrsMutex.Lock()
+							callErrs = append(callErrs, errs[i])
+							callErrsMutex.Unlock()
+							continue
 						}
+						*value = append(*value, *result)
 					}
 					return nil
 				}
@@ -85,14 +127,19 @@ func (opts *StateOptions) HandleCall() error {
 						nErrors++
 					}
 				}
+				for _, err := range callErrs {
+					if !testMode || nErrors == 0 {
+						errorChan <- err
+						nErrors++
+					}
+				}
 
-				items := make([]types.SimpleResult, 0, len(thisMap))
+				items := make([]types.SimpleResult, 0, len(thisMap)*len(templates))
 				for _, v := range thisMap {
-					v := v
-					items = append(items, *v)
+					items = append(items, *v...)
 				}
 
-				sort.Slice(items, func(i, j int) bool {
+				sort.SliceStable(items, func(i, j int) bool {
 					return items[i].BlockNumber < items[j].BlockNumber
 				})
 
@@ -111,3 +158,15 @@ func (opts *StateOptions) HandleCall() error {
 
 	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extra))
 }
+
+// callAddresses returns the addresses of the contracts called by --call
+func (opts *StateOptions) callAddresses() []base.Address {
+	if opts.ProxyFor != "" {
+		return []base.Address{base.HexToAddress(opts.ProxyFor)}
+	}
+	ret := make([]base.Address, 0, len(opts.Addrs))
+	for _, addr := range opts.Addrs {
+		ret = append(ret, base.HexToAddress(addr))
+	}
+	return ret
+}
@@ -0,0 +1,175 @@
+package statePkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// HandleDiff reports, for each transaction in the given blocks, the changes the transaction made to the
+// balance, nonce, code, and storage of each of the addresses. If --slots is also present, only changes to
+// those storage slots are reported.
+func (opts *StateOptions) HandleDiff() error {
+	chain := opts.Globals.Chain
+
+	addresses := make([]base.Address, 0, len(opts.Addrs))
+	for _, addr := range opts.Addrs {
+		addresses = append(addresses, base.HexToAddress(addr))
+	}
+
+	variables := make(map[base.Hash]string, len(opts.Locations))
+	for _, loc := range opts.Locations {
+		variables[loc.Slot] = loc.Spec
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for _, br := range opts.BlockIds {
+			blockNums, err := br.ResolveBlocks(chain)
+			if err != nil {
+				errorChan <- err
+				if errors.Is(err, ethereum.NotFound) {
+					continue
+				}
+				cancel()
+				return
+			}
+
+			for _, bn := range blockNums {
+				diffs, err := opts.Conn.GetStateDiffsByBlock(bn)
+				if err != nil {
+					errorChan <- fmt.Errorf("the --diff option could not trace block %d (the node must support debug_traceBlockByNumber): %w", bn, err)
+					cancel()
+					return
+				}
+
+				ts := base.Timestamp(0)
+				if opts.Globals.Verbose {
+					ts, _ = tslib.FromBnToTs(chain, bn)
+				}
+
+				for _, diff := range diffs {
+					for _, address := range addresses {
+						for _, change := range stateChanges(&diff, address, variables) {
+							change.BlockNumber = bn
+							change.Timestamp = ts
+							modelChan <- change
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// stateChanges returns the changes a transaction made to the state of an address. If variables is not
+// empty, only changes to those storage slots are returned.
+func stateChanges(diff *rpc.PrestateDiff, address base.Address, variables map[base.Hash]string) []*simpleStateDiff {
+	pre, inPre := diff.Pre[address]
+	post, inPost := diff.Post[address]
+	if !inPre && !inPost {
+		return nil
+	}
+	destroyed := inPre && !inPost
+
+	ret := []*simpleStateDiff{}
+	add := func(field, before, after string) *simpleStateDiff {
+		change := &simpleStateDiff{
+			TransactionIndex: diff.TransactionIndex,
+			TransactionHash:  diff.TransactionHash,
+			Address:          address,
+			Field:            field,
+			Before:           before,
+			After:            after,
+		}
+		ret = append(ret, change)
+		return change
+	}
+
+	if len(variables) == 0 {
+		if len(post.Balance) > 0 || (destroyed && len(pre.Balance) > 0) {
+			if before, after := weiString(pre.Balance), weiString(post.Balance); before != after {
+				add("balance", before, after)
+			}
+		}
+		if post.Nonce != 0 || (destroyed && pre.Nonce != 0) {
+			if pre.Nonce != post.Nonce {
+				add("nonce", fmt.Sprintf("%d", pre.Nonce), fmt.Sprintf("%d", post.Nonce))
+			}
+		}
+		if len(post.Code) > 0 || (destroyed && len(pre.Code) > 0) {
+			if before, after := codeHash(pre.Code), codeHash(post.Code); before != after {
+				add("code", before, after)
+			}
+		}
+	}
+
+	toHashes := func(storage map[string]string) map[base.Hash]base.Hash {
+		ret := make(map[base.Hash]base.Hash, len(storage))
+		for slot, value := range storage {
+			ret[base.HexToHash(slot)] = base.HexToHash(value)
+		}
+		return ret
+	}
+	preStorage, postStorage := toHashes(pre.Storage), toHashes(post.Storage)
+
+	// A slot found in pre but not in post was cleared
+	slots := make([]base.Hash, 0, len(preStorage)+len(postStorage))
+	for _, storage := range []map[base.Hash]base.Hash{postStorage, preStorage} {
+		for slot := range storage {
+			if _, ok := variables[slot]; ok || len(variables) == 0 {
+				slots = append(slots, slot)
+			}
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].Hex() < slots[j].Hex()
+	})
+
+	for i, slot := range slots {
+		if i > 0 && slots[i-1] == slot {
+			continue
+		}
+		before, after := preStorage[slot], postStorage[slot]
+		if before == after {
+			continue
+		}
+		change := add("storage", before.Hex(), after.Hex())
+		change.Slot = slot
+		change.Variable = variables[slot]
+	}
+
+	return ret
+}
+
+// weiString converts a prestate tracer's hex balance to a decimal string
+func weiString(hex string) string {
+	if len(hex) < 3 {
+		return "0"
+	}
+	value, ok := new(big.Int).SetString(hex[2:], 16)
+	if !ok {
+		return hex
+	}
+	return value.String()
+}
+
+// codeHash returns the hash of the given hex code (or 0x if there is no code)
+func codeHash(code string) string {
+	if len(code) < 3 {
+		return "0x"
+	}
+	return "0x" + base.Bytes2Hex(crypto.Keccak256(base.Hex2Bytes(code[2:])))
+}
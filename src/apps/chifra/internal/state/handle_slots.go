@@ -0,0 +1,80 @@
+package statePkg
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum"
+)
+
+// HandleSlots reports the value of each of the --slots locations for each address at each block. All
+// of the slots of an address at a given block are read in a single batch.
+func (opts *StateOptions) HandleSlots() error {
+	chain := opts.Globals.Chain
+
+	slots := make([]base.Hash, 0, len(opts.Locations))
+	for _, loc := range opts.Locations {
+		slots = append(slots, loc.Slot)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fetchData := func(modelChan chan types.Modeler[types.RawModeler], errorChan chan error) {
+		for _, addressStr := range opts.Addrs {
+			address := base.HexToAddress(addressStr)
+			previous := make([]*base.Hash, len(slots))
+			for _, br := range opts.BlockIds {
+				blockNums, err := br.ResolveBlocks(chain)
+				if err != nil {
+					errorChan <- err
+					if errors.Is(err, ethereum.NotFound) {
+						continue
+					}
+					cancel()
+					return
+				}
+
+				for _, bn := range blockNums {
+					values, err := opts.Conn.GetStorageAt(address, slots, bn)
+					if err != nil {
+						errorChan <- err
+						continue
+					}
+
+					ts := base.Timestamp(0)
+					if opts.Globals.Verbose {
+						ts, _ = tslib.FromBnToTs(chain, bn)
+					}
+
+					for i, loc := range opts.Locations {
+						value := values[i]
+						if opts.Changes {
+							if previous[i] != nil && *previous[i] == value {
+								continue
+							}
+							previous[i] = &value
+						}
+						if opts.NoZero && value.IsZero() {
+							continue
+						}
+						modelChan <- &simpleStorageSlot{
+							BlockNumber: bn,
+							Timestamp:   ts,
+							Address:     address,
+							Variable:    loc.Spec,
+							Slot:        loc.Slot,
+							Value:       value,
+							Type:        loc.Type,
+							Decoded:     loc.Decode(value.Bytes()),
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
@@ -18,25 +18,31 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/identifiers"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/storage"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
 // StateOptions provides all command options for the chifra state command.
 type StateOptions struct {
-	Addrs      []string                 `json:"addrs,omitempty"`      // One or more addresses (0x...) from which to retrieve balances
-	Blocks     []string                 `json:"blocks,omitempty"`     // An optional list of one or more blocks at which to report balances, defaults to 'latest'
-	BlockIds   []identifiers.Identifier `json:"blockIds,omitempty"`   // Block identifiers
-	Parts      []string                 `json:"parts,omitempty"`      // Control which state to export
-	Changes    bool                     `json:"changes,omitempty"`    // Only report a balance when it changes from one block to the next
-	NoZero     bool                     `json:"noZero,omitempty"`     // Suppress the display of zero balance accounts
-	Call       string                   `json:"call,omitempty"`       // Call a smart contract with a solidity syntax, a four-byte and parameters, or encoded call data
-	Articulate bool                     `json:"articulate,omitempty"` // For the --call option only, articulate the retrieved data if ABIs can be found
-	ProxyFor   string                   `json:"proxyFor,omitempty"`   // For the --call option only, redirects calls to this implementation
-	Globals    globals.GlobalOptions    `json:"globals,omitempty"`    // The global options
-	Conn       *rpc.Connection          `json:"conn,omitempty"`       // The connection to the RPC server
-	BadFlag    error                    `json:"badFlag,omitempty"`    // An error flag if needed
+	Addrs       []string                 `json:"addrs,omitempty"`       // One or more addresses (0x...) from which to retrieve balances
+	Blocks      []string                 `json:"blocks,omitempty"`      // An optional list of one or more blocks at which to report balances, defaults to 'latest'
+	BlockIds    []identifiers.Identifier `json:"blockIds,omitempty"`    // Block identifiers
+	Parts       []string                 `json:"parts,omitempty"`       // Control which state to export
+	Changes     bool                     `json:"changes,omitempty"`     // Only report a balance when it changes from one block to the next
+	NoZero      bool                     `json:"noZero,omitempty"`      // Suppress the display of zero balance accounts
+	Call        string                   `json:"call,omitempty"`        // Call a smart contract with a solidity syntax, a four-byte and parameters, or encoded call data
+	Articulate  bool                     `json:"articulate,omitempty"`  // For the --call option only, articulate the retrieved data if ABIs can be found
+	ProxyFor    string                   `json:"proxyFor,omitempty"`    // For the --call option only, redirects calls to this implementation
+	NoMulticall bool                     `json:"noMulticall,omitempty"` // For the --call option only, call each address with its own eth_call instead of aggregating the calls with Multicall3
+	Slots       []string                 `json:"slots,omitempty"`       // Report the value of each of the given storage slots (a slot number or, with --layout, a variable) of the address(es)
+	Layout      string                   `json:"layout,omitempty"`      // For the --slots option only, a Solidity storage layout file used to locate variables, mapping entries, and array elements
+	Diff        bool                     `json:"diff,omitempty"`        // For each transaction in the given block(s), report the changes to the address(es) state using the node's prestate tracer
+	Globals     globals.GlobalOptions    `json:"globals,omitempty"`     // The global options
+	Conn        *rpc.Connection          `json:"conn,omitempty"`        // The connection to the RPC server
+	BadFlag     error                    `json:"badFlag,omitempty"`     // An error flag if needed
 	// EXISTING_CODE
-	ProxyForAddr base.Address `json:"-"`
+	ProxyForAddr base.Address        `json:"-"`
+	Locations    []*storage.Location `json:"-"`
 	// EXISTING_CODE
 }
 
@@ -52,6 +58,10 @@ func (opts *StateOptions) testLog() {
 	logger.TestLog(len(opts.Call) > 0, "Call: ", opts.Call)
 	logger.TestLog(opts.Articulate, "Articulate: ", opts.Articulate)
 	logger.TestLog(len(opts.ProxyFor) > 0, "ProxyFor: ", opts.ProxyFor)
+	logger.TestLog(opts.NoMulticall, "NoMulticall: ", opts.NoMulticall)
+	logger.TestLog(len(opts.Slots) > 0, "Slots: ", opts.Slots)
+	logger.TestLog(len(opts.Layout) > 0, "Layout: ", opts.Layout)
+	logger.TestLog(opts.Diff, "Diff: ", opts.Diff)
 	opts.Conn.TestLog(opts.getCaches())
 	opts.Globals.TestLog()
 }
@@ -93,6 +103,17 @@ func stateFinishParseApi(w http.ResponseWriter, r *http.Request) *StateOptions {
 			opts.Articulate = true
 		case "proxyFor":
 			opts.ProxyFor = value[0]
+		case "noMulticall":
+			opts.NoMulticall = true
+		case "slots":
+			for _, val := range value {
+				s := strings.Split(val, " ") // may contain space separated items
+				opts.Slots = append(opts.Slots, s...)
+			}
+		case "layout":
+			opts.Layout = value[0]
+		case "diff":
+			opts.Diff = true
 		default:
 			if !copy.Globals.Caps.HasKey(key) {
 				opts.BadFlag = validate.Usage("Invalid key ({0}) in {1} route.", key, "state")
@@ -56,6 +56,10 @@ func (opts *StateOptions) StateInternal() error {
 		err = opts.HandleDecache()
 	} else if opts.Call != "" {
 		err = opts.HandleCall()
+	} else if opts.Diff {
+		err = opts.HandleDiff()
+	} else if len(opts.Slots) > 0 {
+		err = opts.HandleSlots()
 	} else {
 		err = opts.HandleShow()
 	}
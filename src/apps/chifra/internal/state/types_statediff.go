@@ -0,0 +1,80 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package statePkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// EXISTING_CODE
+
+type simpleStateDiff struct {
+	Address          base.Address   `json:"address"`
+	After            string         `json:"after"`
+	Before           string         `json:"before"`
+	BlockNumber      base.Blknum    `json:"blockNumber"`
+	Field            string         `json:"field"`
+	Slot             base.Hash      `json:"slot,omitempty"`
+	Timestamp        base.Timestamp `json:"timestamp"`
+	TransactionHash  base.Hash      `json:"transactionHash"`
+	TransactionIndex base.Txnum     `json:"transactionIndex"`
+	Variable         string         `json:"variable,omitempty"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleStateDiff) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleStateDiff) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"blockNumber":      s.BlockNumber,
+		"transactionIndex": s.TransactionIndex,
+		"transactionHash":  s.TransactionHash,
+		"address":          s.Address,
+		"field":            s.Field,
+		"before":           s.Before,
+		"after":            s.After,
+	}
+	order = []string{
+		"blockNumber",
+		"transactionIndex",
+		"transactionHash",
+		"address",
+		"field",
+	}
+
+	if format != "json" || s.Field == "storage" {
+		model["slot"] = s.Slot
+		model["variable"] = s.Variable
+		order = append(order, []string{"slot", "variable"}...)
+	}
+	order = append(order, []string{"before", "after"}...)
+
+	if verbose {
+		model["timestamp"] = s.Timestamp
+		order = append([]string{"blockNumber", "timestamp"}, order[1:]...)
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
@@ -0,0 +1,73 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were generated with makeClass --run. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package statePkg
+
+// EXISTING_CODE
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// EXISTING_CODE
+
+type simpleStorageSlot struct {
+	Address     base.Address   `json:"address"`
+	BlockNumber base.Blknum    `json:"blockNumber"`
+	Decoded     string         `json:"decoded"`
+	Slot        base.Hash      `json:"slot"`
+	Timestamp   base.Timestamp `json:"timestamp"`
+	Type        string         `json:"type"`
+	Value       base.Hash      `json:"value"`
+	Variable    string         `json:"variable"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s *simpleStorageSlot) Raw() *types.RawModeler {
+	return nil
+}
+
+func (s *simpleStorageSlot) Model(chain, format string, verbose bool, extraOptions map[string]any) types.Model {
+	var model = map[string]interface{}{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"blockNumber": s.BlockNumber,
+		"address":     s.Address,
+		"variable":    s.Variable,
+		"slot":        s.Slot,
+		"value":       s.Value,
+		"type":        s.Type,
+		"decoded":     s.Decoded,
+	}
+	order = []string{
+		"blockNumber",
+		"address",
+		"variable",
+		"slot",
+		"value",
+		"type",
+		"decoded",
+	}
+
+	if verbose {
+		model["timestamp"] = s.Timestamp
+		order = append([]string{"blockNumber", "timestamp"}, order[1:]...)
+	}
+	// EXISTING_CODE
+
+	return types.Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
@@ -9,10 +9,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/call"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/storage"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -42,6 +43,54 @@ func (opts *StateOptions) validateState() error {
 		// do nothing for now
 
 	} else {
+		if len(opts.Slots) > 0 || opts.Diff {
+			if len(opts.Call) > 0 {
+				return validate.Usage("Please choose only one of {0}.", "--call, --slots, or --diff")
+			}
+			if len(opts.Parts) > 0 {
+				return validate.Usage("The {0} option is not available{1}.", "--parts", " with the --slots or --diff options")
+			}
+		}
+
+		if opts.Diff {
+			if opts.Changes {
+				return validate.Usage("The {0} option is not available{1}.", "--changes", " with the --diff option")
+			}
+			if opts.NoZero {
+				return validate.Usage("The {0} option is not available{1}.", "--no_zero", " with the --diff option")
+			}
+		}
+
+		if len(opts.Layout) > 0 {
+			if len(opts.Slots) == 0 {
+				return validate.Usage("The {0} option is only available with the {1} option.", "--layout", "--slots")
+			}
+			if opts.Globals.IsApiMode() {
+				return validate.Usage("The {0} option is not available{1}.", "--layout", " in API mode")
+			}
+			if !file.FileExists(opts.Layout) {
+				return validate.Usage("The {0} option ({1}) must {2}.", "--layout", opts.Layout, "be an existing file")
+			}
+		}
+
+		if len(opts.Slots) > 0 {
+			var layout *storage.Layout
+			if len(opts.Layout) > 0 {
+				var err error
+				if layout, err = storage.LoadLayout(opts.Layout); err != nil {
+					return validate.Usage("The {0} option ({1}) is invalid: {2}.", "--layout", opts.Layout, err.Error())
+				}
+			}
+			opts.Locations = make([]*storage.Location, 0, len(opts.Slots))
+			for _, spec := range opts.Slots {
+				loc, err := storage.Resolve(spec, layout)
+				if err != nil {
+					return validate.Usage("The {0} value ({1}) is invalid: {2}.", "--slots", spec, err.Error())
+				}
+				opts.Locations = append(opts.Locations, loc)
+			}
+		}
+
 		if len(opts.Call) > 0 {
 			if len(opts.Parts) > 0 {
 				return validate.Usage("The {0} option is not available{1}.", "--parts", " with the --call option")
@@ -55,30 +104,31 @@ func (opts *StateOptions) validateState() error {
 				return validate.Usage("The {0} option is not available{1}.", "--no_zero", " with the --call option")
 			}
 
-			if len(opts.Addrs) != 1 {
-				return validate.Usage("Exactly one address is required for the {0} option.", "--call")
+			if len(opts.Addrs) == 0 {
+				return validate.Usage("At least one address is required for the {0} option.", "--call")
 			}
 
-			contract := opts.Addrs[0]
-			if len(opts.ProxyFor) > 0 {
-				contract = opts.ProxyFor
+			if len(opts.ProxyFor) > 0 && len(opts.Addrs) != 1 {
+				return validate.Usage("Exactly one address is required for the {0} option.", "--proxy_for")
 			}
 
-			err := opts.Conn.IsContractAt(base.HexToAddress(contract), nil)
-			if err != nil {
-				if errors.Is(err, rpc.ErrNotAContract) {
-					return validate.Usage("The address for the --call option must be a smart contract.")
+			for _, callAddress := range opts.callAddresses() {
+				err := opts.Conn.IsContractAt(callAddress, nil)
+				if err != nil {
+					if errors.Is(err, rpc.ErrNotAContract) {
+						return validate.Usage("The address for the --call option must be a smart contract.")
+					}
+					return err
 				}
-				return err
 			}
 
 			// Before we do anythinng, let's just make sure we have a valid four-byte
-			callAddress := base.HexToAddress(opts.Addrs[0])
-			if opts.ProxyFor != "" {
-				callAddress = base.HexToAddress(opts.ProxyFor)
-			}
 			// TODO: Can't we preserve the results of this so we don't have to do it later?
-			if _, suggestions, err := call.NewContractCall(opts.Conn, callAddress, opts.Call); err != nil {
+			for _, callAddress := range opts.callAddresses() {
+				_, suggestions, err := call.NewContractCall(opts.Conn, callAddress, opts.Call)
+				if err == nil {
+					continue
+				}
 				message := fmt.Sprintf("the --call value provided (%s) was not found: %s", opts.Call, err)
 				if len(suggestions) > 0 {
 					if len(suggestions) > 0 {
@@ -103,6 +153,10 @@ func (opts *StateOptions) validateState() error {
 				return validate.Usage("The {0} option is only available with the {1} option.", "--proxy_for", "--call")
 			}
 
+			if opts.NoMulticall {
+				return validate.Usage("The {0} option is only available with the {1} option.", "--no_multicall", "--call")
+			}
+
 			err := validate.ValidateAtLeastOneAddr(opts.Addrs)
 			if err != nil {
 				return err
@@ -2,7 +2,6 @@ package tokensPkg
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -10,50 +9,49 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
-	"github.com/ethereum/go-ethereum"
 )
 
+// HandleParts reports on the tokens themselves, token by token and, for each token, block by block.
+// The parts of a token are queried together with Multicall.
 func (opts *TokensOptions) HandleParts() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
 
+	tokens := make([]base.Address, 0, len(opts.Addrs))
+	for _, address := range opts.Addrs {
+		tokens = append(tokens, base.HexToAddress(address))
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	fetchData := func(modelChan chan types.Modeler[types.RawToken], errorChan chan error) {
-		for _, address := range opts.Addrs {
-			addr := base.HexToAddress(address)
-			currentBn := uint64(0)
-			currentTs := base.Timestamp(0)
-			for _, br := range opts.BlockIds {
-				blockNums, err := br.ResolveBlocks(chain)
+		blockNums, ok := opts.resolveBlocks(errorChan)
+		if !ok {
+			cancel()
+			return
+		}
+
+		for _, token := range tokens {
+			for _, bn := range blockNums {
+				states, err := opts.Conn.GetTokenStatesAt([]base.Address{token}, bn)
 				if err != nil {
 					errorChan <- err
-					if errors.Is(err, ethereum.NotFound) {
-						continue
-					}
 					cancel()
 					return
 				}
-
-				for _, bn := range blockNums {
-					if state, err := opts.Conn.GetTokenState(addr, fmt.Sprintf("0x%x", bn)); err != nil {
-						errorChan <- err
-					} else {
-						s := &types.SimpleToken{
-							Address:     state.Address,
-							BlockNumber: bn,
-							TotalSupply: state.TotalSupply,
-							Decimals:    uint64(state.Decimals),
-						}
-						if opts.Globals.Verbose {
-							if bn == 0 || bn != currentBn {
-								currentTs, _ = tslib.FromBnToTs(chain, bn)
-							}
-							s.Timestamp = currentTs
-							currentBn = bn
-						}
-						modelChan <- s
-					}
+				if states[0] == nil {
+					errorChan <- fmt.Errorf("address %s is not a token at block %d", token.Hex(), bn)
+					continue
+				}
+				s := &types.SimpleToken{
+					Address:     states[0].Address,
+					BlockNumber: bn,
+					TotalSupply: states[0].TotalSupply,
+					Decimals:    uint64(states[0].Decimals),
+				}
+				if opts.Globals.Verbose {
+					s.Timestamp, _ = tslib.FromBnToTs(chain, bn)
 				}
+				modelChan <- s
 			}
 		}
 	}
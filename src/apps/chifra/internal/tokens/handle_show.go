@@ -13,48 +13,56 @@ import (
 	"github.com/ethereum/go-ethereum"
 )
 
+// HandleShow reports token balances. Without --by_acct, the first address is the token and the
+// remaining addresses are holders. With --by_acct, the last address is the holder and the others are
+// tokens. Balances are reported holder by holder and, for each holder, block by block. At each block,
+// the holder's balances in every token are queried together with Multicall.
 func (opts *TokensOptions) HandleShow() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
-	tokenAddr := base.HexToAddress(opts.Addrs[0])
+
+	tokens, holders := opts.tokensAndHolders()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	fetchData := func(modelChan chan types.Modeler[types.RawToken], errorChan chan error) {
-		for _, address := range opts.Addrs[1:] {
-			addr := base.HexToAddress(address)
-			currentBn := uint64(0)
-			currentTs := base.Timestamp(0)
-			for _, br := range opts.BlockIds {
-				blockNums, err := br.ResolveBlocks(chain)
+		blockNums, ok := opts.resolveBlocks(errorChan)
+		if !ok {
+			cancel()
+			return
+		}
+
+		for _, holder := range holders {
+			pairHolders := make([]base.Address, len(tokens))
+			for t := range tokens {
+				pairHolders[t] = holder
+			}
+
+			for _, bn := range blockNums {
+				balances, err := opts.Conn.GetTokenBalancesAt(tokens, pairHolders, bn)
 				if err != nil {
 					errorChan <- err
-					if errors.Is(err, ethereum.NotFound) {
-						continue
-					}
 					cancel()
 					return
 				}
+				ts := base.Timestamp(0)
+				if opts.Globals.Verbose {
+					ts, _ = tslib.FromBnToTs(chain, bn)
+				}
 
-				for _, bn := range blockNums {
-					if bal, err := opts.Conn.GetTokenBalanceAt(tokenAddr, addr, fmt.Sprintf("0x%x", bn)); bal == nil {
-						errorChan <- err
-					} else {
-						if opts.Globals.Verbose {
-							if bn == 0 || bn != currentBn {
-								currentTs, _ = tslib.FromBnToTs(chain, bn)
-							}
-							currentBn = bn
-						}
-						s := &types.SimpleToken{
-							Holder:      addr,
-							Address:     tokenAddr,
-							Balance:     *bal,
-							BlockNumber: bn,
-							Timestamp:   currentTs,
-							TokenType:   types.TokenErc20,
-						}
-						modelChan <- s
+				for t, bal := range balances {
+					if bal == nil {
+						errorChan <- fmt.Errorf("could not query the balance of %s in token %s at block %d", holder.Hex(), tokens[t].Hex(), bn)
+						continue
 					}
+					s := &types.SimpleToken{
+						Holder:      holder,
+						Address:     tokens[t],
+						Balance:     *bal,
+						BlockNumber: bn,
+						Timestamp:   ts,
+						TokenType:   types.TokenErc20,
+					}
+					modelChan <- s
 				}
 			}
 		}
@@ -75,16 +83,32 @@ func (opts *TokensOptions) HandleShow() error {
 	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extra))
 }
 
-// TODO: NOTE THIS - DOES IT STILL WORK THIS WAY?
-// } else if (by_acct) {
-//     // All user-provided addresses are assumed to be tokens, except the last one which is the holder
-//     holders.push_back(addrs[addrs.size() - 1]);
-//     CAddressArray::iterator it;
-//     it = prev(addrs.end());
-//     addrs.erase(it);
-//     for (auto addr : addrs) {
-//         if (!isContractAt(addr, latestBlock))
-//             errors.push_back("Address '" + addr + "' is not a token contract.");
-//         else
-//             tokens.push_back(addr);
-//     }
+// resolveBlocks returns the block numbers of the block identifiers in order. Identifiers that are not
+// found are reported and skipped. It returns false if any other error is reported.
+func (opts *TokensOptions) resolveBlocks(errorChan chan error) ([]uint64, bool) {
+	ret := []uint64{}
+	for _, br := range opts.BlockIds {
+		blockNums, err := br.ResolveBlocks(opts.Globals.Chain)
+		if err != nil {
+			errorChan <- err
+			if errors.Is(err, ethereum.NotFound) {
+				continue
+			}
+			return nil, false
+		}
+		ret = append(ret, blockNums...)
+	}
+	return ret, true
+}
+
+// tokensAndHolders returns the tokens and holders named on the command line
+func (opts *TokensOptions) tokensAndHolders() (tokens []base.Address, holders []base.Address) {
+	addrs := make([]base.Address, 0, len(opts.Addrs))
+	for _, addr := range opts.Addrs {
+		addrs = append(addrs, base.HexToAddress(addr))
+	}
+	if opts.ByAcct {
+		return addrs[:len(addrs)-1], addrs[len(addrs)-1:]
+	}
+	return addrs[:1], addrs[1:]
+}
@@ -24,6 +24,7 @@ func (opts *TracesOptions) HandleFilter() error {
 	nErrors := 0
 
 	abiCache := articulate.NewAbiCache(opts.Conn, opts.Articulate)
+	abiCache.ReplayReverts = opts.Articulate
 	traceFilter := types.SimpleTraceFilter{}
 	_, br := traceFilter.ParseBangString(chain, opts.Filter)
 
@@ -36,6 +36,9 @@ func (opts *TransactionsOptions) HandleLogs() error {
 		Emitters: emitters,
 		Topics:   topics,
 	}
+	if opts.EventFilter != nil {
+		logFilter.EventTopics = opts.EventFilter.Topics()
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	fetchData := func(modelChan chan types.Modeler[types.RawLog], errorChan chan error) {
@@ -106,7 +109,7 @@ func (opts *TransactionsOptions) HandleLogs() error {
 					item := item
 					for _, log := range item.Receipt.Logs {
 						log := log
-						if logFilter.PassesFilter(&log) {
+						if logFilter.PassesFilter(&log) && (opts.EventFilter == nil || opts.EventFilter.Passes(&log)) {
 							modelChan <- &log
 						}
 					}
@@ -15,6 +15,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/globals"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/caps"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/identifiers"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
@@ -32,6 +33,8 @@ type TransactionsOptions struct {
 	Logs           bool                     `json:"logs,omitempty"`           // Display only the logs found in the transaction(s)
 	Emitter        []string                 `json:"emitter,omitempty"`        // For the --logs option only, filter logs to show only those logs emitted by the given address(es)
 	Topic          []string                 `json:"topic,omitempty"`          // For the --logs option only, filter logs to show only those with this topic(s)
+	Event          string                   `json:"event,omitempty"`          // For the --logs option only, filter logs to show only those emitted by this event (for example, Transfer(address indexed from, address indexed to, uint256 value))
+	Where          []string                 `json:"where,omitempty"`          // For the --event option only, filter logs to show only those whose arguments match these conditions (for example, to=0x... or value>1e18)
 	AccountFor     string                   `json:"accountFor,omitempty"`     // Reconcile the transaction as per the provided address
 	CacheTraces    bool                     `json:"cacheTraces,omitempty"`    // Force the transaction's traces into the cache
 	Source         bool                     `json:"source,omitempty"`         // Find the source of the funds sent to the receiver
@@ -39,7 +42,8 @@ type TransactionsOptions struct {
 	Conn           *rpc.Connection          `json:"conn,omitempty"`           // The connection to the RPC server
 	BadFlag        error                    `json:"badFlag,omitempty"`        // An error flag if needed
 	// EXISTING_CODE
-	AccountForAddr base.Address `json:"-"`
+	EventFilter    *filter.EventFilter `json:"-"`
+	AccountForAddr base.Address        `json:"-"`
 	// EXISTING_CODE
 }
 
@@ -55,6 +59,8 @@ func (opts *TransactionsOptions) testLog() {
 	logger.TestLog(opts.Logs, "Logs: ", opts.Logs)
 	logger.TestLog(len(opts.Emitter) > 0, "Emitter: ", opts.Emitter)
 	logger.TestLog(len(opts.Topic) > 0, "Topic: ", opts.Topic)
+	logger.TestLog(len(opts.Event) > 0, "Event: ", opts.Event)
+	logger.TestLog(len(opts.Where) > 0, "Where: ", opts.Where)
 	logger.TestLog(len(opts.AccountFor) > 0, "AccountFor: ", opts.AccountFor)
 	logger.TestLog(opts.CacheTraces, "CacheTraces: ", opts.CacheTraces)
 	logger.TestLog(opts.Source, "Source: ", opts.Source)
@@ -99,6 +105,13 @@ func transactionsFinishParseApi(w http.ResponseWriter, r *http.Request) *Transac
 				s := strings.Split(val, " ") // may contain space separated items
 				opts.Topic = append(opts.Topic, s...)
 			}
+		case "event":
+			opts.Event = value[0]
+		case "where":
+			for _, val := range value {
+				s := strings.Split(val, " ") // may contain space separated items
+				opts.Where = append(opts.Where, s...)
+			}
 		case "accountFor":
 			opts.AccountFor = value[0]
 		case "cacheTraces":
@@ -116,6 +129,8 @@ func transactionsFinishParseApi(w http.ResponseWriter, r *http.Request) *Transac
 	opts.AccountForAddr = base.HexToAddress(opts.AccountFor)
 
 	// EXISTING_CODE
+	// the API splits list options on spaces, which splits conditions such as `value > 1e18`
+	opts.Where = filter.RejoinConditions(opts.Where)
 	// EXISTING_CODE
 	opts.Emitter, _ = opts.Conn.GetEnsAddresses(opts.Emitter)
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
@@ -41,6 +42,21 @@ func (opts *TransactionsOptions) validateTransactions() error {
 		}
 	}
 
+	if len(opts.Where) > 0 && len(opts.Event) == 0 {
+		return validate.Usage("The {0} option is only available with the {1} option.", "--where", "--event")
+	}
+
+	if len(opts.Event) > 0 {
+		if !opts.Logs {
+			return validate.Usage("The {0} option is only available with the {1} option.", "--event", "--logs")
+		}
+		eventFilter, err := filter.NewEventFilter(opts.Event, opts.Where)
+		if err != nil {
+			return validate.Usage("The {0} option ({1}) is invalid: {2}.", "--event", opts.Event, err.Error())
+		}
+		opts.EventFilter = eventFilter
+	}
+
 	if len(opts.Globals.File) > 0 {
 		// Do nothing
 	} else {
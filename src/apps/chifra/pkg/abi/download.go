@@ -1,16 +1,13 @@
 package abi
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"strings"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 )
 
@@ -22,9 +19,8 @@ func init() {
 
 var AbiNotFound = `[{"name":"AbiNotFound","type":"function"}]`
 
-// downloadAbi downloads the ABI for the given address and saves it to the cache.
-// TODO: This function should be easy to replace with "ABI providers" (different services like
-// Sourcify or custom ones configured by the user)
+// downloadAbi queries the chain's ABI providers (in order) for the ABI for the given address and
+// saves the first one found to the cache.
 func (abiMap *SelectorSyncMap) downloadAbi(chain string, address base.Address) error {
 	if address.IsZero() {
 		return errors.New("address is 0x0 in downloadAbi")
@@ -33,63 +29,51 @@ func (abiMap *SelectorSyncMap) downloadAbi(chain string, address base.Address) e
 	// C++ code used do check if the address is contract in 2 places: here and in handle_addresses. We
 	// check only in handle_addresses.
 
-	key := config.GetKey("etherscan").ApiKey
-	if key == "" {
-		return errors.New("cannot read Etherscan API key")
-	}
-	url := fmt.Sprintf(
-		"https://api.etherscan.io/api?module=contract&action=getabi&address=%s&apikey=%s",
-		address.Hex(),
-		key,
-	)
-
-	resp, err := http.Get(url)
+	providers, err := GetProviders(chain)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-
-	// Check server response
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("etherscan API error: %s", resp.Status)
-	}
-
-	data := map[string]string{}
-	decoder := json.NewDecoder(resp.Body)
-	if err = decoder.Decode(&data); err != nil {
-		return err
+	if len(providers) == 0 {
+		return fmt.Errorf("no abi providers are configured for chain %s", chain)
 	}
-	resp.Body.Close()
 
-	if data["message"] == "NOTOK" {
-		// Etherscan sends 200 OK responses even if there's an error. We want to cache the error
-		// response so we don't keep asking Etherscan for the same address. The user may later
-		// remove empty ABIs with chifra abis --clean.
-		if !perfTiming {
-			logger.Warn("provider responded with:", address.Hex(), data["message"])
+	var lastErr error
+	for _, provider := range providers {
+		abiJson, err := provider.GetAbi(chain, address)
+		if errors.Is(err, ErrAbiNotFound) {
+			continue
+		} else if err != nil {
+			lastErr = fmt.Errorf("%s: %w", provider.Name(), err)
+			if !perfTiming {
+				logger.Warn("abi provider", provider.Name(), "failed:", err)
+			}
+			continue
 		}
 
-		reader := strings.NewReader(AbiNotFound)
-		_ = fromJson(reader, abiMap)
-		if _, err = reader.Seek(0, io.SeekStart); err != nil {
-			return err
+		reader := strings.NewReader(abiJson)
+		if err = fromJson(reader, abiMap); err != nil {
+			lastErr = fmt.Errorf("%s: %w", provider.Name(), err)
+			continue
 		}
-		if err = insertAbi(chain, address, reader); err != nil {
+		if _, err = reader.Seek(0, io.SeekStart); err != nil {
 			return err
 		}
-		return nil
+
+		// Write the body to file
+		return insertAbi(chain, address, reader)
 	}
 
-	reader := strings.NewReader(data["result"])
-	_ = fromJson(reader, abiMap)
-	if _, err = reader.Seek(0, io.SeekStart); err != nil {
-		return err
+	if lastErr != nil {
+		// At least one of the providers could not answer, so we may find the ABI later
+		return lastErr
 	}
 
-	// Write the body to file
-	if err = insertAbi(chain, address, reader); err != nil {
+	// None of the providers has the ABI. We want to cache this so we don't keep asking for the same
+	// address. The user may later remove empty ABIs with chifra abis --clean.
+	reader := strings.NewReader(AbiNotFound)
+	_ = fromJson(reader, abiMap)
+	if _, err = reader.Seek(0, io.SeekStart); err != nil {
 		return err
 	}
-
-	return nil
+	return insertAbi(chain, address, reader)
 }
@@ -25,7 +25,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
 
-// LoadAbi tries to load ABI from any source (local file, cache, download from 3rd party)
+// LoadAbi tries to load ABI from any source (local file, cache, or the chain's ABI providers in the
+// order configured, see GetProviders)
 func LoadAbi(conn *rpc.Connection, address base.Address, abiMap *SelectorSyncMap) error {
 	err := conn.IsContractAt(address, nil)
 	if err != nil {
@@ -797,3 +798,27 @@ func getAbi(chain string, address base.Address) (simpleAbis []types.SimpleFuncti
 	simpleAbis = append(functions, events...)
 	return
 }
+
+// LoadAbiErrors loads the custom errors declared in the cached ABI of the given address into errorMap. The
+// ABI must have been loaded (see LoadAbi) first. Errors are kept apart from functions and events because
+// their selectors may collide with the selectors of functions.
+func LoadAbiErrors(chain string, address base.Address, errorMap *SelectorSyncMap) error {
+	fullPath := path.Join(config.PathToCache(chain), walk.CacheTypeToFolder[walk.Cache_Abis], address.Hex()+".json")
+	f, err := os.OpenFile(fullPath, os.O_RDONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ethAbi, err := abi.JSON(f)
+	if err != nil {
+		return err
+	}
+
+	for _, ethError := range ethAbi.Errors {
+		ethError := ethError
+		function := types.FunctionFromAbiError(&ethError)
+		errorMap.SetValue(function.Encoding, function)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+)
+
+// ExplorerProvider downloads ABIs from an Etherscan compatible block explorer API (Etherscan and
+// its sister sites for other chains, Blockscout, etc.). If KeyName is not empty, the API key is
+// read from that entry of the [keys] group of the config.
+type ExplorerProvider struct {
+	Url     string
+	KeyName string
+}
+
+// notVerified is the result an explorer returns for a contract whose source code it does not have
+const notVerified = "Contract source code not verified"
+
+func (p *ExplorerProvider) Name() string {
+	return "explorer"
+}
+
+func (p *ExplorerProvider) GetAbi(chain string, address base.Address) (string, error) {
+	query := url.Values{}
+	query.Set("module", "contract")
+	query.Set("action", "getabi")
+	query.Set("address", address.Hex())
+	if len(p.KeyName) > 0 {
+		key := config.GetKey(p.KeyName).ApiKey
+		if key == "" {
+			return "", fmt.Errorf("cannot read %s API key", p.KeyName)
+		}
+		query.Set("apikey", key)
+	}
+
+	sep := "?"
+	if strings.Contains(p.Url, "?") {
+		sep = "&"
+	}
+
+	resp, err := http.Get(p.Url + sep + query.Encode())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	// Check server response
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("explorer API error: %s", resp.Status)
+	}
+
+	data := map[string]string{}
+	decoder := json.NewDecoder(resp.Body)
+	if err = decoder.Decode(&data); err != nil {
+		return "", err
+	}
+
+	if data["message"] == "NOTOK" || data["status"] == "0" {
+		// Etherscan sends 200 OK responses even if there's an error. Only an unverified contract means
+		// the explorer has no ABI for the address. Anything else (rate limiting, a bad API key, etc.) is
+		// reported as an error so the missing ABI is not cached.
+		if !strings.Contains(data["result"], notVerified) {
+			return "", fmt.Errorf("explorer API error: %s", data["result"])
+		}
+		if !perfTiming {
+			logger.Warn("provider responded with:", address.Hex(), data["message"])
+		}
+		return "", ErrAbiNotFound
+	}
+
+	return data["result"], nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// LocalProvider reads ABIs from a folder of verified contracts. Each file is named for its address
+// (in lower case or checksummed) with a .json extension and contains either an ABI, a compiler
+// artifact, or Solidity metadata.
+type LocalProvider struct {
+	Path string
+}
+
+func (p *LocalProvider) Name() string {
+	return "local"
+}
+
+func (p *LocalProvider) GetAbi(chain string, address base.Address) (string, error) {
+	for _, name := range []string{address.Hex(), address.Address.Hex()} {
+		data, err := os.ReadFile(filepath.Join(p.Path, name+".json"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		}
+		return extractAbi(data)
+	}
+	return "", ErrAbiNotFound
+}
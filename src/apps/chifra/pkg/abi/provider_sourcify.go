@@ -0,0 +1,70 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// SourcifyProvider reads ABIs from the metadata files of a Sourcify repository. Path is the root
+// of the repository, either a local mirror on disc or a URL. Full matches are preferred over
+// partial matches.
+type SourcifyProvider struct {
+	Path    string
+	ChainId string
+}
+
+func (p *SourcifyProvider) Name() string {
+	return "sourcify"
+}
+
+func (p *SourcifyProvider) GetAbi(chain string, address base.Address) (string, error) {
+	for _, match := range []string{"full_match", "partial_match"} {
+		// Sourcify stores contracts in folders named by checksummed address
+		rel := strings.Join([]string{"contracts", match, p.ChainId, address.Address.Hex(), "metadata.json"}, "/")
+		data, err := p.read(rel)
+		if err == ErrAbiNotFound {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		return extractAbi(data)
+	}
+	return "", ErrAbiNotFound
+}
+
+// read returns the contents of the file at rel, a path relative to the repository's root. It
+// returns ErrAbiNotFound if there is no such file.
+func (p *SourcifyProvider) read(rel string) ([]byte, error) {
+	if !strings.HasPrefix(p.Path, "http://") && !strings.HasPrefix(p.Path, "https://") {
+		data, err := os.ReadFile(filepath.Join(p.Path, filepath.FromSlash(rel)))
+		if os.IsNotExist(err) {
+			return nil, ErrAbiNotFound
+		}
+		return data, err
+	}
+
+	resp, err := http.Get(strings.TrimSuffix(p.Path, "/") + "/" + rel)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrAbiNotFound
+	default:
+		return nil, fmt.Errorf("sourcify error: %s", resp.Status)
+	}
+}
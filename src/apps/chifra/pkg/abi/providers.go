@@ -0,0 +1,82 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+// ErrAbiNotFound is returned by an AbiProvider that was able to answer the query, but has
+// no ABI for the address.
+var ErrAbiNotFound = errors.New("abi not found")
+
+// AbiProvider is a source of ABIs for addresses not found in the cache. Providers are queried in
+// the order configured for the chain (see config.AbiSettings) until one of them finds the ABI.
+type AbiProvider interface {
+	// Name returns the provider's name as it appears in the configuration
+	Name() string
+	// GetAbi returns the JSON ABI for the address or ErrAbiNotFound if the provider has none
+	GetAbi(chain string, address base.Address) (string, error)
+}
+
+// GetProviders returns the ABI providers configured for the chain in the order in which they
+// should be queried. Providers that are listed but not configured (for example, a local provider
+// without a path) are skipped.
+func GetProviders(chain string) ([]AbiProvider, error) {
+	settings := config.GetAbiSettings(chain)
+	ret := make([]AbiProvider, 0, 3)
+	for _, name := range settings.ProviderList() {
+		switch name {
+		case "local":
+			if len(settings.LocalPath) > 0 {
+				ret = append(ret, &LocalProvider{Path: settings.LocalPath})
+			}
+		case "sourcify":
+			if len(settings.SourcifyPath) > 0 {
+				ret = append(ret, &SourcifyProvider{Path: settings.SourcifyPath, ChainId: config.GetChain(chain).ChainId})
+			}
+		case "explorer":
+			if len(settings.ExplorerUrl) > 0 {
+				ret = append(ret, &ExplorerProvider{Url: settings.ExplorerUrl, KeyName: settings.ExplorerKey})
+			}
+		default:
+			return nil, fmt.Errorf("unknown abi provider %s (must be one of local, sourcify, or explorer)", name)
+		}
+	}
+	return ret, nil
+}
+
+// extractAbi returns the ABI found in data, which may be an ABI, a compiler artifact (with an
+// abi field) or Solidity metadata (with an output.abi field).
+func extractAbi(data []byte) (string, error) {
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "[") {
+		return trimmed, nil
+	}
+
+	var artifact struct {
+		Abi    json.RawMessage `json:"abi"`
+		Output struct {
+			Abi json.RawMessage `json:"abi"`
+		} `json:"output"`
+	}
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return "", err
+	}
+
+	if len(artifact.Abi) > 0 {
+		return string(artifact.Abi), nil
+	}
+	if len(artifact.Output.Abi) > 0 {
+		return string(artifact.Output.Abi), nil
+	}
+	return "", fmt.Errorf("no abi found in json file")
+}
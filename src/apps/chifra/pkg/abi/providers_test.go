@@ -0,0 +1,100 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+const testAbi = `[{"constant":true,"inputs":[{"name":"","type":"address"}],"name":"isBar","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
+
+func Test_ExtractAbi(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		fail bool
+	}{
+		{"abi", testAbi, false},
+		{"artifact", `{"contractName":"Bar","abi":` + testAbi + `}`, false},
+		{"metadata", `{"compiler":{},"output":{"abi":` + testAbi + `}}`, false},
+		{"empty", `{"contractName":"Bar"}`, true},
+		{"invalid", `not json`, true},
+	}
+	for _, test := range tests {
+		got, err := extractAbi([]byte(test.data))
+		if test.fail {
+			if err == nil {
+				t.Error(test.name, "expected an error")
+			}
+		} else if err != nil || got != testAbi {
+			t.Error(test.name, "expected", testAbi, "got", got, err)
+		}
+	}
+}
+
+func Test_FileProviders(t *testing.T) {
+	address := base.HexToAddress("0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c")
+	missing := base.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	localDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(localDir, address.Address.Hex()+".json"), []byte(testAbi), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sourcifyDir := t.TempDir()
+	metaDir := filepath.Join(sourcifyDir, "contracts", "partial_match", "10", address.Address.Hex())
+	if err := os.MkdirAll(metaDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(metaDir, "metadata.json"), []byte(`{"output":{"abi":`+testAbi+`}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	providers := []AbiProvider{
+		&LocalProvider{Path: localDir},
+		&SourcifyProvider{Path: sourcifyDir, ChainId: "10"},
+	}
+	for _, provider := range providers {
+		if got, err := provider.GetAbi("optimism", address); err != nil || got != testAbi {
+			t.Error(provider.Name(), "expected", testAbi, "got", got, err)
+		}
+		if _, err := provider.GetAbi("optimism", missing); err != ErrAbiNotFound {
+			t.Error(provider.Name(), "expected ErrAbiNotFound, got", err)
+		}
+	}
+}
+
+func Test_ExplorerProvider(t *testing.T) {
+	responses := map[string]string{
+		"0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c": `{"status":"1","message":"OK","result":` + strconv.Quote(testAbi) + `}`,
+		"0x1234567890123456789012345678901234567890": `{"status":"0","message":"NOTOK","result":"Contract source code not verified"}`,
+		"0x0000000000000000000000000000000000000001": `{"status":"0","message":"NOTOK","result":"Invalid API Key"}`,
+		"0x0000000000000000000000000000000000000002": `{"status":"0","message":"NOTOK","result":"Max rate limit reached"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(responses[r.URL.Query().Get("address")]))
+	}))
+	defer server.Close()
+
+	provider := &ExplorerProvider{Url: server.URL}
+	if got, err := provider.GetAbi("mainnet", base.HexToAddress("0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c")); err != nil || got != testAbi {
+		t.Error("expected", testAbi, "got", got, err)
+	}
+	if _, err := provider.GetAbi("mainnet", base.HexToAddress("0x1234567890123456789012345678901234567890")); err != ErrAbiNotFound {
+		t.Error("expected ErrAbiNotFound for an unverified contract, got", err)
+	}
+	for _, addr := range []string{"0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"} {
+		if _, err := provider.GetAbi("mainnet", base.HexToAddress(addr)); err == nil || err == ErrAbiNotFound {
+			t.Error("expected an explorer error (not ErrAbiNotFound) for", addr, "got", err)
+		}
+	}
+}
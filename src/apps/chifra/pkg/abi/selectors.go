@@ -0,0 +1,74 @@
+package abi
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+const (
+	opPush1  = 0x60
+	opPush4  = 0x63
+	opPush32 = 0x7f
+	opEq     = 0x14
+	opDup1   = 0x80
+	opDup16  = 0x8f
+)
+
+// SelectorsFromCode returns the four-byte selectors of the functions dispatched by the given runtime
+// bytecode. Solidity (and most other compilers) compare the selector of the call to each of the
+// contract's selectors with a PUSH4 <selector> followed by an EQ (possibly after a DUP). The result
+// is sorted and each selector is 0x-prefixed.
+func SelectorsFromCode(code []byte) []string {
+	seen := map[string]bool{}
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op < opPush1 || op > opPush32 {
+			continue
+		}
+
+		width := int(op-opPush1) + 1
+		if op == opPush4 && pc+width < len(code) {
+			next := pc + width + 1
+			if next < len(code) && code[next] >= opDup1 && code[next] <= opDup16 {
+				next++
+			}
+			if next < len(code) && code[next] == opEq {
+				seen["0x"+hex.EncodeToString(code[pc+1:pc+1+width])] = true
+			}
+		}
+		pc += width // skip the pushed data
+	}
+
+	ret := make([]string, 0, len(seen))
+	for selector := range seen {
+		ret = append(ret, selector)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// FunctionSignatures returns the text signatures of as many of the given selectors as are found in the
+// signature database. The database is only consulted: signatures missing from it may be found with
+// chifra abis --find (which adds what it finds to the database) or imported with chifra abis --import.
+func (db *SignatureDb) FunctionSignatures(selectors []string) map[string]string {
+	ret := make(map[string]string, len(selectors))
+	for _, selector := range selectors {
+		if sig := db.functionSignature(selector); len(sig) > 0 {
+			ret[selector] = sig
+		}
+	}
+	return ret
+}
+
+// EventSignature returns the text signature of the event with the given topic (if it is in the signature
+// database)
+func (db *SignatureDb) EventSignature(topic base.Hash) string {
+	for _, event := range db.lookupHash(topic.Bytes()) {
+		if event.FunctionType != "function" {
+			return event.Signature
+		}
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+package abi
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func TestSelectorsFromCode(t *testing.T) {
+	// A typical Solidity dispatcher (DUP1 PUSH4 <selector> EQ PUSH2 <dest> JUMPI), a selector compared
+	// after a DUP2, a PUSH4 that is not compared (GT, used for binary search), and a PUSH32 whose data
+	// contains what looks like a PUSH4 and an EQ (which must be skipped).
+	code, _ := hex.DecodeString(
+		"80" + "63a9059cbb" + "14" + "610100" + "57" +
+			"63095ea7b3" + "81" + "14" + "610200" + "57" +
+			"6370a08231" + "11" +
+			"7f" + "63deadbeef14000000000000000000000000000000000000000000000000000000" +
+			"5b00")
+
+	expected := []string{"0x095ea7b3", "0xa9059cbb"}
+	if got := SelectorsFromCode(code); !reflect.DeepEqual(got, expected) {
+		t.Error("expected", expected, "got", got)
+	}
+
+	if got := SelectorsFromCode([]byte{0x63, 0x01}); len(got) != 0 {
+		t.Error("expected no selectors from truncated code, got", got)
+	}
+}
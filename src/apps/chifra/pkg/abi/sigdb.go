@@ -0,0 +1,500 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignatureKind records whether a signature is known to be a function, an event, or either
+type SignatureKind uint8
+
+const (
+	SigUnknown SignatureKind = iota
+	SigFunction
+	SigEvent
+)
+
+// sigRecord is a single entry in the signature database. Records are sorted by Hash, so all
+// signatures sharing a four-byte selector are adjacent (and form that selector's collision list).
+type sigRecord struct {
+	Hash     [32]byte
+	Kind     SignatureKind
+	SigIndex uint32
+}
+
+// sigDbHeader is stored at the front of the signature database file. It is followed by NSigs
+// length-prefixed signatures and then NRecords sigRecords.
+type sigDbHeader struct {
+	Magic    uint32
+	NSigs    uint32
+	NRecords uint32
+}
+
+// SignatureDb maps four-byte selectors and 32-byte event topics to their text signatures. It is
+// stored on disc in the chain's abis cache and read entirely into memory. The records are sorted when
+// the database is read or written, so signatures inserted since it was read are found only once it is written.
+type SignatureDb struct {
+	sigs    []string
+	records []sigRecord
+	known   map[string]int // from signature to its record's position (only while inserting)
+	dirty   bool
+}
+
+// ToSignatureDbPath returns the path to the chain's signature database
+func ToSignatureDbPath(chain string) string {
+	return filepath.Join(walk.GetRootPathFromCacheType(chain, walk.Cache_Abis), "signatures.bin")
+}
+
+// OpenSignatureDb reads the chain's signature database. If there is none, an empty database is returned.
+func OpenSignatureDb(chain string) (*SignatureDb, error) {
+	return readSignatureDb(ToSignatureDbPath(chain))
+}
+
+func readSignatureDb(fileName string) (*SignatureDb, error) {
+	db := &SignatureDb{}
+
+	fp, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return db, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	r := bufio.NewReader(fp)
+	var header sigDbHeader
+	if err = binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if header.Magic != file.SignatureMagicNumber {
+		return nil, fmt.Errorf("invalid signature database %s", fileName)
+	}
+
+	db.sigs = make([]string, header.NSigs)
+	for i := range db.sigs {
+		var size uint16
+		if err = binary.Read(r, binary.LittleEndian, &size); err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		db.sigs[i] = string(buf)
+	}
+
+	db.records = make([]sigRecord, header.NRecords)
+	if err = binary.Read(r, binary.LittleEndian, db.records); err != nil {
+		return nil, err
+	}
+	if !sort.SliceIsSorted(db.records, db.less) {
+		db.sort()
+	}
+
+	return db, nil
+}
+
+// Len returns the number of signatures in the database
+func (db *SignatureDb) Len() int {
+	return len(db.records)
+}
+
+// Insert adds the signature to the database. It returns false if the signature was already there
+// (in which case, its kind is updated if it was unknown).
+func (db *SignatureDb) Insert(sig string, kind SignatureKind) bool {
+	if db.known == nil {
+		db.known = make(map[string]int, len(db.records))
+		for i, rec := range db.records {
+			db.known[db.sigs[rec.SigIndex]] = i
+		}
+	}
+
+	if pos, ok := db.known[sig]; ok {
+		if db.records[pos].Kind == SigUnknown && kind != SigUnknown {
+			db.records[pos].Kind = kind
+			db.dirty = true
+		}
+		return false
+	}
+
+	rec := sigRecord{Kind: kind, SigIndex: uint32(len(db.sigs))}
+	copy(rec.Hash[:], crypto.Keccak256([]byte(sig)))
+	db.sigs = append(db.sigs, sig)
+	db.records = append(db.records, rec)
+	db.known[sig] = len(db.records) - 1
+	db.dirty = true
+	return true
+}
+
+// Write sorts the database and writes it to disc (if it has changed).
+func (db *SignatureDb) Write(chain string) error {
+	return db.writeTo(ToSignatureDbPath(chain))
+}
+
+func (db *SignatureDb) writeTo(fileName string) error {
+	if !db.dirty {
+		return nil
+	}
+	db.sort()
+
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+	tmpName := fileName + ".tmp"
+	fp, err := os.Create(tmpName)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(fp)
+	header := sigDbHeader{
+		Magic:    file.SignatureMagicNumber,
+		NSigs:    uint32(len(db.sigs)),
+		NRecords: uint32(len(db.records)),
+	}
+	err = binary.Write(w, binary.LittleEndian, header)
+	for i := 0; err == nil && i < len(db.sigs); i++ {
+		if err = binary.Write(w, binary.LittleEndian, uint16(len(db.sigs[i]))); err == nil {
+			_, err = w.WriteString(db.sigs[i])
+		}
+	}
+	if err == nil {
+		if err = binary.Write(w, binary.LittleEndian, db.records); err == nil {
+			err = w.Flush()
+		}
+	}
+	if closeErr := fp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	db.dirty = false
+	return os.Rename(tmpName, fileName)
+}
+
+// Lookup returns the signatures matching the given four-byte selector or 32-byte topic (all of them,
+// if there are collisions). Any other term is searched for in the signatures themselves (ignoring case).
+func (db *SignatureDb) Lookup(term string) []types.SimpleFunction {
+	if b, err := hex.DecodeString(strings.TrimPrefix(term, "0x")); err == nil && strings.HasPrefix(term, "0x") && (len(b) == 4 || len(b) == 32) {
+		return db.lookupHash(b)
+	}
+
+	ret := []types.SimpleFunction{}
+	fragment := strings.ToLower(term)
+	for _, rec := range db.records {
+		if strings.Contains(strings.ToLower(db.sigs[rec.SigIndex]), fragment) {
+			if function, err := db.toFunction(rec, rec.Kind == SigEvent); err == nil {
+				ret = append(ret, function)
+			}
+		}
+	}
+	sortFunctions(ret)
+	return ret
+}
+
+// lookupHash returns the records whose hash starts with prefix
+func (db *SignatureDb) lookupHash(prefix []byte) []types.SimpleFunction {
+	pos := sort.Search(len(db.records), func(i int) bool {
+		return bytes.Compare(db.records[i].Hash[:len(prefix)], prefix) >= 0
+	})
+
+	ret := []types.SimpleFunction{}
+	for ; pos < len(db.records) && bytes.Equal(db.records[pos].Hash[:len(prefix)], prefix); pos++ {
+		if function, err := db.toFunction(db.records[pos], len(prefix) == 32); err == nil {
+			ret = append(ret, function)
+		}
+	}
+	return ret
+}
+
+// functionSignature returns the first non-event signature with the given selector (if any)
+func (db *SignatureDb) functionSignature(selector string) string {
+	b, err := hex.DecodeString(strings.TrimPrefix(selector, "0x"))
+	if err != nil || len(b) != 4 {
+		return ""
+	}
+	for _, function := range db.lookupHash(b) {
+		if function.FunctionType != "event" {
+			return function.Signature
+		}
+	}
+	return ""
+}
+
+// All returns every signature in the database
+func (db *SignatureDb) All() []types.SimpleFunction {
+	ret := make([]types.SimpleFunction, 0, len(db.records))
+	for _, rec := range db.records {
+		if function, err := db.toFunction(rec, rec.Kind == SigEvent); err == nil {
+			ret = append(ret, function)
+		}
+	}
+	sortFunctions(ret)
+	return ret
+}
+
+func (db *SignatureDb) less(i, j int) bool {
+	return bytes.Compare(db.records[i].Hash[:], db.records[j].Hash[:]) < 0
+}
+
+// sort sorts the records by hash (which moves them, so the positions of inserted signatures are forgotten)
+func (db *SignatureDb) sort() {
+	sort.Slice(db.records, db.less)
+	db.known = nil
+}
+
+// toFunction converts the record to a function. Events are reported with their 32-byte topic and
+// everything else with its four-byte selector.
+func (db *SignatureDb) toFunction(rec sigRecord, asTopic bool) (types.SimpleFunction, error) {
+	sig := db.sigs[rec.SigIndex]
+	open := strings.Index(sig, "(")
+	if open < 1 {
+		return types.SimpleFunction{}, fmt.Errorf("%w: %s", ErrInvalidSignature, sig)
+	}
+	ret := types.SimpleFunction{
+		Signature: sig,
+		Name:      sig[:open],
+	}
+	switch rec.Kind {
+	case SigFunction:
+		ret.FunctionType = "function"
+	case SigEvent:
+		ret.FunctionType = "event"
+	}
+	if asTopic {
+		ret.Encoding = "0x" + hex.EncodeToString(rec.Hash[:])
+	} else {
+		ret.Encoding = "0x" + hex.EncodeToString(rec.Hash[:4])
+	}
+	return ret, nil
+}
+
+func sortFunctions(funcs []types.SimpleFunction) {
+	sort.Slice(funcs, func(i, j int) bool {
+		if funcs[i].Signature == funcs[j].Signature {
+			return funcs[i].Encoding < funcs[j].Encoding
+		}
+		return funcs[i].Signature < funcs[j].Signature
+	})
+}
+
+// ErrInvalidSignature is returned for lines of a signature dump that cannot be imported
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ParseSignatureLine parses a line of a plain-text signature dump. Lines may hold a bare signature,
+// a signature preceded by function or event, or an encoding followed by a signature (separated by
+// white space or a comma). If an encoding is present, it must match the signature.
+func ParseSignatureLine(line string) (string, SignatureKind, error) {
+	fields := strings.FieldsFunc(strings.TrimSpace(line), func(r rune) bool {
+		return r == '\t' || r == ','
+	})
+	if len(fields) == 0 {
+		return "", SigUnknown, ErrInvalidSignature
+	}
+
+	encoding := ""
+	sig := strings.TrimSpace(line)
+	if len(fields) > 1 && strings.HasPrefix(fields[0], "0x") && !strings.Contains(fields[0], "(") {
+		encoding = strings.ToLower(strings.TrimSpace(fields[0]))
+		sig = strings.TrimSpace(sig[len(fields[0])+1:])
+	} else if parts := strings.SplitN(sig, " ", 2); len(parts) == 2 && strings.HasPrefix(parts[0], "0x") {
+		encoding = strings.ToLower(parts[0])
+		sig = strings.TrimSpace(parts[1])
+	}
+
+	kind := SigUnknown
+	if strings.HasPrefix(sig, "function ") {
+		kind, sig = SigFunction, sig[len("function "):]
+	} else if strings.HasPrefix(sig, "event ") {
+		kind, sig = SigEvent, sig[len("event "):]
+	}
+
+	sig, err := canonicalSignature(sig)
+	if err != nil {
+		return "", SigUnknown, err
+	}
+
+	if len(encoding) > 0 {
+		hash := "0x" + hex.EncodeToString(crypto.Keccak256([]byte(sig)))
+		if !strings.HasPrefix(hash, encoding) || (len(encoding) != 10 && len(encoding) != 66) {
+			return "", SigUnknown, fmt.Errorf("%w: %s does not match %s", ErrInvalidSignature, encoding, sig)
+		}
+		if len(encoding) == 66 && kind == SigUnknown {
+			kind = SigEvent
+		}
+	}
+
+	return sig, kind, nil
+}
+
+// canonicalSignature returns the signature with its parameter names (and indexed or data location
+// keywords) and white space removed. Each parameter's type must be a valid ABI type.
+func canonicalSignature(sig string) (string, error) {
+	sig = strings.TrimSpace(sig)
+	open := strings.Index(sig, "(")
+	if open < 1 || !strings.HasSuffix(sig, ")") {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSignature, sig)
+	}
+	name := strings.TrimSpace(sig[:open])
+	if len(name) == 0 || strings.ContainsAny(name, " \t") {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSignature, sig)
+	}
+	depth := 0
+	for i, r := range sig[open:] {
+		if r == '(' {
+			depth++
+		} else if r == ')' {
+			if depth--; depth == 0 && open+i != len(sig)-1 {
+				return "", fmt.Errorf("%w: %s", ErrInvalidSignature, sig)
+			}
+		}
+	}
+	if depth != 0 {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSignature, sig)
+	}
+	params, err := canonicalParams(sig[open+1 : len(sig)-1])
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %s", ErrInvalidSignature, sig, err)
+	}
+	return name + "(" + params + ")", nil
+}
+
+// canonicalParams returns the comma separated types of a parameter list. Tuples are handled recursively.
+func canonicalParams(params string) (string, error) {
+	if len(strings.TrimSpace(params)) == 0 {
+		return "", nil
+	}
+
+	ret := []string{}
+	for _, param := range splitParams(params) {
+		param = strings.TrimSpace(param)
+		if strings.HasPrefix(param, "(") {
+			end := strings.LastIndex(param, ")")
+			if end < 0 {
+				return "", fmt.Errorf("unbalanced parentheses in %s", param)
+			}
+			inner, err := canonicalParams(param[1:end])
+			if err != nil {
+				return "", err
+			}
+			// what follows the tuple is any array suffix and then (perhaps) the parameter's name
+			suffix := ""
+			if fields := strings.Fields(param[end+1:]); len(fields) > 0 && strings.HasPrefix(fields[0], "[") {
+				suffix = fields[0]
+			}
+			ret = append(ret, "("+inner+")"+suffix)
+			continue
+		}
+
+		fields := strings.Fields(param)
+		if len(fields) == 0 {
+			return "", fmt.Errorf("empty parameter")
+		}
+		if _, err := abi.NewType(fields[0], "", nil); err != nil {
+			return "", err
+		}
+		ret = append(ret, fields[0])
+	}
+	return strings.Join(ret, ","), nil
+}
+
+// splitParams splits a parameter list at the commas that are not inside a tuple
+func splitParams(params string) []string {
+	ret := []string{}
+	depth, start := 0, 0
+	for i, r := range params {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				ret = append(ret, params[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(ret, params[start:])
+}
+
+// ImportSignatures imports the signatures found in a plain-text signature dump into the database. It
+// returns the number of signatures added and the number of lines rejected.
+func (db *SignatureDb) ImportSignatures(reader io.Reader) (added int, rejected int, err error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sig, kind, err := ParseSignatureLine(line)
+		if err != nil {
+			rejected++
+			continue
+		}
+		if db.Insert(sig, kind) {
+			added++
+		}
+	}
+	return added, rejected, scanner.Err()
+}
+
+// ImportAbis imports the signatures of every function and event found in the chain's cached ABIs
+// and in the known ABIs. It returns the number of signatures added.
+func (db *SignatureDb) ImportAbis(chain string) (int, error) {
+	paths, err := getKnownAbiPaths()
+	if err != nil {
+		return 0, err
+	}
+
+	cachePath := walk.GetRootPathFromCacheType(chain, walk.Cache_Abis)
+	if entries, err := os.ReadDir(cachePath); err == nil {
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+				paths = append(paths, filepath.Join(cachePath, entry.Name()))
+			}
+		}
+	}
+
+	added := 0
+	for _, path := range paths {
+		abiMap := SelectorSyncMap{}
+		if err := loadAbiFromKnownFile(path, &abiMap); err != nil {
+			continue // e.g. an empty ABI or one that could not be parsed
+		}
+		for _, function := range abiMap.Values() {
+			kind := SigFunction
+			if function.FunctionType == "event" {
+				kind = SigEvent
+			} else if function.FunctionType != "function" {
+				continue
+			}
+			if len(function.Signature) > 0 && db.Insert(function.Signature, kind) {
+				added++
+			}
+		}
+	}
+	return added, nil
+}
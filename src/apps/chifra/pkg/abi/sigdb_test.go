@@ -0,0 +1,102 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package abi
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSignatureDb(t *testing.T) {
+	db := &SignatureDb{}
+	if !db.Insert("transfer(address,uint256)", SigFunction) {
+		t.Error("first insert should add the signature")
+	}
+	if db.Insert("transfer(address,uint256)", SigFunction) {
+		t.Error("second insert should not add the signature")
+	}
+	db.Insert("Transfer(address,address,uint256)", SigEvent)
+	// These two collide on the 0x42966c68 selector
+	db.Insert("burn(uint256)", SigUnknown)
+	db.Insert("collate_propagate_storage(bytes16)", SigUnknown)
+
+	fileName := filepath.Join(t.TempDir(), "signatures.bin")
+	if err := db.writeTo(fileName); err != nil {
+		t.Fatal(err)
+	}
+	read, err := readSignatureDb(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Len() != 4 {
+		t.Fatal("expected 4 signatures, got", read.Len())
+	}
+
+	if got := read.Lookup("0xa9059cbb"); len(got) != 1 || got[0].Signature != "transfer(address,uint256)" || got[0].FunctionType != "function" {
+		t.Error("wrong selector lookup", got)
+	}
+	topic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	if got := read.Lookup(topic); len(got) != 1 || got[0].Encoding != topic || got[0].FunctionType != "event" {
+		t.Error("wrong topic lookup", got)
+	}
+	if got := read.Lookup("0x42966c68"); len(got) != 2 {
+		t.Error("expected both colliding signatures", got)
+	}
+	if got := read.Lookup("TRANSFER"); len(got) != 2 || got[0].Signature != "Transfer(address,address,uint256)" {
+		t.Error("wrong name lookup", got)
+	}
+	if got := read.functionSignature("0xddf252ad"); got != "" {
+		t.Error("events should not be reported as functions", got)
+	}
+
+	missing, err := readSignatureDb(filepath.Join(t.TempDir(), "missing.bin"))
+	if err != nil || missing.Len() != 0 {
+		t.Error("a missing database should be empty", err)
+	}
+}
+
+func TestParseSignatureLine(t *testing.T) {
+	tests := []struct {
+		line string
+		sig  string
+		kind SignatureKind
+		err  bool
+	}{
+		{"transfer(address,uint256)", "transfer(address,uint256)", SigUnknown, false},
+		{"function transfer(address, uint256)", "transfer(address,uint256)", SigFunction, false},
+		{"event Transfer(address,address,uint256)", "Transfer(address,address,uint256)", SigEvent, false},
+		{"0xa9059cbb\ttransfer(address,uint256)", "transfer(address,uint256)", SigUnknown, false},
+		{"0xa9059cbb,transfer(address,uint256)", "transfer(address,uint256)", SigUnknown, false},
+		{"0xa9059cbb transfer(address,uint256)", "transfer(address,uint256)", SigUnknown, false},
+		{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef Transfer(address,address,uint256)", "Transfer(address,address,uint256)", SigEvent, false},
+		{"0x12345678 transfer(address,uint256)", "", SigUnknown, true},
+		{"transfer", "", SigUnknown, true},
+		{"transfer(address", "", SigUnknown, true},
+		{"transfer(address to, uint256 amount)", "transfer(address,uint256)", SigUnknown, false},
+		{"event Transfer(address indexed from, address indexed to, uint256 value)", "Transfer(address,address,uint256)", SigEvent, false},
+		{"fill((address,uint256)[] orders, bytes memory data)", "fill((address,uint256)[],bytes)", SigUnknown, false},
+		{"transfer(addressto,uint256)", "", SigUnknown, true},
+		{"transfer(address) returns (bool)", "", SigUnknown, true},
+	}
+	for _, test := range tests {
+		sig, kind, err := ParseSignatureLine(test.line)
+		if (err != nil) != test.err {
+			t.Error("unexpected error result for", test.line, err)
+		} else if err != nil && !errors.Is(err, ErrInvalidSignature) {
+			t.Error("expected ErrInvalidSignature for", test.line, err)
+		} else if sig != test.sig || kind != test.kind {
+			t.Error("wrong result for", test.line, sig, kind)
+		}
+	}
+
+	db := &SignatureDb{}
+	dump := "# comment\n\ntransfer(address,uint256)\nbad line\n0xa9059cbb transfer(address,uint256)\n"
+	added, rejected, err := db.ImportSignatures(strings.NewReader(dump))
+	if err != nil || added != 1 || rejected != 1 {
+		t.Error("wrong import result", added, rejected, err)
+	}
+}
@@ -1,17 +1,41 @@
 package articulate
 
 import (
+	"sync"
+
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 )
 
 type AbiCache struct {
-	Conn      *rpc.Connection
-	Chain     string
-	AbiMap    abi.SelectorSyncMap
+	Conn   *rpc.Connection
+	Chain  string
+	AbiMap abi.SelectorSyncMap
+	// ReplayReverts, if set, replays failed transactions and traces that carry no revert data in order to
+	// find the reason they reverted. Set it only where revert reasons are shown: each replay is an eth_call.
+	ReplayReverts bool
+	// Guess, if set, articulates the calls and events of contracts with no ABI from the signature database.
+	// Such articulations are marked as guessed. Each unarticulated call costs a lookup of the contract's code.
+	Guess     bool
 	loadedMap abi.AddressSyncMap
 	skipMap   abi.AddressSyncMap
+	// proxies carries the implementation history of each proxy contract seen so far
+	proxies    map[base.Address]*ProxyHistory
+	proxyMutex sync.Mutex
+	// noProxyMap carries the contracts whose implementation could not be looked up
+	noProxyMap abi.AddressSyncMap
+	// guessed carries, per contract without an ABI, the selectors found in its code and their signatures
+	guessed    map[base.Address]map[string]string
+	guessMutex sync.Mutex
+	// sigDb is the chain's signature database, which the signatures of guessed articulations come from
+	sigDb     *abi.SignatureDb
+	sigDbOnce sync.Once
+	// errorMap carries the custom errors declared in the ABIs of the contracts seen reverting so far
+	errorMap     abi.SelectorSyncMap
+	errorsLoaded abi.AddressSyncMap
 }
 
 func NewAbiCache(conn *rpc.Connection, loadKnown bool) *AbiCache {
@@ -21,6 +45,7 @@ func NewAbiCache(conn *rpc.Connection, loadKnown bool) *AbiCache {
 		AbiMap:    abi.SelectorSyncMap{},
 		loadedMap: abi.AddressSyncMap{},
 		skipMap:   abi.AddressSyncMap{},
+		Guess:     config.GetAbiSettings(conn.Chain).GuessSignatures,
 	}
 
 	if loadKnown {
@@ -0,0 +1,266 @@
+package articulate
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	goEthAbi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// GuessedMessage marks articulations that were guessed (from the contract's bytecode and the known
+// signature database) rather than taken from the contract's ABI.
+const GuessedMessage = "guessed"
+
+// guessFromCode articulates a call to a contract for which we have no ABI. The function's signature
+// is looked up by selector among the selectors found in the contract's bytecode. If the signature is
+// found (and decodes the input), it is used. Otherwise, the input's words are decoded heuristically.
+// Either way, the result is marked as guessed.
+func (abiCache *AbiCache) guessFromCode(address base.Address, bn base.Blknum, input string) *types.SimpleFunction {
+	if len(input) < 10 {
+		return nil
+	}
+	selector := strings.ToLower(input[:10])
+
+	signatures := abiCache.signaturesFromCode(address, bn)
+	if signatures == nil {
+		return nil
+	}
+
+	inputData := input[10:]
+	if sig, ok := signatures[selector]; ok && len(sig) > 0 {
+		if function, err := functionFromSignature(sig); err == nil {
+			if err = ArticulateFunction(function, inputData, ""); err == nil {
+				function.Message = GuessedMessage
+				return function
+			}
+		}
+	}
+
+	data, err := hex.DecodeString(inputData)
+	if err != nil {
+		return nil
+	}
+
+	name := "unknown"
+	if _, ok := signatures[selector]; !ok {
+		// The selector is not dispatched by the contract, so the call falls through to its fallback
+		name = "fallback"
+	}
+	return &types.SimpleFunction{
+		Encoding:     selector,
+		Name:         name,
+		FunctionType: "function",
+		Inputs:       guessArguments(data),
+		Message:      GuessedMessage,
+	}
+}
+
+// guessEvent articulates a log emitted by a contract for which we have no ABI. The event's signature is
+// looked up by topic in the signature database. Signatures do not record which parameters are indexed, so
+// the first parameters are taken to be the indexed ones (one per topic). If that does not decode the log,
+// the topics and the data's words are decoded heuristically. Either way, the result is marked as guessed.
+func (abiCache *AbiCache) guessEvent(log *types.SimpleLog) *types.SimpleFunction {
+	if len(log.Topics) < 1 {
+		return nil
+	}
+	topic := log.Topics[0]
+	data := strings.TrimPrefix(log.Data, "0x")
+
+	if sig := abiCache.signatureDb().EventSignature(topic); len(sig) > 0 {
+		if event, err := eventFromSignature(sig, len(log.Topics)-1); err == nil {
+			if abiEvent, err := event.GetAbiEvent(); err == nil {
+				if err = articulateArguments(abiEvent.Inputs, data, log.Topics, event.Inputs); err == nil {
+					event.Message = GuessedMessage
+					return event
+				}
+			}
+		}
+	}
+
+	bytes, err := hex.DecodeString(data)
+	if err != nil {
+		return nil
+	}
+
+	inputs := make([]types.SimpleParameter, 0, len(log.Topics)-1)
+	for _, t := range log.Topics[1:] {
+		inputs = append(inputs, types.SimpleParameter{
+			ParameterType: "bytes32",
+			Value:         t.Hex(),
+			Indexed:       true,
+		})
+	}
+	return &types.SimpleFunction{
+		Encoding:     topic.Hex(),
+		Name:         "unknown",
+		FunctionType: "event",
+		Inputs:       append(inputs, guessArguments(bytes)...),
+		Message:      GuessedMessage,
+	}
+}
+
+// signaturesFromCode returns a map from each selector found in the contract's code to its signature
+// (or an empty string if the signature is not known). It returns nil if the address has no code (or
+// its code could not be read, since guessing is best effort).
+func (abiCache *AbiCache) signaturesFromCode(address base.Address, bn base.Blknum) map[string]string {
+	db := abiCache.signatureDb()
+
+	abiCache.guessMutex.Lock()
+	defer abiCache.guessMutex.Unlock()
+
+	if abiCache.guessed == nil {
+		abiCache.guessed = make(map[base.Address]map[string]string)
+	}
+	if signatures, ok := abiCache.guessed[address]; ok {
+		return signatures
+	}
+
+	var signatures map[string]string
+	if code, err := abiCache.Conn.GetContractCodeAt(address, bn); err == nil && len(code) > 0 {
+		selectors := abi.SelectorsFromCode(code)
+		found := db.FunctionSignatures(selectors)
+		signatures = make(map[string]string, len(selectors))
+		for _, selector := range selectors {
+			signatures[selector] = found[selector]
+		}
+	}
+	abiCache.guessed[address] = signatures
+	return signatures
+}
+
+// signatureDb returns the chain's signature database (read once). If it cannot be read, an empty
+// database is returned.
+func (abiCache *AbiCache) signatureDb() *abi.SignatureDb {
+	abiCache.sigDbOnce.Do(func() {
+		db, err := abi.OpenSignatureDb(abiCache.Chain)
+		if err != nil {
+			logger.Warn("could not read the signature database:", err)
+			db = &abi.SignatureDb{}
+		}
+		abiCache.sigDb = db
+	})
+	return abiCache.sigDb
+}
+
+// functionFromSignature builds a function from its text signature (for example, transfer(address,uint256)).
+// Tuple parameters are not supported.
+func functionFromSignature(sig string) (*types.SimpleFunction, error) {
+	name, inputs, err := parseSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	method := goEthAbi.NewMethod(name, name, goEthAbi.Function, "nonpayable", false, false, inputs, nil)
+	return types.FunctionFromAbiMethod(&method), nil
+}
+
+// parseSignature returns the name and the (unnamed) parameters of a text signature
+func parseSignature(sig string) (string, goEthAbi.Arguments, error) {
+	open := strings.Index(sig, "(")
+	if open < 1 || !strings.HasSuffix(sig, ")") {
+		return "", nil, fmt.Errorf("invalid signature %s", sig)
+	}
+	name := sig[:open]
+	params := sig[open+1 : len(sig)-1]
+	if strings.Contains(params, "(") {
+		return "", nil, fmt.Errorf("tuples are not supported: %s", sig)
+	}
+
+	inputs := goEthAbi.Arguments{}
+	if len(params) > 0 {
+		for i, param := range strings.Split(params, ",") {
+			typ, err := goEthAbi.NewType(param, "", nil)
+			if err != nil {
+				return "", nil, err
+			}
+			inputs = append(inputs, goEthAbi.Argument{Name: fmt.Sprintf("val_%d", i), Type: typ})
+		}
+	}
+	return name, inputs, nil
+}
+
+// eventFromSignature builds an event from its text signature taking the first nIndexed parameters to be
+// indexed. Tuple parameters are not supported.
+func eventFromSignature(sig string, nIndexed int) (*types.SimpleFunction, error) {
+	name, inputs, err := parseSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+	if nIndexed > len(inputs) {
+		return nil, fmt.Errorf("%s has fewer than %d parameters", sig, nIndexed)
+	}
+	for i := 0; i < nIndexed; i++ {
+		inputs[i].Indexed = true
+	}
+
+	event := goEthAbi.NewEvent(name, name, false, inputs)
+	return types.FunctionFromAbiEvent(&event), nil
+}
+
+// guessArguments decodes ABI encoded data without knowing its types. Each 32-byte word is taken to
+// be an address (if it has the shape of one), an offset to dynamic bytes (if it points to a valid
+// length prefixed byte array later in the data), or otherwise an unsigned integer.
+func guessArguments(data []byte) []types.SimpleParameter {
+	nWords := len(data) / 32
+	word := func(i int) []byte {
+		return data[i*32 : (i+1)*32]
+	}
+
+	ret := []types.SimpleParameter{}
+	headEnd := nWords // the head ends where the first dynamic value begins
+	for i := 0; i < nWords && i < headEnd; i++ {
+		w := word(i)
+		value := new(big.Int).SetBytes(w)
+
+		if isAddressWord(w) {
+			addr := base.BytesToAddress(w[12:])
+			ret = append(ret, types.SimpleParameter{
+				ParameterType: "address",
+				Value:         addr.Hex(),
+			})
+			continue
+		}
+
+		if value.IsUint64() {
+			offset := value.Uint64()
+			if offset%32 == 0 && offset > uint64(i*32) && offset+32 <= uint64(len(data)) {
+				start := int(offset / 32)
+				length := new(big.Int).SetBytes(word(start))
+				if length.IsUint64() && offset+32+length.Uint64() <= uint64(len(data)) {
+					if start < headEnd {
+						headEnd = start
+					}
+					bytes := data[offset+32 : offset+32+length.Uint64()]
+					ret = append(ret, types.SimpleParameter{
+						ParameterType: "bytes",
+						Value:         "0x" + hex.EncodeToString(bytes),
+					})
+					continue
+				}
+			}
+		}
+
+		ret = append(ret, types.SimpleParameter{
+			ParameterType: "uint256",
+			Value:         value.String(),
+		})
+	}
+	return ret
+}
+
+// isAddressWord returns true if the word looks like an address: twelve leading zero bytes followed by
+// a value too large to be a reasonable integer.
+func isAddressWord(w []byte) bool {
+	for _, b := range w[:12] {
+		if b != 0 {
+			return false
+		}
+	}
+	return w[12] != 0 || w[13] != 0
+}
@@ -0,0 +1,93 @@
+package articulate
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func TestFunctionFromSignature(t *testing.T) {
+	function, err := functionFromSignature("transfer(address,uint256)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if function.Encoding != "0xa9059cbb" || function.Name != "transfer" || len(function.Inputs) != 2 {
+		t.Error("wrong function", function.Encoding, function.Name, function.Inputs)
+	}
+
+	input := "000000000000000000000000f503017d7baf7fbc0fff7492b751025c6a78179b" +
+		"00000000000000000000000000000000000000000000000000000000000003e8"
+	if err = ArticulateFunction(function, input, ""); err != nil {
+		t.Fatal(err)
+	}
+	if function.Inputs[1].Value != "1000" {
+		t.Error("expected 1000, got", function.Inputs[1].Value)
+	}
+
+	for _, bad := range []string{"transfer", "(address)", "swap((uint256,address))", "foo(notAType)"} {
+		if _, err := functionFromSignature(bad); err == nil {
+			t.Error("expected an error for", bad)
+		}
+	}
+}
+
+func TestGuessArguments(t *testing.T) {
+	// an address, a number, and dynamic bytes (offset, length, data)
+	data, _ := hex.DecodeString(
+		"000000000000000000000000f503017d7baf7fbc0fff7492b751025c6a78179b" +
+			"00000000000000000000000000000000000000000000000000000000000003e8" +
+			"0000000000000000000000000000000000000000000000000000000000000060" +
+			"0000000000000000000000000000000000000000000000000000000000000003" +
+			"abcdef0000000000000000000000000000000000000000000000000000000000")
+
+	params := guessArguments(data)
+	expected := []struct {
+		typ   string
+		value string
+	}{
+		{"address", "0xf503017d7baf7fbc0fff7492b751025c6a78179b"},
+		{"uint256", "1000"},
+		{"bytes", "0xabcdef"},
+	}
+	if len(params) != len(expected) {
+		t.Fatal("expected", len(expected), "parameters, got", params)
+	}
+	for i, e := range expected {
+		if params[i].ParameterType != e.typ || params[i].Value != e.value {
+			t.Error(i, "expected", e.typ, e.value, "got", params[i].ParameterType, params[i].Value)
+		}
+	}
+}
+
+func TestEventFromSignature(t *testing.T) {
+	// Transfer(address,address,uint256) with the two addresses indexed
+	event, err := eventFromSignature("Transfer(address,address,uint256)", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Encoding != "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef" || event.FunctionType != "event" {
+		t.Error("wrong event", event.Encoding, event.FunctionType)
+	}
+
+	abiEvent, err := event.GetAbiEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics := []base.Hash{
+		base.HexToHash(event.Encoding),
+		base.HexToHash("0x000000000000000000000000f503017d7baf7fbc0fff7492b751025c6a78179b"),
+		base.HexToHash("0x000000000000000000000000054993ab0f2b1acc0fdc65405ee203b4271bebe6"),
+	}
+	data := "00000000000000000000000000000000000000000000000000000000000003e8"
+	if err = articulateArguments(abiEvent.Inputs, data, topics, event.Inputs); err != nil {
+		t.Fatal(err)
+	}
+	if event.Inputs[2].Value != "1000" {
+		t.Error("expected 1000, got", event.Inputs[2].Value)
+	}
+
+	if _, err := eventFromSignature("Transfer(address,address,uint256)", 4); err == nil {
+		t.Error("expected an error for more topics than parameters")
+	}
+}
@@ -36,6 +36,19 @@ func (abiCache *AbiCache) ArticulateLog(log *types.SimpleLog) error {
 			if log.ArticulatedLog, err = articulateLogFromMap(log, &abiCache.AbiMap); err != nil {
 				return err
 			}
+			if log.ArticulatedLog == nil && len(log.Topics) > 0 {
+				// Proxies emit the events of their implementation, so we need its ABI at this block
+				if abiCache.loadImplementationAbi(address, log.BlockNumber) {
+					if log.ArticulatedLog, err = articulateLogFromMap(log, &abiCache.AbiMap); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if abiCache.Guess && log.ArticulatedLog == nil {
+			// We have no ABI for the event, so we try to guess it from the signature database
+			log.ArticulatedLog = abiCache.guessEvent(log)
 		}
 		return nil
 	}
@@ -0,0 +1,189 @@
+package articulate
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+)
+
+// ProxyObservation records the implementation of a proxy contract at a given block. A zero
+// Implementation means the contract was not acting as a proxy at that block.
+type ProxyObservation struct {
+	BlockNumber    base.Blknum  `json:"blockNumber"`
+	Implementation base.Address `json:"implementation"`
+}
+
+// ProxyHistory is what we know of the implementation history of a proxy contract: the
+// implementations observed at each of the blocks queried so far, sorted by block.
+type ProxyHistory struct {
+	Proxy        base.Address       `json:"proxy"`
+	Observations []ProxyObservation `json:"observations"`
+}
+
+// ImplementationAt returns the implementation in place at the given block if it can be determined
+// from the history without querying the chain. This is the case if the block was queried before
+// or if it lies between two observations of the same implementation (i.e. no upgrade happened).
+func (h *ProxyHistory) ImplementationAt(bn base.Blknum) (base.Address, bool) {
+	pos := sort.Search(len(h.Observations), func(i int) bool {
+		return h.Observations[i].BlockNumber >= bn
+	})
+	if pos < len(h.Observations) {
+		next := h.Observations[pos]
+		if next.BlockNumber == bn {
+			return next.Implementation, true
+		}
+		if pos > 0 && h.Observations[pos-1].Implementation == next.Implementation {
+			return next.Implementation, true
+		}
+	}
+	return base.Address{}, false
+}
+
+// Insert adds an observation to the history keeping it sorted by block. It returns true if the
+// history changed.
+func (h *ProxyHistory) Insert(bn base.Blknum, implementation base.Address) bool {
+	pos := sort.Search(len(h.Observations), func(i int) bool {
+		return h.Observations[i].BlockNumber >= bn
+	})
+	if pos < len(h.Observations) && h.Observations[pos].BlockNumber == bn {
+		if h.Observations[pos].Implementation == implementation {
+			return false
+		}
+		h.Observations[pos].Implementation = implementation
+		return true
+	}
+	h.Observations = append(h.Observations, ProxyObservation{})
+	copy(h.Observations[pos+1:], h.Observations[pos:])
+	h.Observations[pos] = ProxyObservation{BlockNumber: bn, Implementation: implementation}
+	return true
+}
+
+// toProxyPath returns the path to the file in which the proxy's history is cached
+func toProxyPath(chain string, proxy base.Address) string {
+	return filepath.Join(config.PathToCache(chain), "proxies", proxy.Hex()+".json")
+}
+
+func readProxyHistory(chain string, proxy base.Address) *ProxyHistory {
+	history := &ProxyHistory{Proxy: proxy}
+	if data, err := os.ReadFile(toProxyPath(chain, proxy)); err == nil {
+		if err = json.Unmarshal(data, history); err != nil {
+			logger.Warn("Ignoring invalid proxy history for", proxy.Hex(), err)
+			history = &ProxyHistory{Proxy: proxy}
+		}
+	}
+	return history
+}
+
+func (h *ProxyHistory) write(chain string) error {
+	fileName := toProxyPath(chain, h.Proxy)
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	tmpName := fileName + ".tmp"
+	if err = os.WriteFile(tmpName, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, fileName)
+}
+
+// ImplementationAt returns the implementation of the proxy at the given block (or a zero address if
+// the contract is not a proxy at that block). Each contract's history is cached in memory, including
+// the blocks at which it was not a proxy, so that the chain need only be queried for blocks whose
+// implementation cannot be inferred. The histories of real proxies are also cached on disc and are
+// rewritten whenever they change.
+func (abiCache *AbiCache) ImplementationAt(proxy base.Address, bn base.Blknum) (base.Address, error) {
+	abiCache.proxyMutex.Lock()
+	if abiCache.proxies == nil {
+		abiCache.proxies = make(map[base.Address]*ProxyHistory)
+	}
+	history := abiCache.proxies[proxy]
+	if history == nil {
+		history = readProxyHistory(abiCache.Chain, proxy)
+		abiCache.proxies[proxy] = history
+	}
+	implementation, ok := history.ImplementationAt(bn)
+	abiCache.proxyMutex.Unlock()
+	if ok {
+		return implementation, nil
+	}
+
+	// The chain is queried without holding the lock so that other lookups are not held up
+	implementation, err := abiCache.Conn.GetContractProxyAt(proxy, bn)
+	if err != nil {
+		return base.Address{}, err
+	}
+
+	abiCache.proxyMutex.Lock()
+	defer abiCache.proxyMutex.Unlock()
+	if history.Insert(bn, implementation) && history.isProxy() {
+		if err = history.write(abiCache.Chain); err != nil {
+			logger.Warn("Could not cache proxy history for", proxy.Hex(), err)
+		}
+	}
+	return implementation, nil
+}
+
+// isProxy returns true if the contract was observed acting as a proxy at any block
+func (h *ProxyHistory) isProxy() bool {
+	for _, observation := range h.Observations {
+		if !observation.Implementation.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// loadImplementationAbi merges the ABI of the implementation behind the proxy at the given block into
+// the cache's ABI map. It returns true if there was an implementation whose ABI could be loaded. The
+// lookup is best effort: the proxy's storage may not be available at historical blocks (on a node that
+// is not an archive node, for example), in which case the proxy is not looked up again and its calls
+// are left unarticulated.
+func (abiCache *AbiCache) loadImplementationAbi(proxy base.Address, bn base.Blknum) bool {
+	if abiCache.skipMap.GetValue(proxy) || abiCache.noProxyMap.GetValue(proxy) {
+		return false
+	}
+
+	implementation, err := abiCache.ImplementationAt(proxy, bn)
+	if err != nil {
+		logger.Warn("Could not find the implementation of", proxy.Hex(), "at block", bn, err)
+		abiCache.noProxyMap.SetValue(proxy, true)
+		return false
+	} else if implementation.IsZero() {
+		return false
+	}
+
+	if err = abiCache.loadAbi(implementation); err != nil {
+		logger.Warn("Could not load the ABI of", implementation.Hex(), "(the implementation of", proxy.Hex()+")", err)
+		return false
+	}
+	return !abiCache.skipMap.GetValue(implementation)
+}
+
+// loadAbi loads the ABI for the address into the cache's ABI map (once). Addresses for which no ABI
+// can be loaded are remembered and skipped thereafter.
+func (abiCache *AbiCache) loadAbi(address base.Address) error {
+	if abiCache.loadedMap.GetValue(address) || abiCache.skipMap.GetValue(address) {
+		return nil
+	}
+	if err := abi.LoadAbi(abiCache.Conn, address, &abiCache.AbiMap); err != nil {
+		abiCache.skipMap.SetValue(address, true)
+		if !errors.Is(err, rpc.ErrNotAContract) {
+			return err
+		}
+		return nil
+	}
+	abiCache.loadedMap.SetValue(address, true)
+	return nil
+}
@@ -0,0 +1,64 @@
+package articulate
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func TestProxyHistory(t *testing.T) {
+	v1 := base.HexToAddress("0x1111111111111111111111111111111111111111")
+	v2 := base.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	history := ProxyHistory{Proxy: base.HexToAddress("0x3333333333333333333333333333333333333333")}
+	history.Insert(300, v2)
+	history.Insert(100, v1)
+	history.Insert(200, v1)
+	history.Insert(50, base.Address{})
+
+	for i := 1; i < len(history.Observations); i++ {
+		if history.Observations[i-1].BlockNumber >= history.Observations[i].BlockNumber {
+			t.Fatal("observations are not sorted", history.Observations)
+		}
+	}
+
+	tests := []struct {
+		bn       base.Blknum
+		expected base.Address
+		known    bool
+	}{
+		{10, base.Address{}, false},  // before anything observed
+		{50, base.Address{}, true},   // observed, not yet a proxy
+		{75, base.Address{}, false},  // implementation changed in between
+		{100, v1, true},              // observed
+		{150, v1, true},              // between two observations of v1
+		{250, base.Address{}, false}, // upgraded somewhere in between
+		{300, v2, true},              // observed
+		{400, base.Address{}, false}, // after anything observed
+	}
+	for _, test := range tests {
+		got, known := history.ImplementationAt(test.bn)
+		if known != test.known || got != test.expected {
+			t.Error("block", test.bn, "expected", test.expected.Hex(), test.known, "got", got.Hex(), known)
+		}
+	}
+
+	if !history.Insert(200, v2) {
+		t.Error("expected replacing an observation to change the history")
+	}
+	if history.Insert(200, v2) {
+		t.Error("expected repeating an observation not to change the history")
+	}
+	if got, _ := history.ImplementationAt(200); got != v2 {
+		t.Error("expected re-inserted observation to replace the old one, got", got.Hex())
+	}
+
+	if !history.isProxy() {
+		t.Error("expected the history to be a proxy's", history.Observations)
+	}
+	notProxy := ProxyHistory{}
+	notProxy.Insert(100, base.Address{})
+	if notProxy.isProxy() {
+		t.Error("expected the history not to be a proxy's", notProxy.Observations)
+	}
+}
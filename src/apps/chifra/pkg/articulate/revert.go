@@ -0,0 +1,214 @@
+package articulate
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/decode"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	goEthAbi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// errorSelector is the selector of Error(string), used by require and revert statements
+	errorSelector = "0x08c379a0"
+	// panicSelector is the selector of Panic(uint256), used by the compiler for failed assertions,
+	// arithmetic errors, out of bounds indexing, and the like
+	panicSelector = "0x4e487b71"
+)
+
+// panicReasons are the Solidity panic codes, see
+// https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized function",
+}
+
+// ArticulateRevert decodes the data a call reverted with into a readable reason. The message of an
+// Error(string) is returned as is, a Panic(uint256) is described by its code, and custom errors found
+// in errorMap are shown with their arguments. Data that cannot be decoded is returned unchanged, and
+// empty data results in an empty reason.
+func ArticulateRevert(data string, errorMap *abi.SelectorSyncMap) string {
+	if len(data) < 10 {
+		return ""
+	}
+
+	selector := strings.ToLower(data[:10])
+	payload := base.Hex2Bytes(data[10:])
+	switch selector {
+	case errorSelector:
+		if values, err := unpackRevert("string", payload); err == nil {
+			if msg, ok := values[0].(string); ok {
+				return decode.SanitizeString(msg)
+			}
+		}
+	case panicSelector:
+		if values, err := unpackRevert("uint256", payload); err == nil {
+			if code, ok := values[0].(*big.Int); ok {
+				reason := "unknown panic code"
+				if code.IsUint64() {
+					if r, ok := panicReasons[code.Uint64()]; ok {
+						reason = r
+					}
+				}
+				return fmt.Sprintf("Panic(0x%02x): %s", code, reason)
+			}
+		}
+	default:
+		if found := errorMap.GetValue(selector); found != nil {
+			if reason, err := articulateCustomError(found, data[10:]); err == nil {
+				return reason
+			}
+		}
+	}
+
+	return data
+}
+
+// unpackRevert unpacks the single argument of a built-in revert error
+func unpackRevert(argType string, payload []byte) ([]any, error) {
+	t, err := goEthAbi.NewType(argType, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := goEthAbi.Arguments{{Type: t}}.Unpack(payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("expected one value, got %d", len(values))
+	}
+	return values, nil
+}
+
+// articulateCustomError decodes the arguments of a custom error and presents the error as a call, for
+// example InsufficientBalance(available: 5, required: 10)
+func articulateCustomError(found *types.SimpleFunction, payload string) (string, error) {
+	art := found.Clone()
+	abiError, err := art.GetAbiError()
+	if err != nil {
+		return "", err
+	}
+	if len(abiError.Inputs) > 0 {
+		if err = articulateArguments(abiError.Inputs, payload, nil, art.Inputs); err != nil {
+			return "", err
+		}
+	}
+
+	args := make([]string, 0, len(art.Inputs))
+	for _, input := range art.Inputs {
+		value := fmt.Sprint(input.Value)
+		if len(input.Name) > 0 {
+			value = input.Name + ": " + value
+		}
+		args = append(args, value)
+	}
+	return art.Name + "(" + strings.Join(args, ", ") + ")", nil
+}
+
+// articulateRevertData decodes the data a call to address at block bn reverted with. Custom errors are
+// looked up in the ABI of the address (and of its implementation if the address is a proxy).
+func (abiCache *AbiCache) articulateRevertData(address base.Address, bn base.Blknum, data string) string {
+	if len(data) < 10 {
+		return ""
+	}
+
+	selector := strings.ToLower(data[:10])
+	if selector != errorSelector && selector != panicSelector && !address.IsZero() {
+		abiCache.loadErrors(address, bn)
+	}
+	return ArticulateRevert(data, &abiCache.errorMap)
+}
+
+// loadErrors loads the custom errors declared in the ABI of the address and, if the address is a proxy,
+// in the ABI of its implementation at the given block. Loading is best effort: an ABI that cannot be
+// found or read only means the revert reason is shown as raw data.
+func (abiCache *AbiCache) loadErrors(address base.Address, bn base.Blknum) {
+	addresses := []base.Address{address}
+	if implementation, err := abiCache.ImplementationAt(address, bn); err == nil && !implementation.IsZero() {
+		addresses = append(addresses, implementation)
+	}
+
+	for _, addr := range addresses {
+		if abiCache.errorsLoaded.GetValue(addr) {
+			continue
+		}
+		abiCache.errorsLoaded.SetValue(addr, true)
+		if err := abiCache.loadAbi(addr); err != nil || abiCache.skipMap.GetValue(addr) {
+			continue
+		}
+		// An ABI we cannot read declares no errors we can use, so we ignore the error
+		_ = abi.LoadAbiErrors(abiCache.Chain, addr, &abiCache.errorMap)
+	}
+}
+
+// isRevert returns true if a trace's error indicates that the call reverted (as opposed to, for
+// example, running out of gas), in which case it may carry revert data
+func isRevert(traceError string) bool {
+	return strings.Contains(strings.ToLower(traceError), "revert")
+}
+
+// replayRevert replays a call with eth_call at the block before bn and returns the data it reverted with.
+// The replay does not see the transactions that preceded the call in its block, so it may not revert the
+// same way the original call did (or at all). Failing to replay the call is not an error: the node may
+// not carry the state of the parent block, for example.
+func (abiCache *AbiCache) replayRevert(from, to base.Address, value *base.Wei, gas base.Gas, input string, bn base.Blknum) string {
+	if !abiCache.ReplayReverts || bn == 0 {
+		return ""
+	}
+	data, err := abiCache.Conn.GetRevertData(from, to, value, gas, base.Hex2Bytes(strings.TrimPrefix(input, "0x")), bn-1)
+	if err != nil {
+		return ""
+	}
+	return data
+}
+
+// articulateTraceRevert sets the revert reason of a reverted trace from the trace's output or, if the
+// output is empty, by replaying the call at the parent block
+func (abiCache *AbiCache) articulateTraceRevert(trace *types.SimpleTrace) {
+	if !isRevert(trace.Error) || trace.Action == nil {
+		return
+	}
+
+	var data string
+	if trace.Result != nil {
+		data = trace.Result.Output
+	}
+	if len(data) < 10 {
+		input := trace.Action.Input
+		if len(trace.Action.Init) > 0 {
+			input = trace.Action.Init
+		}
+		data = abiCache.replayRevert(trace.Action.From, trace.Action.To, &trace.Action.Value, trace.Action.Gas, input, trace.BlockNumber)
+	}
+
+	trace.RevertReason = abiCache.articulateRevertData(trace.Action.To, trace.BlockNumber, data)
+}
+
+// articulateTxRevert sets the revert reason of a failed transaction from its top-level trace or, if it
+// has no traces, by replaying the transaction at the parent block
+func (abiCache *AbiCache) articulateTxRevert(tx *types.SimpleTransaction) {
+	if !tx.IsError {
+		return
+	}
+
+	if len(tx.Traces) > 0 && len(tx.Traces[0].TraceAddress) == 0 {
+		// the traces have already been articulated
+		tx.RevertReason = tx.Traces[0].RevertReason
+		return
+	}
+
+	data := abiCache.replayRevert(tx.From, tx.To, &tx.Value, tx.Gas, tx.Input, tx.BlockNumber)
+	tx.RevertReason = abiCache.articulateRevertData(tx.To, tx.BlockNumber, data)
+}
@@ -0,0 +1,73 @@
+package articulate
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	goEthAbi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const errorsAbi = `[
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[{"name":"","type":"address"}]},
+	{"type":"error","name":"Paused","inputs":[]}
+]`
+
+func TestArticulateRevert(t *testing.T) {
+	parsed, err := goEthAbi.JSON(strings.NewReader(errorsAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errorMap := abi.SelectorSyncMap{}
+	for _, ethError := range parsed.Errors {
+		ethError := ethError
+		function := types.FunctionFromAbiError(&ethError)
+		errorMap.SetValue(function.Encoding, function)
+	}
+
+	pack := func(selector string, args goEthAbi.Arguments, values ...any) string {
+		packed, err := args.Pack(values...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return selector + base.Bytes2Hex(packed)
+	}
+	argsOf := func(types ...string) goEthAbi.Arguments {
+		args := goEthAbi.Arguments{}
+		for _, argType := range types {
+			typ, _ := goEthAbi.NewType(argType, "", nil)
+			args = append(args, goEthAbi.Argument{Type: typ})
+		}
+		return args
+	}
+	selector := func(name string) string {
+		return "0x" + base.Bytes2Hex(parsed.Errors[name].ID.Bytes()[:4])
+	}
+	owner := base.HexToAddress("0x00000000000000000000000000000000000a11ce")
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"empty", "0x", ""},
+		{"error string", pack(errorSelector, argsOf("string"), "Ownable: caller is not the owner"), "Ownable: caller is not the owner"},
+		{"panic", pack(panicSelector, argsOf("uint256"), big.NewInt(0x11)), "Panic(0x11): arithmetic underflow or overflow"},
+		{"unknown panic", pack(panicSelector, argsOf("uint256"), big.NewInt(0x99)), "Panic(0x99): unknown panic code"},
+		{"custom error", pack(selector("InsufficientBalance"), argsOf("uint256", "uint256"), big.NewInt(5), big.NewInt(10)), "InsufficientBalance(available: 5, required: 10)"},
+		{"unnamed argument", pack(selector("Unauthorized"), argsOf("address"), owner.Common()), "Unauthorized(arg0: " + owner.Hex() + ")"},
+		{"no arguments", selector("Paused"), "Paused()"},
+		{"unknown selector", "0xdeadbeef0000000000000000000000000000000000000000000000000000000000000001", "0xdeadbeef0000000000000000000000000000000000000000000000000000000000000001"},
+		{"malformed error string", errorSelector + "00", errorSelector + "00"},
+	}
+
+	for _, test := range tests {
+		if got := ArticulateRevert(test.data, &errorMap); got != test.expected {
+			t.Errorf("%s: got %q, expected %q", test.name, got, test.expected)
+		}
+	}
+}
@@ -10,6 +10,8 @@ import (
 )
 
 func (abiCache *AbiCache) ArticulateTrace(trace *types.SimpleTrace) (err error) {
+	abiCache.articulateTraceRevert(trace)
+
 	found, err := articulateTrace(trace, &abiCache.AbiMap)
 	if err != nil {
 		return err
@@ -36,6 +38,14 @@ func (abiCache *AbiCache) ArticulateTrace(trace *types.SimpleTrace) (err error)
 			if trace.ArticulatedTrace, err = articulateTrace(trace, &abiCache.AbiMap); err != nil {
 				return err
 			}
+			if len(trace.Action.Input) >= 10 && abiCache.AbiMap.GetValue(trace.Action.Input[:10]) == nil {
+				// The contract may be a proxy, in which case we need the ABI of its implementation at this block
+				if abiCache.loadImplementationAbi(address, trace.BlockNumber) {
+					if trace.ArticulatedTrace, err = articulateTrace(trace, &abiCache.AbiMap); err != nil {
+						return err
+					}
+				}
+			}
 		}
 
 		return nil
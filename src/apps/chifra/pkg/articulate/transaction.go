@@ -39,6 +39,14 @@ func (abiCache *AbiCache) ArticulateTransaction(tx *types.SimpleTransaction) err
 		if tx.ArticulatedTx, tx.Message, err = articulateTx(tx, &abiCache.AbiMap); err != nil {
 			return err
 		}
+		if len(tx.Input) >= 10 && abiCache.AbiMap.GetValue(tx.Input[:10]) == nil {
+			// The contract may be a proxy, in which case we need the ABI of its implementation at this block
+			if abiCache.loadImplementationAbi(address, tx.BlockNumber) {
+				if tx.ArticulatedTx, tx.Message, err = articulateTx(tx, &abiCache.AbiMap); err != nil {
+					return err
+				}
+			}
+		}
 	} else {
 		if message, ok := decode.ArticulateString(tx.Input); ok {
 			tx.Message = message
@@ -46,6 +54,11 @@ func (abiCache *AbiCache) ArticulateTransaction(tx *types.SimpleTransaction) err
 	}
 	// }
 
+	if abiCache.Guess && tx.ArticulatedTx == nil && tx.Message == "" && len(tx.Input) >= 10 && !address.IsZero() {
+		// We have no ABI for the call, so we try to guess it from the contract's bytecode
+		tx.ArticulatedTx = abiCache.guessFromCode(address, tx.BlockNumber, tx.Input)
+	}
+
 	if err = abiCache.ArticulateReceipt(tx.Receipt); err != nil {
 		return err
 	}
@@ -56,6 +69,8 @@ func (abiCache *AbiCache) ArticulateTransaction(tx *types.SimpleTransaction) err
 		}
 	}
 
+	abiCache.articulateTxRevert(tx)
+
 	return nil
 }
 
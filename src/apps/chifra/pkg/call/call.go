@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/articulate"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/parser"
@@ -191,64 +192,134 @@ func (call *ContractCall) Call(artFunc func(string, *types.SimpleFunction) error
 		logger.Fatal("should not happen ==> implementation error: artFunc is nil")
 	}
 
-	blockTs := base.Timestamp(0)
-	if call.Conn.StoreReadable() {
-		results = &types.SimpleResult{
-			Address:     call.Address,
-			BlockNumber: call.BlockNumber,
-			Encoding:    call.Method.Encoding,
-		}
-		if err := call.Conn.Store.Read(results, nil); err == nil {
-			// logger.Info("cache read:", results.Address, results.BlockNumber, call.Method.Encoding)
-			return results, nil
-			// } else {
-			// 	logger.Info("no cache read:", results.Address, results.BlockNumber, call.Method.Encoding, err)
-		}
-		blockTs = call.Conn.GetBlockTimestamp(call.BlockNumber)
+	results, blockTs := call.readCache()
+	if results != nil {
+		return results, nil
+	}
+
+	packed, err := call.Pack()
+	if err != nil {
+		return nil, err
 	}
 
 	blockNumberHex := "0x" + strconv.FormatUint(call.BlockNumber, 16)
+	rawBytes, err := query.Query[string](call.Conn.Chain, "eth_call", query.Params{
+		map[string]any{
+			"to":   call.Address.Hex(),
+			"data": "0x" + base.Bytes2Hex(packed),
+		},
+		blockNumberHex,
+	})
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	var packed []byte
-	if call.encoded != "" {
-		packed = base.Hex2Bytes(call.encoded[2:])
-	} else {
-		packed, err = call.Method.Pack(call.Arguments)
+	return call.toResult(packed, *rawBytes, blockTs, artFunc)
+}
+
+// CallMany makes each of the calls (which may be at different blocks) and returns their results in the
+// same order. Calls at the same block are aggregated into as few eth_calls as possible with Multicall.
+// As with Call, results are read from and written to the cache if it is enabled. A call that fails does
+// not fail the others: its result is nil and the corresponding entry in the returned errors carries the
+// reason the call reverted (if any). The final error is returned only if no results could be had at all.
+func CallMany(conn *rpc.Connection, calls []*ContractCall, artFunc func(string, *types.SimpleFunction) error) ([]*types.SimpleResult, []error, error) {
+	if artFunc == nil {
+		logger.Fatal("should not happen ==> implementation error: artFunc is nil")
+	}
+
+	ret := make([]*types.SimpleResult, len(calls))
+	errs := make([]error, len(calls))
+	timestamps := make([]base.Timestamp, len(calls))
+	packed := make([][]byte, len(calls))
+	byBlock := map[uint64][]int{}
+	blocks := []uint64{}
+	for i, call := range calls {
+		if ret[i], timestamps[i] = call.readCache(); ret[i] != nil {
+			continue
+		}
+		var err error
+		if packed[i], err = call.Pack(); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := byBlock[call.BlockNumber]; !ok {
+			blocks = append(blocks, call.BlockNumber)
+		}
+		byBlock[call.BlockNumber] = append(byBlock[call.BlockNumber], i)
+	}
+
+	for _, bn := range blocks {
+		indices := byBlock[bn]
+		requests := make([]rpc.MulticallRequest, 0, len(indices))
+		for _, i := range indices {
+			requests = append(requests, rpc.MulticallRequest{Target: calls[i].Address, Data: packed[i]})
+		}
+
+		results, err := conn.Multicall(requests, bn)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		for j, i := range indices {
+			if !results[j].Success {
+				errs[i] = calls[i].failure(results[j].ReturnData)
+				continue
+			}
+			returned := "0x" + base.Bytes2Hex(results[j].ReturnData)
+			ret[i], errs[i] = calls[i].toResult(packed[i], returned, timestamps[i], artFunc)
 		}
 	}
 
+	return ret, errs, nil
+}
+
+// failure returns the error reported for a call that failed, including the reason it reverted if the
+// revert data can be decoded. Custom errors are looked up in the called contract's ABI.
+func (call *ContractCall) failure(revertData []byte) error {
+	msg := fmt.Sprintf("the call to %s (%s) at block %d failed", call.Address.Hex(), call.Method.Signature, call.BlockNumber)
+	if len(revertData) == 0 {
+		return errors.New(msg)
+	}
+
+	errorMap := &abi.SelectorSyncMap{}
+	// The ABI was loaded when the call was created; if it declares no errors, we decode the built-in ones only
+	_ = abi.LoadAbiErrors(call.Conn.Chain, call.Address, errorMap)
+	return fmt.Errorf("%s: %s", msg, articulate.ArticulateRevert("0x"+base.Bytes2Hex(revertData), errorMap))
+}
+
+// readCache returns the call's results if they are in the cache. Otherwise, it returns nil and (if
+// the cache is enabled) the timestamp of the call's block.
+func (call *ContractCall) readCache() (*types.SimpleResult, base.Timestamp) {
+	if !call.Conn.StoreReadable() {
+		return nil, base.Timestamp(0)
+	}
+
+	results := &types.SimpleResult{
+		Address:     call.Address,
+		BlockNumber: call.BlockNumber,
+		Encoding:    call.Method.Encoding,
+	}
+	if err := call.Conn.Store.Read(results, nil); err == nil {
+		return results, base.Timestamp(0)
+	}
+	return nil, call.Conn.GetBlockTimestamp(call.BlockNumber)
+}
+
+// toResult articulates the bytes returned by the call and writes the results to the cache (if enabled
+// and the block is final).
+func (call *ContractCall) toResult(packed []byte, rawBytes string, blockTs base.Timestamp, artFunc func(string, *types.SimpleFunction) error) (*types.SimpleResult, error) {
 	packedHex := "0x" + base.Bytes2Hex(packed)
 	encodedArguments := ""
 	if len(packedHex) > 10 {
 		encodedArguments = packedHex[10:]
 	}
 
-	rawBytes, err := query.Query[string](call.Conn.Chain, "eth_call", query.Params{
-		map[string]any{
-			"to":   call.Address.Hex(),
-			"data": packedHex,
-		},
-		blockNumberHex,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	function := call.Method.Clone()
 	// articulate it if possible
-	if rawBytes != nil {
-		str := *rawBytes
-		if err = artFunc(str, function); err != nil {
-			return nil, err
-		}
+	if err := artFunc(rawBytes, function); err != nil {
+		return nil, err
 	}
 
-	results = &types.SimpleResult{
+	results := &types.SimpleResult{
 		BlockNumber:      call.BlockNumber,
 		Timestamp:        blockTs,
 		Address:          call.Address,
@@ -256,7 +327,7 @@ func (call *ContractCall) Call(artFunc func(string, *types.SimpleFunction) error
 		Encoding:         call.Method.Encoding,
 		Signature:        call.Method.Signature,
 		EncodedArguments: encodedArguments,
-		ReturnedBytes:    *rawBytes,
+		ReturnedBytes:    rawBytes,
 		ArticulatedOut:   function,
 	}
 	results.Values = make(map[string]string)
@@ -266,12 +337,6 @@ func (call *ContractCall) Call(artFunc func(string, *types.SimpleFunction) error
 
 	if call.Conn.StoreWritable() && call.Conn.EnabledMap["results"] && base.IsFinal(call.Conn.LatestBlockTimestamp, blockTs) {
 		_ = call.Conn.Store.Write(results, nil)
-		// logger.Info("Writing call results to the database...", results.Address, results.BlockNumber, call.Method.Encoding)
-		// if err := call.Conn.Store.Write(results, nil); err != nil {
-		// 	logger.Warn("Failed to write call results to the database", err) // report but don't fail
-		// }
-		// } else if !isFin {
-		// 	logger.Info("Not caching result (not ripe)...", results.Address, results.BlockNumber, call.Method.Encoding)
 	}
 
 	return results, nil
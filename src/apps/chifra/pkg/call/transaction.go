@@ -0,0 +1,126 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package call
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Transaction is an EIP-1559 (type 2) transaction built from a contract call. Once every field is
+// filled in, building it is deterministic, so the same inputs always produce the same payload.
+type Transaction struct {
+	ChainId     uint64
+	Nonce       uint64
+	From        base.Address
+	To          base.Address
+	Value       *big.Int
+	Gas         uint64
+	MaxFee      *big.Int
+	PriorityFee *big.Int
+	Input       []byte
+	signed      *ethTypes.Transaction
+}
+
+// Pack returns the call's ABI encoded input data
+func (call *ContractCall) Pack() ([]byte, error) {
+	if call.encoded != "" {
+		return base.Hex2Bytes(call.encoded[2:]), nil
+	}
+	return call.Method.Pack(call.Arguments)
+}
+
+// toEth returns the unsigned go-ethereum transaction
+func (tx *Transaction) toEth() *ethTypes.Transaction {
+	to := tx.To.Common()
+	return ethTypes.NewTx(&ethTypes.DynamicFeeTx{
+		ChainID:   new(big.Int).SetUint64(tx.ChainId),
+		Nonce:     tx.Nonce,
+		GasTipCap: tx.PriorityFee,
+		GasFeeCap: tx.MaxFee,
+		Gas:       tx.Gas,
+		To:        &to,
+		Value:     tx.Value,
+		Data:      tx.Input,
+	})
+}
+
+// signer returns the signer for the transaction's chain
+func (tx *Transaction) signer() ethTypes.Signer {
+	return ethTypes.LatestSignerForChainID(new(big.Int).SetUint64(tx.ChainId))
+}
+
+// UnsignedPayload returns the unsigned transaction's encoding (the type byte followed by the RLP list of
+// its fields with an empty signature), which offline signers accept.
+func (tx *Transaction) UnsignedPayload() ([]byte, error) {
+	return tx.toEth().MarshalBinary()
+}
+
+// SigningHash returns the hash that is signed by the sender
+func (tx *Transaction) SigningHash() base.Hash {
+	return base.BytesToHash(tx.signer().Hash(tx.toEth()).Bytes())
+}
+
+// IsSigned returns true if the transaction has been signed
+func (tx *Transaction) IsSigned() bool {
+	return tx.signed != nil
+}
+
+// SignedPayload returns the signed transaction's encoding (suitable for eth_sendRawTransaction) and its hash
+func (tx *Transaction) SignedPayload() ([]byte, base.Hash, error) {
+	if tx.signed == nil {
+		return nil, base.Hash{}, errors.New("the transaction is not signed")
+	}
+	payload, err := tx.signed.MarshalBinary()
+	if err != nil {
+		return nil, base.Hash{}, err
+	}
+	return payload, base.BytesToHash(tx.signed.Hash().Bytes()), nil
+}
+
+// SignWithKeystore decrypts the key in the keystore file with the password and signs the transaction.
+// The key must belong to the transaction's sender (if one is set).
+func (tx *Transaction) SignWithKeystore(path, password string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return fmt.Errorf("could not decrypt keystore %s: %w", path, err)
+	}
+
+	keyAddr := base.BytesToAddress(crypto.PubkeyToAddress(key.PrivateKey.PublicKey).Bytes())
+	if !tx.From.IsZero() && tx.From != keyAddr {
+		return fmt.Errorf("the keystore holds the key for %s, not the sender %s", keyAddr.Hex(), tx.From.Hex())
+	}
+	tx.From = keyAddr
+
+	tx.signed, err = ethTypes.SignTx(tx.toEth(), tx.signer(), key.PrivateKey)
+	return err
+}
+
+// KeystoreAddress returns the address of the key held in the keystore file (which is not encrypted)
+func KeystoreAddress(path string) (base.Address, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return base.Address{}, err
+	}
+	var header struct {
+		Address string `json:"address"`
+	}
+	if err = json.Unmarshal(data, &header); err != nil || !base.IsValidAddress("0x"+header.Address) {
+		return base.Address{}, fmt.Errorf("%s is not a valid keystore file", path)
+	}
+	return base.HexToAddress("0x" + header.Address), nil
+}
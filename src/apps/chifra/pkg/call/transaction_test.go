@@ -0,0 +1,91 @@
+package call
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestTransaction(t *testing.T) {
+	newTx := func() *Transaction {
+		return &Transaction{
+			ChainId:     1,
+			Nonce:       7,
+			To:          base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f"),
+			Value:       big.NewInt(0),
+			Gas:         60000,
+			MaxFee:      big.NewInt(30000000000),
+			PriorityFee: big.NewInt(1000000000),
+			Input:       base.Hex2Bytes("a9059cbb000000000000000000000000f503017d7baf7fbc0fff7492b751025c6a78179b0000000000000000000000000000000000000000000000000000000000000064"),
+		}
+	}
+
+	tx := newTx()
+	unsigned, err := tx.UnsignedPayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unsigned[0] != ethTypes.DynamicFeeTxType {
+		t.Error("expected a type 2 payload, got type", unsigned[0])
+	}
+	var decodedUnsigned ethTypes.Transaction
+	if err = decodedUnsigned.UnmarshalBinary(unsigned); err != nil {
+		t.Fatal(err)
+	}
+	if got := base.BytesToHash(ethTypes.LatestSignerForChainID(big.NewInt(1)).Hash(&decodedUnsigned).Bytes()); got != tx.SigningHash() {
+		t.Error("the signing hash is not the hash of the unsigned payload", got, tx.SigningHash())
+	}
+	again, _ := newTx().UnsignedPayload()
+	if !bytes.Equal(unsigned, again) {
+		t.Error("building the same transaction twice should produce the same payload")
+	}
+	if tx.IsSigned() {
+		t.Error("transaction should not be signed")
+	}
+
+	// Sign with a freshly created keystore
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.NewAccount("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := account.URL.Path
+	expected := base.BytesToAddress(account.Address.Bytes())
+
+	keyAddr, err := KeystoreAddress(path)
+	if err != nil || keyAddr != expected {
+		t.Error("wrong keystore address", keyAddr, err)
+	}
+
+	if err = tx.SignWithKeystore(path, "wrong"); err == nil {
+		t.Error("signing with the wrong password should fail")
+	}
+	tx.From = base.HexToAddress("0x0000000000000000000000000000000000000001")
+	if err = tx.SignWithKeystore(path, "secret"); err == nil {
+		t.Error("signing for a different sender should fail")
+	}
+	tx.From = base.Address{}
+	if err = tx.SignWithKeystore(path, "secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	payload, hash, err := tx.SignedPayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ethTypes.Transaction
+	if err = decoded.UnmarshalBinary(payload); err != nil {
+		t.Fatal(err)
+	}
+	sender, err := ethTypes.Sender(ethTypes.NewLondonSigner(big.NewInt(1)), &decoded)
+	if err != nil || sender != account.Address {
+		t.Error("wrong sender recovered from signed transaction", sender.Hex(), err)
+	}
+	if base.BytesToHash(decoded.Hash().Bytes()) != hash || decoded.Nonce() != 7 || decoded.Gas() != 60000 {
+		t.Error("signed transaction does not match", decoded.Hash().Hex(), hash)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+import (
+	"strings"
+)
+
+// AbiSettings carries config information for the ABI providers per chain
+type AbiSettings struct {
+	// Providers is a comma separated list of the providers to query (in order) for ABIs not found
+	// in the cache. Valid providers are local, sourcify, and explorer.
+	Providers string `toml:"providers,omitempty" json:"providers,omitempty"`
+	// LocalPath is a folder containing ABI files (or compiler artifacts) named by address
+	LocalPath string `toml:"localPath,omitempty" json:"localPath,omitempty"`
+	// SourcifyPath is the root of a Sourcify repository mirror, either a folder or a URL
+	SourcifyPath string `toml:"sourcifyPath,omitempty" json:"sourcifyPath,omitempty"`
+	// ExplorerUrl is the API endpoint of an Etherscan compatible block explorer for the chain
+	ExplorerUrl string `toml:"explorerUrl,omitempty" json:"explorerUrl,omitempty"`
+	// ExplorerKey is the name of the entry in the [keys] group holding the explorer's API key
+	ExplorerKey string `toml:"explorerKey,omitempty" json:"explorerKey,omitempty"`
+	// GuessSignatures, if set, articulates the calls and events of contracts with no ABI from the
+	// signatures in the signature database (see chifra abis --find and --import)
+	GuessSignatures bool `toml:"guessSignatures,omitempty" json:"guessSignatures,omitempty"`
+}
+
+// DefaultAbiProviders is the order in which ABI providers are queried if none is configured
+const DefaultAbiProviders = "local,sourcify,explorer"
+
+// GetAbiSettings returns the ABI provider settings per chain. Mainnet defaults to Etherscan as its
+// explorer. Other chains have no explorer unless one is configured.
+func GetAbiSettings(chain string) AbiSettings {
+	settings := GetRootConfig().Chains[chain].Abis
+	if len(settings.Providers) == 0 {
+		settings.Providers = DefaultAbiProviders
+	}
+	if len(settings.ExplorerUrl) == 0 && chain == "mainnet" {
+		settings.ExplorerUrl = "https://api.etherscan.io/api"
+		if len(settings.ExplorerKey) == 0 {
+			settings.ExplorerKey = "etherscan"
+		}
+	}
+	return settings
+}
+
+// ProviderList returns the configured providers in the order they should be queried.
+func (s *AbiSettings) ProviderList() []string {
+	ret := []string{}
+	for _, provider := range strings.Split(s.Providers, ",") {
+		if provider = strings.TrimSpace(provider); len(provider) > 0 {
+			ret = append(ret, provider)
+		}
+	}
+	return ret
+}
@@ -13,6 +13,7 @@ type chainGroup struct {
 	RpcProvider    string         `toml:"rpcProvider"`
 	Symbol         string         `toml:"symbol"`
 	Scrape         ScrapeSettings `toml:"scrape"`
+	Abis           AbiSettings    `toml:"abis,omitempty"`
 }
 
 // GetChain returns the chain for a given chain
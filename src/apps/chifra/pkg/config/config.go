@@ -25,6 +25,8 @@ type ConfigFile struct {
 	Version   versionGroup          `toml:"version"`
 	Settings  settingsGroup         `toml:"settings"`
 	Keys      map[string]keyGroup   `toml:"keys"`
+	Server    ServerSettings        `toml:"server"`
+	Names     NamesSettings         `toml:"names"`
 	Pinning   pinningGroup          `toml:"pinning"`
 	Unchained unchainedGroup        `toml:"unchained"`
 	Chains    map[string]chainGroup `toml:"chains"`
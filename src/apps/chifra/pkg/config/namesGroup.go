@@ -0,0 +1,43 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+import (
+	"strings"
+)
+
+// NamesSettings configures how chifra names imports name packs. Both values are comma separated lists.
+// TrustedSigners are the addresses whose signed name packs are imported without --untrusted.
+// SourcePriority lists the sources of names (highest priority first) used by --policy priority.
+type NamesSettings struct {
+	TrustedSigners string `toml:"trustedSigners,omitempty" json:"trustedSigners,omitempty"`
+	SourcePriority string `toml:"sourcePriority,omitempty" json:"sourcePriority,omitempty"`
+}
+
+// GetNames returns the names database settings
+func GetNames() NamesSettings {
+	return GetRootConfig().Names
+}
+
+// SignerList returns the trusted signers in lower case
+func (s *NamesSettings) SignerList() []string {
+	return splitList(s.TrustedSigners)
+}
+
+// PriorityList returns the sources in lower case in order of priority (highest first)
+func (s *NamesSettings) PriorityList() []string {
+	return splitList(s.SourcePriority)
+}
+
+// splitList returns the non-empty items in a comma separated list in lower case
+func splitList(list string) []string {
+	ret := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			ret = append(ret, strings.ToLower(item))
+		}
+	}
+	return ret
+}
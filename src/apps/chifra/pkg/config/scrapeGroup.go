@@ -17,6 +17,12 @@ type ScrapeSettings struct {
 	UnripeDist   uint64 `toml:"unripeDist" json:"unripeDist"`
 	AllowMissing bool   `toml:"allowMissing" json:"allowMissing,omitempty"`
 	ChannelCount uint64 `toml:"channelCount" json:"channelCount,omitempty"`
+	// The following settings control the format of the Bloom filters written by the scraper. If left
+	// empty, the scraper writes Bloom filters in the original, unversioned format.
+	BloomFormat   string `toml:"bloomFormat,omitempty" json:"bloomFormat,omitempty"`
+	BloomWidth    uint64 `toml:"bloomWidth,omitempty" json:"bloomWidth,omitempty"`
+	BloomHashes   uint64 `toml:"bloomHashes,omitempty" json:"bloomHashes,omitempty"`
+	BloomMaxAddrs uint64 `toml:"bloomMaxAddrs,omitempty" json:"bloomMaxAddrs,omitempty"`
 }
 
 // GetScrape returns the scraper settings per chain
@@ -31,6 +37,10 @@ func (s *ScrapeSettings) TestLog(chain string, test bool) {
 	logger.TestLog(false, "UnripeDist: ", s.UnripeDist)
 	logger.TestLog(false, "ChannelCount: ", s.ChannelCount)
 	logger.TestLog(false, "AllowMissing: ", s.AllowMissing)
+	logger.TestLog(len(s.BloomFormat) > 0, "BloomFormat: ", s.BloomFormat)
+	logger.TestLog(s.BloomWidth != 0, "BloomWidth: ", s.BloomWidth)
+	logger.TestLog(s.BloomHashes != 0, "BloomHashes: ", s.BloomHashes)
+	logger.TestLog(s.BloomMaxAddrs != 0, "BloomMaxAddrs: ", s.BloomMaxAddrs)
 }
 
 func SetScrapeArgs(chain string, args map[string]string) {
@@ -0,0 +1,43 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+// Scopes of the API server's keys. Read only keys may not call the routes (or options) that change
+// the index, the caches, the names database, the monitors, or the configuration.
+const (
+	ScopeRead  = "read"
+	ScopeAdmin = "admin"
+)
+
+// ServerSettings configures the API server (chifra daemon). If any keys are configured, every request
+// must carry one of them (as a bearer token or in the X-API-Key header). Rate limits are in requests
+// per second for each client (each key or, if there are no keys, each remote address). A zero limit
+// means no limit. The Monitor settings configure the monitor watcher the daemon runs with --monitor.
+type ServerSettings struct {
+	TlsCert     string             `toml:"tlsCert,omitempty" json:"tlsCert,omitempty"`
+	TlsKey      string             `toml:"tlsKey,omitempty" json:"tlsKey,omitempty"`
+	RateLimit   float64            `toml:"rateLimit,omitempty" json:"rateLimit,omitempty"`
+	Burst       uint64             `toml:"burst,omitempty" json:"burst,omitempty"`
+	RouteLimits map[string]float64 `toml:"routeLimits,omitempty" json:"routeLimits,omitempty"`
+	ApiKeys     map[string]ApiKey  `toml:"apiKeys,omitempty" json:"apiKeys,omitempty"`
+	// The files given to chifra monitors --watch as --commands and --watchlist (default "existing") and
+	// the number of seconds it sleeps between runs (default 14)
+	MonitorCommands  string  `toml:"monitorCommands,omitempty" json:"monitorCommands,omitempty"`
+	MonitorWatchlist string  `toml:"monitorWatchlist,omitempty" json:"monitorWatchlist,omitempty"`
+	MonitorSleep     float64 `toml:"monitorSleep,omitempty" json:"monitorSleep,omitempty"`
+}
+
+// ApiKey is a key (named by its entry in the [server.apiKeys] group) with which clients call the
+// API server. If its RateLimit is zero, the server's rateLimit applies.
+type ApiKey struct {
+	Key       string  `toml:"key" json:"key"`
+	Scope     string  `toml:"scope,omitempty" json:"scope,omitempty"`
+	RateLimit float64 `toml:"rateLimit,omitempty" json:"rateLimit,omitempty"`
+}
+
+// GetServer returns the API server's settings
+func GetServer() ServerSettings {
+	return GetRootConfig().Server
+}
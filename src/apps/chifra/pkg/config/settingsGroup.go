@@ -10,6 +10,11 @@ type settingsGroup struct {
 	DefaultChain   string `toml:"defaultChain"`
 	DefaultGateway string `toml:"defaultGateway,omitempty"`
 	NotifyUrl      string `toml:"notifyUrl" json:"notifyUrl,omitempty"`
+	// IndexReader, if set, makes the API server keep recently used index chunks open in a shared cache
+	// and selects how they are read: "file", "memory", or "mmap". IndexCacheSize is the number of chunks
+	// kept open (default 64).
+	IndexReader    string `toml:"indexReader,omitempty" json:"indexReader,omitempty"`
+	IndexCacheSize uint64 `toml:"indexCacheSize,omitempty" json:"indexCacheSize,omitempty"`
 }
 
 func GetSettings() settingsGroup {
@@ -8,4 +8,10 @@ const (
 	// MagicNumber is used to check data validity
 	MagicNumber      = 0xdeadbeef
 	SmallMagicNumber = uint16(0xdead)
+	// ParamsMagicNumber marks a Bloom filter whose header is followed by its filter parameters
+	ParamsMagicNumber = uint16(0xdeaf)
+	// PostingMagicNumber marks a posting list (the appearances of a single hot address)
+	PostingMagicNumber = uint32(0xdeadfeed)
+	// SignatureMagicNumber marks the signature database (four-byte and event signatures)
+	SignatureMagicNumber = uint32(0xdeadc0de)
 )
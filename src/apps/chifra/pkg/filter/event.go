@@ -0,0 +1,488 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EventFilter selects logs by an event's signature and by the values of the event's arguments.
+// Equality conditions on indexed arguments are compiled to topics. All other conditions are
+// checked against the values decoded from the log.
+type EventFilter struct {
+	Signature string // the canonical signature of the event, for example Transfer(address,address,uint256)
+	Topic0    base.Hash
+	params    []eventParam
+	data      abi.Arguments
+	topics    [][]base.Hash // for each indexed argument, the topics it must match (if any)
+	anyOf     [][]condition // for each non-indexed argument, the values one of which it must equal
+	allOf     []condition   // the remaining conditions, all of which must hold
+}
+
+type eventParam struct {
+	name    string
+	arg     abi.Argument
+	indexed bool
+	pos     int // the position of the argument among either the indexed or non-indexed arguments
+}
+
+type condition struct {
+	param int
+	op    string
+	value any // a *big.Int, base.Address, bool, string, or []byte depending on the argument's type
+}
+
+var (
+	conditionRe = regexp.MustCompile(`^\s*([A-Za-z_$][A-Za-z0-9_$]*|[0-9]+)\s*(==|!=|>=|<=|=|>|<)\s*(.+?)\s*$`)
+	intTypeRe   = regexp.MustCompile(`\b(u?int)(\[|$)`)
+	eventNameRe = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+)
+
+// NewEventFilter compiles an event signature such as `Transfer(address indexed from, address indexed to,
+// uint256 value)` and a list of conditions such as `to=0x...` or `value>1e18` into a filter. A condition
+// names an argument either by its name or by its position, and equality conditions on the same argument
+// match any of their values.
+func NewEventFilter(signature string, conditions []string) (*EventFilter, error) {
+	name, params, err := parseEvent(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := make([]string, 0, len(params))
+	f := &EventFilter{params: params}
+	for _, p := range params {
+		canonical = append(canonical, p.arg.Type.String())
+		if p.indexed {
+			f.topics = append(f.topics, nil)
+		} else {
+			f.data = append(f.data, p.arg)
+			f.anyOf = append(f.anyOf, nil)
+		}
+	}
+	f.Signature = name + "(" + strings.Join(canonical, ",") + ")"
+	f.Topic0 = base.BytesToHash(crypto.Keccak256([]byte(f.Signature)))
+
+	for _, c := range conditions {
+		cond, err := f.parseCondition(c)
+		if err != nil {
+			return nil, err
+		}
+		p := &f.params[cond.param]
+		switch {
+		case cond.op == "=" && p.indexed:
+			f.topics[p.pos] = append(f.topics[p.pos], toTopic(p.arg.Type, cond.value))
+		case cond.op == "=":
+			f.anyOf[p.pos] = append(f.anyOf[p.pos], cond)
+		default:
+			f.allOf = append(f.allOf, cond)
+		}
+	}
+
+	return f, nil
+}
+
+// Topics returns the topics a matching log must have, suitable for use with eth_getLogs. The first
+// entry is the event's topic0. A nil entry matches any topic.
+func (f *EventFilter) Topics() [][]base.Hash {
+	return append([][]base.Hash{{f.Topic0}}, f.topics...)
+}
+
+// Passes returns true if the log was emitted by the filter's event and its arguments meet all of the
+// filter's conditions.
+func (f *EventFilter) Passes(log *types.SimpleLog) bool {
+	logFilter := types.SimpleLogFilter{EventTopics: f.Topics()}
+	if len(log.Topics) != len(f.topics)+1 || !logFilter.PassesFilter(log) {
+		return false
+	}
+
+	hasAnyOf := false
+	for _, conds := range f.anyOf {
+		hasAnyOf = hasAnyOf || len(conds) > 0
+	}
+	if !hasAnyOf && len(f.allOf) == 0 {
+		return true
+	}
+
+	values, err := f.decode(log)
+	if err != nil {
+		return false
+	}
+
+	for _, conds := range f.anyOf {
+		if len(conds) == 0 {
+			continue
+		}
+		passes := false
+		for _, cond := range conds {
+			if cond.matches(values[cond.param]) {
+				passes = true
+				break
+			}
+		}
+		if !passes {
+			return false
+		}
+	}
+	for _, cond := range f.allOf {
+		if !cond.matches(values[cond.param]) {
+			return false
+		}
+	}
+	return true
+}
+
+// decode returns the value of each of the event's arguments. Indexed arguments of dynamic types are
+// returned as the hash found in the topic.
+func (f *EventFilter) decode(log *types.SimpleLog) ([]any, error) {
+	data, err := f.data.Unpack(base.Hex2Bytes(strings.TrimPrefix(log.Data, "0x")))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != len(f.data) {
+		return nil, errors.New("unexpected number of data values")
+	}
+
+	ret := make([]any, 0, len(f.params))
+	for _, p := range f.params {
+		if !p.indexed {
+			ret = append(ret, data[p.pos])
+			continue
+		}
+		topic := log.Topics[p.pos+1]
+		if isHashedWhenIndexed(p.arg.Type) {
+			ret = append(ret, topic.Bytes())
+			continue
+		}
+		arg := p.arg
+		arg.Indexed = false // a static indexed value is encoded in its topic exactly as it is in data
+		values, err := abi.Arguments{arg}.Unpack(topic.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, values[0])
+	}
+	return ret, nil
+}
+
+// parseEvent parses an event signature into the event's name and arguments
+func parseEvent(signature string) (string, []eventParam, error) {
+	sig := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(signature), "event "))
+	open := strings.Index(sig, "(")
+	if open < 1 || !strings.HasSuffix(sig, ")") {
+		return "", nil, fmt.Errorf("invalid event signature %s", signature)
+	}
+	name := strings.TrimSpace(sig[:open])
+	if !eventNameRe.MatchString(name) {
+		return "", nil, fmt.Errorf("invalid event name %s", name)
+	}
+
+	params := []eventParam{}
+	body := strings.TrimSpace(sig[open+1 : len(sig)-1])
+	if len(body) == 0 {
+		return name, params, nil
+	}
+
+	nIndexed, nData := 0, 0
+	for i, part := range strings.Split(body, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 || len(fields) > 3 || strings.Contains(part, "(") {
+			return "", nil, fmt.Errorf("invalid or unsupported argument %q in event signature", strings.TrimSpace(part))
+		}
+
+		indexed := len(fields) > 1 && fields[1] == "indexed"
+		argName := ""
+		switch {
+		case len(fields) == 3 && indexed:
+			argName = fields[2]
+		case len(fields) == 2 && !indexed:
+			argName = fields[1]
+		case len(fields) != 1 && !(len(fields) == 2 && indexed):
+			return "", nil, fmt.Errorf("invalid argument %q in event signature", strings.TrimSpace(part))
+		}
+
+		typ, err := abi.NewType(intTypeRe.ReplaceAllString(fields[0], "${1}256${2}"), "", nil)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid type %s in event signature: %w", fields[0], err)
+		}
+
+		p := eventParam{
+			name:    argName,
+			arg:     abi.Argument{Name: fmt.Sprintf("arg%d", i), Type: typ, Indexed: indexed},
+			indexed: indexed,
+		}
+		if indexed {
+			p.pos = nIndexed
+			nIndexed++
+		} else {
+			p.pos = nData
+			nData++
+		}
+		params = append(params, p)
+	}
+
+	if nIndexed > 3 {
+		return "", nil, fmt.Errorf("an event may have at most three indexed arguments")
+	}
+	return name, params, nil
+}
+
+// RejoinConditions rejoins conditions that were split on spaces (as the API splits list options) so that a
+// condition with spaces around its operator (for example, value > 1e18) is a single condition again
+func RejoinConditions(parts []string) []string {
+	ret := make([]string, 0, len(parts))
+	current := ""
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		switch {
+		case len(part) == 0:
+			continue
+		case len(current) == 0:
+			current = part
+		case strings.ContainsAny(current[len(current)-1:], "=!<>") || strings.ContainsAny(part[:1], "=!<>"):
+			current += " " + part
+		default:
+			ret = append(ret, current)
+			current = part
+		}
+	}
+	if len(current) > 0 {
+		ret = append(ret, current)
+	}
+	return ret
+}
+
+// parseCondition parses a condition such as to=0x... or value>1e18
+func (f *EventFilter) parseCondition(str string) (condition, error) {
+	m := conditionRe.FindStringSubmatch(str)
+	if m == nil {
+		return condition{}, fmt.Errorf("invalid condition %s", str)
+	}
+
+	cond := condition{param: -1, op: m[2]}
+	if cond.op == "==" {
+		cond.op = "="
+	}
+	for i, p := range f.params {
+		if p.name == m[1] || fmt.Sprintf("%d", i) == m[1] {
+			cond.param = i
+			break
+		}
+	}
+	if cond.param == -1 {
+		return condition{}, fmt.Errorf("the event %s has no argument %s", f.Signature, m[1])
+	}
+
+	p := f.params[cond.param]
+	typ := p.arg.Type
+	isNumeric := typ.T == abi.IntTy || typ.T == abi.UintTy
+	if cond.op != "=" && cond.op != "!=" && !isNumeric {
+		return condition{}, fmt.Errorf("the %s operator is not available for arguments of type %s", cond.op, typ.String())
+	}
+
+	raw := strings.Trim(m[3], "'\"")
+	var err error
+	switch {
+	case p.indexed && (typ.T == abi.StringTy || typ.T == abi.BytesTy):
+		var value []byte
+		if typ.T == abi.StringTy {
+			value = []byte(raw)
+		} else if value, err = parseBytes(raw); err != nil {
+			break
+		}
+		cond.value = crypto.Keccak256(value)
+	case isNumeric:
+		var n *big.Int
+		if n, err = parseBigNumber(raw); err == nil {
+			if typ.T == abi.UintTy && n.Sign() < 0 {
+				err = fmt.Errorf("negative value %s for %s", raw, typ.String())
+			}
+			cond.value = n
+		}
+	case typ.T == abi.AddressTy:
+		if !base.IsValidAddress(raw) {
+			err = fmt.Errorf("invalid address %s", raw)
+		}
+		cond.value = base.HexToAddress(raw)
+	case typ.T == abi.BoolTy:
+		if raw != "true" && raw != "false" {
+			err = fmt.Errorf("invalid bool %s", raw)
+		}
+		cond.value = raw == "true"
+	case typ.T == abi.StringTy:
+		cond.value = raw
+	case typ.T == abi.BytesTy || typ.T == abi.FixedBytesTy:
+		var value []byte
+		if value, err = parseBytes(raw); err == nil && typ.T == abi.FixedBytesTy {
+			if len(value) > typ.Size {
+				err = fmt.Errorf("value %s is too long for %s", raw, typ.String())
+			}
+			padded := make([]byte, typ.Size)
+			copy(padded, value)
+			value = padded
+		}
+		cond.value = value
+	default:
+		err = fmt.Errorf("conditions on arguments of type %s are not supported", typ.String())
+	}
+
+	if err != nil {
+		return condition{}, fmt.Errorf("invalid condition %s: %w", str, err)
+	}
+	return cond, nil
+}
+
+// matches returns true if the decoded value meets the condition
+func (c *condition) matches(value any) bool {
+	cmp, ok := 0, false
+	switch want := c.value.(type) {
+	case *big.Int:
+		if n, isNum := toBig(value); isNum {
+			cmp, ok = n.Cmp(want), true
+		}
+	case base.Address:
+		if addr, isAddr := value.(common.Address); isAddr {
+			ok = true
+			if base.HexToAddress(addr.Hex()) != want {
+				cmp = 1
+			}
+		}
+	case bool:
+		if b, isBool := value.(bool); isBool {
+			ok = true
+			if b != want {
+				cmp = 1
+			}
+		}
+	case string:
+		if s, isString := value.(string); isString {
+			ok = true
+			if s != want {
+				cmp = 1
+			}
+		}
+	case []byte:
+		if b, isBytes := toBytes(value); isBytes {
+			ok = true
+			if string(b) != string(want) {
+				cmp = 1
+			}
+		}
+	}
+
+	if !ok {
+		return false
+	}
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// toTopic encodes an equality condition's value as the topic of an indexed argument
+func toTopic(typ abi.Type, value any) base.Hash {
+	switch v := value.(type) {
+	case *big.Int:
+		n := new(big.Int).Set(v)
+		if n.Sign() < 0 {
+			n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		return base.BigToHash(n)
+	case base.Address:
+		return base.BytesToHash(common.LeftPadBytes(v.Bytes(), 32))
+	case bool:
+		if v {
+			return base.BigToHash(big.NewInt(1))
+		}
+		return base.Hash{}
+	case []byte:
+		if typ.T == abi.FixedBytesTy {
+			return base.BytesToHash(common.RightPadBytes(v, 32))
+		}
+		return base.BytesToHash(v)
+	}
+	return base.Hash{}
+}
+
+// isHashedWhenIndexed returns true for types whose indexed values are stored as the hash of the value
+func isHashedWhenIndexed(typ abi.Type) bool {
+	switch typ.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+	return false
+}
+
+// parseBigNumber parses a decimal, hex, or scientific (for example 1e18 or 2.5e6) integer
+func parseBigNumber(str string) (*big.Int, error) {
+	if strings.HasPrefix(str, "0x") {
+		if n, ok := new(big.Int).SetString(str[2:], 16); ok {
+			return n, nil
+		}
+		return nil, fmt.Errorf("invalid number %s", str)
+	}
+	if n, ok := new(big.Int).SetString(str, 10); ok {
+		return n, nil
+	}
+	f, ok := new(big.Float).SetPrec(512).SetString(str)
+	if !ok || !f.IsInt() {
+		return nil, fmt.Errorf("invalid number %s", str)
+	}
+	n, _ := f.Int(nil)
+	return n, nil
+}
+
+func parseBytes(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "0x") || len(str)%2 != 0 || !base.IsHex(str) {
+		return nil, fmt.Errorf("invalid hex value %s", str)
+	}
+	return base.Hex2Bytes(str[2:]), nil
+}
+
+func toBig(value any) (*big.Int, bool) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, true
+	case uint8, uint16, uint32, uint64:
+		return new(big.Int).SetUint64(reflect.ValueOf(v).Uint()), true
+	case int8, int16, int32, int64:
+		return big.NewInt(reflect.ValueOf(v).Int()), true
+	}
+	return nil, false
+}
+
+func toBytes(value any) ([]byte, bool) {
+	if b, ok := value.([]byte); ok {
+		return b, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		ret := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(ret), rv)
+		return ret, true
+	}
+	return nil, false
+}
@@ -0,0 +1,121 @@
+package filter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+func transferLog(from, to string, value *big.Int) types.SimpleLog {
+	fromAddr, toAddr := base.HexToAddress(from), base.HexToAddress(to)
+	return types.SimpleLog{
+		Topics: []base.Hash{
+			base.HexToHash(transferTopic),
+			base.BytesToHash(common.LeftPadBytes(fromAddr.Bytes(), 32)),
+			base.BytesToHash(common.LeftPadBytes(toAddr.Bytes(), 32)),
+		},
+		Data: "0x" + base.Bytes2Hex(common.LeftPadBytes(value.Bytes(), 32)),
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	alice := "0x00000000000000000000000000000000000a11ce"
+	bob := "0x0000000000000000000000000000000000000b0b"
+	carol := "0x00000000000000000000000000000000000ca401"
+	eth := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	small := transferLog(alice, bob, big.NewInt(5))
+	large := transferLog(alice, bob, new(big.Int).Mul(eth, big.NewInt(2)))
+	toCarol := transferLog(bob, carol, new(big.Int).Mul(eth, big.NewInt(3)))
+	approval := small
+	approval.Topics = append([]base.Hash{base.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")}, small.Topics[1:]...)
+
+	tests := []struct {
+		name       string
+		signature  string
+		conditions []string
+		expected   []bool // small, large, toCarol, approval
+	}{
+		{"signature only", "event Transfer(address indexed from, address indexed to, uint value)", nil, []bool{true, true, true, false}},
+		{"unnamed arguments", "Transfer(address indexed,address indexed,uint256)", []string{"1=" + carol}, []bool{false, false, true, false}},
+		{"indexed equality", "Transfer(address indexed from, address indexed to, uint256 value)", []string{"to=" + bob}, []bool{true, true, false, false}},
+		{"indexed alternatives", "Transfer(address indexed from, address indexed to, uint256 value)", []string{"to=" + bob, "to=" + carol}, []bool{true, true, true, false}},
+		{"indexed inequality", "Transfer(address indexed from, address indexed to, uint256 value)", []string{"from!=" + alice}, []bool{false, false, true, false}},
+		{"data comparison", "Transfer(address indexed from, address indexed to, uint256 value)", []string{"value>1e18"}, []bool{false, true, true, false}},
+		{"combined", "Transfer(address indexed from, address indexed to, uint256 value)", []string{"from=" + alice, "value >= 2e18"}, []bool{false, true, false, false}},
+		{"data equality", "Transfer(address indexed from, address indexed to, uint256 value)", []string{"value==5"}, []bool{true, false, false, false}},
+		{"wrong indexing", "Transfer(address from, address to, uint256 value)", nil, []bool{false, false, false, false}},
+	}
+
+	for _, test := range tests {
+		f, err := NewEventFilter(test.signature, test.conditions)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if f.Signature != "Transfer(address,address,uint256)" || f.Topic0 != base.HexToHash(transferTopic) {
+			t.Errorf("%s: wrong signature %s or topic %s", test.name, f.Signature, f.Topic0.Hex())
+		}
+		for i, log := range []types.SimpleLog{small, large, toCarol, approval} {
+			log := log
+			if got := f.Passes(&log); got != test.expected[i] {
+				t.Errorf("%s: log %d: got %t, expected %t", test.name, i, got, test.expected[i])
+			}
+		}
+	}
+
+	f, _ := NewEventFilter("Transfer(address indexed from, address indexed to, uint256 value)", []string{"to=" + bob})
+	topics := f.Topics()
+	if len(topics) != 3 || len(topics[1]) != 0 || len(topics[2]) != 1 || topics[2][0] != small.Topics[2] {
+		t.Errorf("unexpected topics %v", topics)
+	}
+}
+
+func TestEventFilterErrors(t *testing.T) {
+	tests := []struct {
+		signature  string
+		conditions []string
+	}{
+		{"Transfer", nil},
+		{"Transfer(address indexed from", nil},
+		{"Transfer(addr from)", nil},
+		{"Transfer(address indexed a, address indexed b, address indexed c, address indexed d)", nil},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", []string{"amount>1"}},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", []string{"to>0x1"}},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", []string{"to=0x1234"}},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", []string{"value=1.5"}},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", []string{"value=-1"}},
+		{"Transfer(address indexed from, address indexed to, uint256 value)", []string{"value"}},
+	}
+
+	for _, test := range tests {
+		if _, err := NewEventFilter(test.signature, test.conditions); err == nil {
+			t.Errorf("%s %v: expected an error", test.signature, test.conditions)
+		}
+	}
+}
+
+func TestRejoinConditions(t *testing.T) {
+	// as split by the API
+	parts := []string{"value", ">", "1e18", "to=0x1", "from", "!=0x2", "value<=", "5", ""}
+	expected := []string{"value > 1e18", "to=0x1", "from !=0x2", "value<= 5"}
+	got := RejoinConditions(parts)
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], got[i])
+		}
+	}
+
+	f, err := NewEventFilter("Transfer(address indexed from, address indexed to, uint256 value)", RejoinConditions([]string{"value", ">", "1e18"}))
+	if err != nil || len(f.allOf) != 1 {
+		t.Error("expected a single condition", err)
+	}
+}
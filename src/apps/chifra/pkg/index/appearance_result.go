@@ -1,9 +1,6 @@
 package index
 
 import (
-	"encoding/binary"
-	"io"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 )
 
@@ -24,19 +21,12 @@ func (chunk *Index) ReadAppearances(address base.Address) *AppearanceResult {
 		return &ret
 	}
 
-	startOfAddressRecord := int64(HeaderWidth + (foundAt * AddrRecordWidth))
-	_, err := chunk.File.Seek(startOfAddressRecord, io.SeekStart)
+	addressRecord, err := chunk.readAddressRecord(foundAt)
 	if err != nil {
 		ret.Err = err
 		return &ret
 	}
 
-	addressRecord := AddressRecord{}
-	if err := binary.Read(chunk.File, binary.LittleEndian, &addressRecord); err != nil {
-		ret.Err = err
-		return &ret
-	}
-
 	appearances, err := chunk.readAppearanceRecords(&addressRecord)
 	if err != nil {
 		ret.Err = err
@@ -0,0 +1,106 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// batchLoadThreshold is the number of addresses above which FilterMembers reads the entire bloom into
+// memory rather than seeking to individual bytes on disc. For a handful of addresses, a few seeks are
+// cheaper than reading the whole file.
+const batchLoadThreshold = 16
+
+// FilterMembers returns those of the given addresses that may be members of the bloom (that is, the
+// bloom hits, including any false positives) in the order they were given. If the bloom was opened with
+// OpenBloom and there are more than a few addresses, its contents are read from disc exactly once and
+// every address is tested in memory. The bloom remains loaded for subsequent calls.
+func (bl *Bloom) FilterMembers(addrs []base.Address) ([]base.Address, error) {
+	if !bl.isLoaded() && bl.File != nil && len(addrs) > batchLoadThreshold {
+		if _, err := bl.File.Seek(bl.HeaderSize, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if err := bl.readBlooms(bufio.NewReader(bl.File)); err != nil {
+			return nil, err
+		}
+	}
+
+	isMember := bl.IsMember
+	if bl.isLoaded() {
+		isMember = bl.isMemberBytes
+	}
+
+	hits := make([]base.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		if isMember(addr) {
+			hits = append(hits, addr)
+		}
+	}
+	return hits, nil
+}
+
+// isLoaded returns true if the bloom's bytes (or its xor filter's fingerprints) are in memory.
+func (bl *Bloom) isLoaded() bool {
+	if bl.isXor() {
+		return bl.xor != nil && bl.xor.Fingerprints != nil
+	}
+	return bl.Count > 0 && len(bl.Blooms) == int(bl.Count)
+}
+
+// ReadAppearancesBatch searches an already-opened Index for each of the given addresses and returns one
+// AppearanceResult per address sorted by address. Rather than searching the whole address table for
+// each address, the addresses are sorted and each search starts where the previous one ended, so the
+// address table is traversed once per chunk. Addresses not found in the Index carry nil AppRecords.
+func (chunk *Index) ReadAppearancesBatch(addrs []base.Address) []AppearanceResult {
+	sorted := make([]base.Address, len(addrs))
+	copy(sorted, addrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0
+	})
+
+	results := make([]AppearanceResult, 0, len(sorted))
+	nRecords := int(chunk.Header.AddressCount)
+	lo := 0
+	for _, address := range sorted {
+		ret := AppearanceResult{Address: address, Range: chunk.Range}
+
+		var readErr error
+		pos := lo + sort.Search(nRecords-lo, func(i int) bool {
+			rec, err := chunk.readAddressRecord(lo + i)
+			if err != nil {
+				readErr = err
+				return true
+			}
+			return bytes.Compare(rec.Address.Bytes(), address.Bytes()) >= 0
+		})
+		if readErr != nil {
+			ret.Err = readErr
+			results = append(results, ret)
+			continue
+		}
+		lo = pos
+
+		if pos < nRecords {
+			rec, err := chunk.readAddressRecord(pos)
+			if err != nil {
+				ret.Err = err
+			} else if rec.Address == address {
+				if apps, err := chunk.readAppearanceRecords(&rec); err != nil {
+					ret.Err = err
+				} else {
+					ret.AppRecords = &apps
+				}
+			}
+		}
+		results = append(results, ret)
+	}
+
+	return results
+}
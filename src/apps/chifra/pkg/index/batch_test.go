@@ -0,0 +1,144 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+)
+
+// writeTestChunk writes an index and bloom containing the given (sorted) addresses to the directory.
+// Every third address has two appearances. It returns the path to the index file.
+func writeTestChunk(t *testing.T, dir string, addrs []base.Address) string {
+	indexFn := filepath.Join(dir, "000000001-000000002.bin")
+
+	bl := NewBloom(DefaultBloomParams)
+	addrTable := make([]AddressRecord, 0, len(addrs))
+	appTable := make([]AppearanceRecord, 0, 2*len(addrs))
+	for i, addr := range addrs {
+		bl.InsertAddress(addr)
+		count := 1 + uint32(i%3/2)
+		addrTable = append(addrTable, AddressRecord{Address: addr, Offset: uint32(len(appTable)), Count: count})
+		for j := uint32(0); j < count; j++ {
+			appTable = append(appTable, AppearanceRecord{BlockNumber: uint32(i), TransactionId: j})
+		}
+	}
+
+	fp, err := os.Create(indexFn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := indexHeader{
+		Magic:           file.MagicNumber,
+		Hash:            base.BytesToHash(config.HeaderHash(config.ExpectedVersion())),
+		AddressCount:    uint32(len(addrTable)),
+		AppearanceCount: uint32(len(appTable)),
+	}
+	for _, data := range []any{header, addrTable, appTable} {
+		if err = binary.Write(fp, binary.LittleEndian, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fp.Close()
+
+	if _, err = bl.writeBloom(ToBloomPath(indexFn)); err != nil {
+		t.Fatal(err)
+	}
+
+	return indexFn
+}
+
+// testAddresses returns n sorted addresses
+func testAddresses(n int) []base.Address {
+	addrs := make([]base.Address, 0, n)
+	for i := 0; i < n; i++ {
+		addrs = append(addrs, base.HexToAddress(fmt.Sprintf("0x%040x", uint64(i+1)<<40)))
+	}
+	return addrs
+}
+
+func Test_Batch(t *testing.T) {
+	nAddrs := 1000
+	addrs := testAddresses(nAddrs)
+	indexFn := writeTestChunk(t, t.TempDir(), addrs)
+	bloomFn := ToBloomPath(indexFn)
+
+	// Query every tenth address in reverse order plus some that are not in the chunk
+	query := []base.Address{}
+	for i := nAddrs - 1; i >= 0; i -= 10 {
+		query = append(query, addrs[i])
+	}
+	missing := []base.Address{
+		base.HexToAddress("0x0000000000000000000000000000000000000001"),
+		base.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff"),
+	}
+	query = append(query, missing...)
+
+	for _, small := range []bool{true, false} {
+		opened, err := OpenBloom(bloomFn, false /* check */)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q := query
+		if small {
+			q = query[:batchLoadThreshold]
+		}
+		hits, err := opened.FilterMembers(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if opened.isLoaded() == small {
+			t.Error("bloom loaded when it should not have been (or vice versa), small:", small)
+		}
+		opened.Close()
+
+		nExpected := len(q)
+		if !small {
+			nExpected -= len(missing)
+		}
+		if len(hits) < nExpected {
+			t.Error("expected at least", nExpected, "bloom hits, got", len(hits))
+		}
+	}
+
+	chunk, err := OpenIndex(indexFn, false /* check */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chunk.Close()
+
+	results := chunk.ReadAppearancesBatch(query)
+	if len(results) != len(query) {
+		t.Fatal("expected", len(query), "results, got", len(results))
+	}
+	for i, res := range results {
+		if i > 0 && res.Address.Hex() < results[i-1].Address.Hex() {
+			t.Error("results are not sorted")
+		}
+		if res.Err != nil {
+			t.Error(res.Err)
+		}
+		single := chunk.ReadAppearances(res.Address)
+		if (single.AppRecords == nil) != (res.AppRecords == nil) {
+			t.Error("batch and single search disagree for", res.Address.Hex())
+		} else if single.AppRecords != nil && fmt.Sprint(*single.AppRecords) != fmt.Sprint(*res.AppRecords) {
+			t.Error("batch and single search disagree for", res.Address.Hex(), *single.AppRecords, *res.AppRecords)
+		}
+	}
+	for _, res := range results {
+		for _, addr := range missing {
+			if res.Address == addr && res.AppRecords != nil {
+				t.Error("address should not be found", addr.Hex())
+			}
+		}
+	}
+}
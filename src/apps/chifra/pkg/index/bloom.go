@@ -40,14 +40,67 @@ type bloomHeader struct {
 // Bloom structures contain an array of bloomBytes each BLOOM_WIDTH_IN_BYTES wide. A new bloomBytes is added to
 // the Bloom when around MAX_ADDRS_IN_BLOOM addresses has been added. These Adaptive Bloom Filters allow us to
 // maintain a near-constant false-positive rate at the expense of slightly larger bloom filters than might be expected.
+//
+// If Params differs from DefaultBloomParams, the width, hash count and maximum addresses per bloomBytes are
+// taken from Params (and recorded on disc following the header). If Params.Format is BloomXor8, the Bloom
+// carries a single xor filter instead of the array of bloomBytes. A zero Params means DefaultBloomParams.
 type Bloom struct {
 	File       *os.File
 	SizeOnDisc int64
 	Range      base.FileRange
 	HeaderSize int64
 	Header     bloomHeader
+	Params     BloomParams
 	Count      uint32 // Do not change the size of this field, it's stored on disc
 	Blooms     []bloomBytes
+	xor        *xorFilter
+	xorKeys    []uint64
+	data       []byte // if non-nil, the contents of the file (in memory or memory-mapped) into which Blooms point
+	mapped     bool
+}
+
+// NewBloom returns an empty Bloom that will be built (and written) with the given parameters.
+func NewBloom(params BloomParams) Bloom {
+	return Bloom{Params: params}
+}
+
+// params returns the parameters of the bloom, treating the zero value as the default.
+func (bl *Bloom) params() BloomParams {
+	if bl.Params == (BloomParams{}) {
+		return DefaultBloomParams
+	}
+	return bl.Params
+}
+
+// isXor returns true if the bloom carries an xor filter rather than an adaptive Bloom filter.
+func (bl *Bloom) isXor() bool {
+	return bl.params().Format == BloomXor8
+}
+
+// NInserted returns the number of addresses inserted into the bloom.
+func (bl *Bloom) NInserted() uint64 {
+	if bl.isXor() {
+		if bl.xor != nil {
+			return uint64(bl.xor.NInserted)
+		}
+		return uint64(len(uniqueKeys(bl.xorKeys)))
+	}
+	nInserted := uint64(0)
+	for _, bb := range bl.Blooms {
+		nInserted += uint64(bb.NInserted)
+	}
+	return nInserted
+}
+
+// WidthInBytes returns the number of bytes in each of the bloom's filters.
+func (bl *Bloom) WidthInBytes() uint64 {
+	if bl.isXor() {
+		if bl.xor != nil {
+			return uint64(3 * bl.xor.BlockLength)
+		}
+		return 0
+	}
+	return uint64(bl.params().WidthInBytes())
 }
 
 // OpenBloom returns a newly initialized bloom filter. The bloom filter's file pointer is open (if there
@@ -80,26 +133,51 @@ func OpenBloom(path string, check bool) (Bloom, error) {
 		return bl, err
 	}
 
+	if bl.isXor() {
+		bl.xor = &xorFilter{}
+		if err = bl.xor.readHeader(bl.File); err != nil {
+			return bl, err
+		}
+	}
+
 	bl.Blooms = make([]bloomBytes, 0, bl.Count)
 	_, _ = bl.File.Seek(int64(bl.HeaderSize), io.SeekStart) // Point to the start of Count
 	return bl, nil
 }
 
-// Close closes the file if it's opened
+// Close closes the file if it's opened and releases its memory if it's mapped
 func (bl *Bloom) Close() {
 	if bl.File != nil {
 		bl.File.Close()
 		bl.File = nil
 	}
+	if bl.data != nil {
+		if bl.mapped {
+			_ = unmapFile(bl.data)
+		}
+		bl.data = nil
+		bl.Blooms = nil
+		bl.xor = nil
+	}
 }
 
-// InsertAddress adds an address to the bloom filter.
+// InsertAddress adds an address to the bloom filter. For xor filters, the address is only collected. The
+// filter itself is built when the bloom is written.
 func (bl *Bloom) InsertAddress(addr base.Address) {
+	if bl.isXor() {
+		bl.xorKeys = append(bl.xorKeys, addressToKey(addr))
+		bl.xor = nil
+		bl.Count = 1
+		return
+	}
+
+	params := bl.params()
+	widthInBytes := params.WidthInBytes()
 
 	// Check and initialize if empty.
 	if len(bl.Blooms) == 0 {
 		bl.Blooms = append(bl.Blooms, bloomBytes{})
-		bl.Blooms[bl.Count].Bytes = make([]byte, BLOOM_WIDTH_IN_BYTES)
+		bl.Blooms[bl.Count].Bytes = make([]byte, widthInBytes)
 		bl.Count++
 	}
 
@@ -111,24 +189,26 @@ func (bl *Bloom) InsertAddress(addr base.Address) {
 	for _, bit := range bits {
 		which := (bit / 8)
 		whence := (bit % 8)
-		index := BLOOM_WIDTH_IN_BYTES - which - 1
+		index := widthInBytes - which - 1
 		mask := uint8(1 << whence)
 		bl.Blooms[loc].Bytes[index] |= mask
 	}
 
 	// Update insert count and check for overflow.
 	bl.Blooms[loc].NInserted++
-	if bl.Blooms[loc].NInserted > MAX_ADDRS_IN_BLOOM {
+	if bl.Blooms[loc].NInserted > params.MaxAddrs {
 		bl.Blooms = append(bl.Blooms, bloomBytes{})
-		bl.Blooms[bl.Count].Bytes = make([]byte, BLOOM_WIDTH_IN_BYTES)
+		bl.Blooms[bl.Count].Bytes = make([]byte, widthInBytes)
 		bl.Count++
 	}
 }
 
-// addressToBits extracts five bits from a 20-byte address to determine its presence in the bloom filter.
+// addressToBits extracts the bits from a 20-byte address that determine its presence in the bloom filter.
 // It divides the address into five 4-byte segments, converts each to a 32-bit integer, and then takes the modulo
-// with the bloom array item width.
-func (bl *Bloom) addressToBits(addr base.Address) (bits [5]uint32) {
+// with the bloom array item width. With the default parameters, this yields five bits. If the bloom's parameters
+// call for more than five bits, the remaining bits are derived from the first two segments by double hashing.
+func (bl *Bloom) addressToBits(addr base.Address) []uint32 {
+	params := bl.params()
 
 	// Convert address to byte slice.
 	slice := addr.Bytes()
@@ -138,20 +218,34 @@ func (bl *Bloom) addressToBits(addr base.Address) (bits [5]uint32) {
 		logger.Fatal("should not happen ==> invalid address length.")
 	}
 
-	// Split address into five segments and calculate corresponding bits.
+	// Split address into five segments.
+	var segments [5]uint32
 	for i, cnt := 0, 0; i < len(slice); i += 4 {
-		bytes := slice[i : i+4]
-		bits[cnt] = (binary.BigEndian.Uint32(bytes) % uint32(BLOOM_WIDTH_IN_BITS))
+		segments[cnt] = binary.BigEndian.Uint32(slice[i : i+4])
 		cnt++
 	}
 
-	return
+	// Calculate the corresponding bits.
+	bits := make([]uint32, params.NHashes)
+	for i := range bits {
+		if i < len(segments) {
+			bits[i] = segments[i] % params.WidthInBits
+		} else {
+			bits[i] = uint32((uint64(segments[0]) + uint64(i)*uint64(segments[1])) % uint64(params.WidthInBits))
+		}
+	}
+
+	return bits
 }
 
 func (bl *Bloom) getStats() (nBlooms uint64, nInserted uint64, nBitsLit uint64, nBitsNotLit uint64, sz uint64, bitsLit []uint64) {
 	bitsLit = []uint64{}
 	sz += 4
 	nBlooms = uint64(bl.Count)
+	if bl.isXor() {
+		nInserted = bl.NInserted()
+		return
+	}
 	for _, bf := range bl.Blooms {
 		nInserted += uint64(bf.NInserted)
 		sz += 4 + uint64(len(bf.Bytes))
@@ -9,6 +9,10 @@ import (
 )
 
 func (bl *Bloom) isMemberBytes(addr base.Address) bool {
+	if bl.isXor() {
+		return bl.xor != nil && bl.xor.contains(addressToKey(addr))
+	}
+
 	whichBits := bl.addressToBits(addr)
 	for _, bb := range bl.Blooms {
 		var tester = bitChecker{bytes: bb.Bytes, whichBits: whichBits}
@@ -20,6 +24,11 @@ func (bl *Bloom) isMemberBytes(addr base.Address) bool {
 }
 
 func (bl *Bloom) IsMember(addr base.Address) bool {
+	if bl.isXor() {
+		offset := bl.HeaderSize + 4 + xorFilterHeaderWidth // the end of Count and the xor filter's header
+		return bl.xor != nil && bl.xor.containsAt(bl.File, offset, addressToKey(addr))
+	}
+
 	whichBits := bl.addressToBits(addr)
 	offset := uint32(bl.HeaderSize) + 4 // the end of Count
 	for j := 0; j < int(bl.Count); j++ {
@@ -28,7 +37,7 @@ func (bl *Bloom) IsMember(addr base.Address) bool {
 		if bl.isMember(&tester) {
 			return true
 		}
-		offset += BLOOM_WIDTH_IN_BYTES
+		offset += bl.params().WidthInBytes()
 	}
 	return false
 }
@@ -44,7 +53,7 @@ func (bl *Bloom) isMember(tester *bitChecker) bool {
 }
 
 type bitChecker struct {
-	whichBits [5]uint32
+	whichBits []uint32
 	offset    uint32
 	bit       uint32
 	bytes     []byte
@@ -53,7 +62,7 @@ type bitChecker struct {
 // isBitLit returns true if the given bit is lit in the given byte array
 func (bl *Bloom) isBitLit(tester *bitChecker) bool {
 	which := uint32(tester.bit / 8)
-	index := uint32(BLOOM_WIDTH_IN_BYTES - which - 1)
+	index := uint32(bl.params().WidthInBytes() - which - 1)
 
 	whence := uint32(tester.bit % 8)
 	mask := byte(1 << whence)
@@ -84,3 +93,27 @@ func (bl *Bloom) isBitLit(tester *bitChecker) bool {
 	// fmt.Fprintf(os.Stdout, "%d-%d-%d: % 9d\t% 9d\t% 9d\t% 9d\t% 9d\t% 9d\t%t\n", i, j, k, which, index, whence, mask, byt, res, (res != 0))
 	return (res != 0)
 }
+
+// MeasureFalsePositiveRate tests nTests pseudo-random addresses against a bloom that has been read into
+// memory and returns the fraction that are reported as members. Since the chance that a random address
+// appears in the index is vanishingly small, this is a measure of the bloom's false positive rate. The
+// addresses are generated deterministically, so repeated measurements of the same bloom agree.
+func (bl *Bloom) MeasureFalsePositiveRate(nTests int) float64 {
+	if nTests == 0 {
+		return 0
+	}
+
+	seed := uint64(bl.Range.First)
+	nHits := 0
+	for i := 0; i < nTests; i++ {
+		var bytes [24]byte
+		binary.BigEndian.PutUint64(bytes[0:], splitmix64(&seed))
+		binary.BigEndian.PutUint64(bytes[8:], splitmix64(&seed))
+		binary.BigEndian.PutUint64(bytes[16:], splitmix64(&seed))
+		if bl.isMemberBytes(base.BytesToAddress(bytes[:20])) {
+			nHits++
+		}
+	}
+
+	return float64(nHits) / float64(nTests)
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+// BloomFormat identifies the type of set membership filter stored in a bloom file
+type BloomFormat uint16
+
+const (
+	// BloomAdaptive is the original adaptive Bloom filter (an array of fixed-width bit arrays)
+	BloomAdaptive BloomFormat = iota
+	// BloomXor8 is an xor filter with eight-bit fingerprints built once from all addresses in the chunk
+	BloomXor8
+)
+
+// String returns the name of the filter format as used in the config file
+func (f BloomFormat) String() string {
+	switch f {
+	case BloomAdaptive:
+		return "bloom"
+	case BloomXor8:
+		return "xor8"
+	default:
+		return fmt.Sprintf("unknown(%d)", f)
+	}
+}
+
+// BloomParams carries the parameters of a bloom file. Unless they equal DefaultBloomParams, they are
+// written to disc immediately following the bloom file's header so readers may recover them. Do not
+// change the size or order of these fields, they are stored on disc.
+type BloomParams struct {
+	Format      BloomFormat
+	NHashes     uint16
+	WidthInBits uint32
+	MaxAddrs    uint32
+}
+
+// DefaultBloomParams are the parameters of every bloom file written before the parameters were recorded
+// in the header. Files with these parameters are written in the original (unversioned) format.
+var DefaultBloomParams = BloomParams{
+	Format:      BloomAdaptive,
+	NHashes:     5,
+	WidthInBits: BLOOM_WIDTH_IN_BITS,
+	MaxAddrs:    MAX_ADDRS_IN_BLOOM,
+}
+
+const bloomParamsWidth = int64(unsafe.Sizeof(BloomParams{}))
+
+// IsDefault returns true if the parameters are the original, unversioned parameters.
+func (p BloomParams) IsDefault() bool {
+	return p == DefaultBloomParams
+}
+
+// WidthInBytes returns the width of each of the bit arrays in an adaptive Bloom filter.
+func (p BloomParams) WidthInBytes() uint32 {
+	return p.WidthInBits / 8
+}
+
+// Validate returns an error if the parameters cannot be used to build a filter.
+func (p BloomParams) Validate() error {
+	switch p.Format {
+	case BloomAdaptive:
+		if p.WidthInBits == 0 || p.WidthInBits%8 != 0 {
+			return fmt.Errorf("bloom width (%d) must be a non-zero multiple of eight", p.WidthInBits)
+		}
+		if p.NHashes == 0 || p.NHashes > 32 {
+			return fmt.Errorf("bloom hash count (%d) must be between one and 32", p.NHashes)
+		}
+		if p.MaxAddrs == 0 {
+			return fmt.Errorf("bloom max addresses must be non-zero")
+		}
+	case BloomXor8:
+		// nothing to check
+	default:
+		return fmt.Errorf("unknown bloom format %d", p.Format)
+	}
+	return nil
+}
+
+// GetBloomParams returns the parameters the chunk writer uses for new bloom files as configured
+// in the chain's scrape settings. Settings left empty take their default values.
+func GetBloomParams(chain string) (BloomParams, error) {
+	settings := config.GetScrape(chain)
+
+	params := DefaultBloomParams
+	switch settings.BloomFormat {
+	case "", "bloom":
+		if settings.BloomWidth != 0 {
+			params.WidthInBits = uint32(settings.BloomWidth)
+		}
+		if settings.BloomHashes != 0 {
+			params.NHashes = uint16(settings.BloomHashes)
+		}
+		if settings.BloomMaxAddrs != 0 {
+			params.MaxAddrs = uint32(settings.BloomMaxAddrs)
+		}
+	case "xor8":
+		params = BloomParams{Format: BloomXor8, NHashes: 3}
+	default:
+		return params, fmt.Errorf("unknown bloomFormat (%s) in scrape settings for chain %s", settings.BloomFormat, chain)
+	}
+
+	return params, params.Validate()
+}
@@ -1,6 +1,7 @@
 package index
 
 import (
+	"bufio"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -33,18 +34,31 @@ func (bl *Bloom) Read(fileName string) (err error) {
 		return err
 	}
 
-	if err = binary.Read(bl.File, binary.LittleEndian, &bl.Count); err != nil {
+	return bl.readBlooms(bufio.NewReader(bl.File))
+}
+
+// readBlooms reads Count and the bloom bytes (or the xor filter) from the reader, which must be
+// positioned at the end of the header.
+func (bl *Bloom) readBlooms(r io.Reader) (err error) {
+	if err = binary.Read(r, binary.LittleEndian, &bl.Count); err != nil {
 		return err
 	}
 
+	if bl.isXor() {
+		bl.Blooms = nil
+		bl.xor = &xorFilter{}
+		return bl.xor.read(r)
+	}
+
+	widthInBytes := bl.params().WidthInBytes()
 	bl.Blooms = make([]bloomBytes, bl.Count)
 	for i := uint32(0); i < bl.Count; i++ {
-		if err = binary.Read(bl.File, binary.LittleEndian, &bl.Blooms[i].NInserted); err != nil {
+		if err = binary.Read(r, binary.LittleEndian, &bl.Blooms[i].NInserted); err != nil {
 			return err
 		}
 
-		bl.Blooms[i].Bytes = make([]byte, BLOOM_WIDTH_IN_BYTES)
-		if err = binary.Read(bl.File, binary.LittleEndian, &bl.Blooms[i].Bytes); err != nil {
+		bl.Blooms[i].Bytes = make([]byte, widthInBytes)
+		if _, err = io.ReadFull(r, bl.Blooms[i].Bytes); err != nil {
 			return err
 		}
 	}
@@ -54,28 +68,45 @@ func (bl *Bloom) Read(fileName string) (err error) {
 
 // readHeader reads a bloom file header into Bloom.
 func (bl *Bloom) readHeader(check bool) error {
+	return bl.readHeaderFrom(bl.File, check)
+}
+
+// readHeaderFrom reads a bloom file header into Bloom from the given reader.
+func (bl *Bloom) readHeaderFrom(r io.ReadSeeker, check bool) error {
 
 	// Set HeaderSize to 0.
 	bl.HeaderSize = 0
 
 	// Read header from file.
-	err := binary.Read(bl.File, binary.LittleEndian, &bl.Header)
+	err := binary.Read(r, binary.LittleEndian, &bl.Header)
 	if err != nil {
 		bl.Header = bloomHeader{}
-		_, _ = bl.File.Seek(0, io.SeekStart)
+		_, _ = r.Seek(0, io.SeekStart)
 		return err
 	}
 
 	// Check for unversioned bloom filter.
-	if bl.Header.Magic != file.SmallMagicNumber {
+	if bl.Header.Magic != file.SmallMagicNumber && bl.Header.Magic != file.ParamsMagicNumber {
 		bl.Header = bloomHeader{}
-		_, _ = bl.File.Seek(0, io.SeekStart)
+		_, _ = r.Seek(0, io.SeekStart)
 		return fmt.Errorf("Bloom.readHeader: %w %x %x", ErrIncorrectMagic, bl.Header.Magic, file.SmallMagicNumber)
 	}
 
 	// Set HeaderSize.
 	bl.HeaderSize = int64(unsafe.Sizeof(bl.Header))
 
+	// Read the filter's parameters if they are recorded, otherwise they are the defaults.
+	bl.Params = DefaultBloomParams
+	if bl.Header.Magic == file.ParamsMagicNumber {
+		if err = binary.Read(r, binary.LittleEndian, &bl.Params); err != nil {
+			return err
+		}
+		if err = bl.Params.Validate(); err != nil {
+			return fmt.Errorf("Bloom.readHeader: %w", err)
+		}
+		bl.HeaderSize += bloomParamsWidth
+	}
+
 	// Validate hash against provided tag.
 	if check {
 		if bl.Header.Hash != base.BytesToHash(config.HeaderHash(config.ExpectedVersion())) {
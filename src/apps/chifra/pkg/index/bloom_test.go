@@ -6,6 +6,7 @@ package index
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -131,3 +132,72 @@ func Test_Bloom(t *testing.T) {
 		fmt.Println(hexutil.Encode(tt.Addr.Bytes()), bloom.isMemberBytes(tt.Addr))
 	}
 }
+
+func Test_BloomParams(t *testing.T) {
+	addrs := make([]base.Address, 0, 2000)
+	for i := 0; i < 2000; i++ {
+		addrs = append(addrs, base.HexToAddress(fmt.Sprintf("0x%040x", uint64(i)*0x9e3779b97f4a7c15)))
+	}
+
+	tests := []BloomParams{
+		DefaultBloomParams,
+		{Format: BloomAdaptive, NHashes: 7, WidthInBits: 65536, MaxAddrs: 500},
+		{Format: BloomXor8, NHashes: 3},
+	}
+
+	for _, params := range tests {
+		bloom := NewBloom(params)
+		for _, addr := range addrs {
+			bloom.InsertAddress(addr)
+		}
+
+		fileName := filepath.Join(t.TempDir(), "000000001-000000002.bloom")
+		if _, err := bloom.writeBloom(fileName); err != nil {
+			t.Fatal(params.Format, err)
+		}
+
+		var fromDisc Bloom
+		if err := fromDisc.Read(fileName); err != nil {
+			t.Fatal(params.Format, err)
+		}
+		if fromDisc.params() != params {
+			t.Error("params not recovered from disc -- expected:", params, "got:", fromDisc.params())
+		}
+		if fromDisc.NInserted() != uint64(len(addrs)) {
+			t.Error(params.Format, "expected", len(addrs), "inserted addresses, got", fromDisc.NInserted())
+		}
+
+		opened, err := OpenBloom(fileName, true /* check */)
+		if err != nil {
+			t.Fatal(params.Format, err)
+		}
+		for _, addr := range addrs {
+			if !fromDisc.isMemberBytes(addr) || !opened.IsMember(addr) {
+				t.Error(params.Format, "address should be member, but isn't", addr.Hex())
+				break
+			}
+		}
+		opened.Close()
+
+		if rate := fromDisc.MeasureFalsePositiveRate(10000); rate > 0.01 {
+			t.Error(params.Format, "false positive rate is too high", rate)
+		}
+	}
+}
+
+func Test_BloomParamsValidate(t *testing.T) {
+	if err := DefaultBloomParams.Validate(); err != nil {
+		t.Error("default params should be valid", err)
+	}
+	bad := []BloomParams{
+		{Format: BloomAdaptive, NHashes: 5, WidthInBits: 1001, MaxAddrs: 10},
+		{Format: BloomAdaptive, NHashes: 0, WidthInBits: 1024, MaxAddrs: 10},
+		{Format: BloomAdaptive, NHashes: 5, WidthInBits: 1024, MaxAddrs: 0},
+		{Format: BloomFormat(7)},
+	}
+	for _, params := range bad {
+		if err := params.Validate(); err == nil {
+			t.Error("expected params to be invalid", params)
+		}
+	}
+}
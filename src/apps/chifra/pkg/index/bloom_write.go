@@ -14,57 +14,73 @@ import (
 // because the caller is responsible for that. This is because the caller may be writing the
 // entire chunk (both Bloom and Index) and we want either both to succeed or both to fail.
 func (bl *Bloom) writeBloom(fileName string) ( /* changed */ bool, error) {
-	var err error
-	if bl.File, err = os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0644); err == nil {
+	err := replaceFile(fileName, func(fp *os.File) error {
+		bl.File = fp
 		defer func() {
-			bl.File.Close()
 			bl.File = nil
 		}()
 
-		_, _ = bl.File.Seek(0, io.SeekStart) // already true, but can't hurt
+		params := bl.params()
 		bl.Header.Magic = file.SmallMagicNumber
+		if !params.IsDefault() {
+			bl.Header.Magic = file.ParamsMagicNumber
+		}
 		bl.Header.Hash = base.BytesToHash(config.HeaderHash(config.ExpectedVersion()))
 
-		if err = binary.Write(bl.File, binary.LittleEndian, bl.Header); err != nil {
-			return false, err
+		if err := binary.Write(bl.File, binary.LittleEndian, bl.Header); err != nil {
+			return err
+		}
+
+		if !params.IsDefault() {
+			if err := binary.Write(bl.File, binary.LittleEndian, params); err != nil {
+				return err
+			}
+		}
+
+		if bl.isXor() {
+			if bl.xor == nil {
+				var err error
+				if bl.xor, err = newXorFilter(bl.xorKeys); err != nil {
+					return err
+				}
+			}
+			bl.Count = 1
+			if err := binary.Write(bl.File, binary.LittleEndian, bl.Count); err != nil {
+				return err
+			}
+			return bl.xor.write(bl.File)
 		}
 
-		if err = binary.Write(bl.File, binary.LittleEndian, bl.Count); err != nil {
-			return false, err
+		if err := binary.Write(bl.File, binary.LittleEndian, bl.Count); err != nil {
+			return err
 		}
 
 		for _, bb := range bl.Blooms {
-			if err = binary.Write(bl.File, binary.LittleEndian, bb.NInserted); err != nil {
-				return false, err
+			if err := binary.Write(bl.File, binary.LittleEndian, bb.NInserted); err != nil {
+				return err
 			}
-			if err = binary.Write(bl.File, binary.LittleEndian, bb.Bytes); err != nil {
-				return false, err
+			if err := binary.Write(bl.File, binary.LittleEndian, bb.Bytes); err != nil {
+				return err
 			}
 		}
 
-		return true, nil
-	}
+		return nil
+	})
 
-	return false, nil
+	return err == nil, err
 }
 
 // updateTag writes a the header back to the bloom file
 func (bl *Bloom) updateTag(tag, fileName string) error {
-	var err error
-	if bl.File, err = os.OpenFile(fileName, os.O_RDWR, 0644); err != nil {
-		return err
-
-	} else {
-		defer func() {
-			_ = bl.File.Sync()
-			_ = bl.File.Close()
-			bl.File = nil
-		}()
-
-		bl.Header.Magic = file.SmallMagicNumber
+	return rewriteFile(fileName, func(fp *os.File) error {
+		if bl.Header.Magic != file.ParamsMagicNumber {
+			bl.Header.Magic = file.SmallMagicNumber
+		}
 		bl.Header.Hash = base.BytesToHash(config.HeaderHash(tag))
 
-		_, _ = bl.File.Seek(0, io.SeekStart) // already true, but can't hurt
-		return binary.Write(bl.File, binary.LittleEndian, bl.Header)
-	}
+		if _, err := fp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		return binary.Write(fp, binary.LittleEndian, bl.Header)
+	})
 }
@@ -0,0 +1,244 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"container/list"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// DefaultChunkCacheSize is the number of chunks kept open by a ChunkCache if no size is given
+const DefaultChunkCacheSize = 64
+
+// ChunkCache is a least-recently-used cache of open chunks (each a Bloom and its Index) shared by
+// many goroutines. It is intended for long running processes (such as the API server) that search
+// the same chunks over and over again and would otherwise spend most of their time opening files
+// and seeking.
+type ChunkCache struct {
+	mode    ReaderMode
+	maxSize int
+	mutex   sync.Mutex
+	lru     *list.List // of *ChunkHandle, most recently used at the front
+	entries map[string]*list.Element
+}
+
+// ChunkHandle is a shared, reference counted chunk held in a ChunkCache. The Bloom is read when the
+// handle is acquired. The Index is opened the first time it is asked for (if the bloom hits). Callers
+// must Release the handle when they are finished with it and must not Close the Bloom or the Index.
+type ChunkHandle struct {
+	Range     base.FileRange
+	Bloom     *Bloom
+	bloomPath string
+	stamp     chunkStamp // guarded by the cache's mutex
+	cache     *ChunkCache
+	loadMutex sync.Mutex // guards Bloom, index and loaded
+	index     *Index
+	loaded    bool
+	refs      int // guarded by the cache's mutex
+	evicted   bool
+}
+
+// NewChunkCache returns a ChunkCache that opens chunks using the given ReaderMode and keeps at most
+// maxSize of them open.
+func NewChunkCache(mode ReaderMode, maxSize int) *ChunkCache {
+	if maxSize <= 0 {
+		maxSize = DefaultChunkCacheSize
+	}
+	return &ChunkCache{
+		mode:    mode,
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+var sharedChunkCache *ChunkCache
+var sharedChunkCacheMutex sync.Mutex
+
+// EnableChunkCache creates the ChunkCache returned by SharedChunkCache. Until it is called, there
+// is no shared cache and callers open chunks themselves.
+func EnableChunkCache(mode ReaderMode, maxSize int) *ChunkCache {
+	sharedChunkCacheMutex.Lock()
+	defer sharedChunkCacheMutex.Unlock()
+	if sharedChunkCache != nil {
+		sharedChunkCache.Purge()
+	}
+	sharedChunkCache = NewChunkCache(mode, maxSize)
+	return sharedChunkCache
+}
+
+// SharedChunkCache returns the process-wide ChunkCache or nil if it has not been enabled.
+func SharedChunkCache() *ChunkCache {
+	sharedChunkCacheMutex.Lock()
+	defer sharedChunkCacheMutex.Unlock()
+	return sharedChunkCache
+}
+
+// chunkStamp identifies the versions on disc of a chunk's bloom filter and index
+type chunkStamp struct {
+	bloomTime time.Time
+	indexTime time.Time
+	indexSize int64 // -1 if there is no index
+}
+
+// stampChunk returns the stamp of the chunk whose bloom filter is at bloomPath. The chunk's index
+// need not exist (it may not have been downloaded).
+func stampChunk(bloomPath string) (chunkStamp, error) {
+	info, err := os.Stat(bloomPath)
+	if err != nil {
+		return chunkStamp{}, err
+	}
+	stamp := chunkStamp{bloomTime: info.ModTime(), indexSize: -1}
+	if info, err = os.Stat(ToIndexPath(bloomPath)); err == nil {
+		stamp.indexTime = info.ModTime()
+		stamp.indexSize = info.Size()
+	}
+	return stamp, nil
+}
+
+func (s chunkStamp) equal(other chunkStamp) bool {
+	return s.bloomTime.Equal(other.bloomTime) && s.indexTime.Equal(other.indexTime) && s.indexSize == other.indexSize
+}
+
+// Acquire returns a handle to the chunk whose bloom filter is at bloomPath, opening it if it is not
+// already in the cache. If the bloom filter or the index has changed on disc since the chunk was
+// opened, it is re-opened.
+func (c *ChunkCache) Acquire(bloomPath string) (*ChunkHandle, error) {
+	bloomPath = ToBloomPath(bloomPath)
+	stamp, err := stampChunk(bloomPath)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	var handle *ChunkHandle
+	if elem, ok := c.entries[bloomPath]; ok {
+		handle = elem.Value.(*ChunkHandle)
+		if !handle.stamp.equal(stamp) {
+			c.evict(elem)
+			handle = nil
+		} else {
+			c.lru.MoveToFront(elem)
+		}
+	}
+	if handle == nil {
+		handle = &ChunkHandle{bloomPath: bloomPath, stamp: stamp, cache: c}
+		c.entries[bloomPath] = c.lru.PushFront(handle)
+		for c.lru.Len() > c.maxSize {
+			c.evict(c.lru.Back())
+		}
+	}
+	handle.refs++
+	c.mutex.Unlock()
+
+	handle.loadMutex.Lock()
+	defer handle.loadMutex.Unlock()
+	if !handle.loaded {
+		bl, err := OpenBloomWith(bloomPath, true /* check */, c.mode)
+		if err != nil {
+			c.mutex.Lock()
+			if elem, ok := c.entries[bloomPath]; ok && elem.Value.(*ChunkHandle) == handle {
+				c.lru.Remove(elem)
+				delete(c.entries, bloomPath)
+			}
+			handle.evicted = true
+			handle.refs--
+			c.mutex.Unlock()
+			return nil, err
+		}
+		handle.Range = bl.Range
+		handle.Bloom = &bl
+		handle.loaded = true
+	}
+
+	return handle, nil
+}
+
+// Invalidate removes the chunk whose bloom filter is at bloomPath from the cache. It is closed
+// once every outstanding handle to it has been released.
+func (c *ChunkCache) Invalidate(bloomPath string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if elem, ok := c.entries[ToBloomPath(bloomPath)]; ok {
+		c.evict(elem)
+	}
+}
+
+// Purge removes every chunk from the cache.
+func (c *ChunkCache) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+}
+
+// MaxSize returns the maximum number of chunks kept in the cache.
+func (c *ChunkCache) MaxSize() int {
+	return c.maxSize
+}
+
+// Len returns the number of chunks in the cache.
+func (c *ChunkCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
+
+// evict removes the element from the cache and closes it if it is not in use. The cache must be locked.
+func (c *ChunkCache) evict(elem *list.Element) {
+	handle := elem.Value.(*ChunkHandle)
+	c.lru.Remove(elem)
+	delete(c.entries, handle.bloomPath)
+
+	handle.evicted = true
+	if handle.refs == 0 {
+		handle.close()
+	}
+}
+
+// Index returns the chunk's Index, opening it on first use. The Index may be searched from multiple
+// goroutines, but only with methods that do not depend on the file pointer (such as ReadAppearances
+// and ReadAppearancesBatch).
+func (h *ChunkHandle) Index() (*Index, error) {
+	h.loadMutex.Lock()
+	defer h.loadMutex.Unlock()
+	if h.index == nil {
+		indexChunk, err := OpenIndexWith(ToIndexPath(h.bloomPath), true /* check */, h.cache.mode)
+		if err != nil {
+			return nil, err
+		}
+		h.index = &indexChunk
+	}
+	return h.index, nil
+}
+
+// Release returns the handle to the cache. If the chunk has been evicted and this was the last
+// outstanding handle, the chunk's files are closed.
+func (h *ChunkHandle) Release() {
+	h.cache.mutex.Lock()
+	defer h.cache.mutex.Unlock()
+	h.refs--
+	if h.refs == 0 && h.evicted {
+		h.close()
+	}
+}
+
+// close closes the Bloom and the Index. The cache must be locked and the handle must not be in use.
+func (h *ChunkHandle) close() {
+	if h.Bloom != nil {
+		h.Bloom.Close()
+		h.Bloom = nil
+	}
+	if h.index != nil {
+		h.index.Close()
+		h.index = nil
+	}
+	h.loaded = false
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_ReaderModes(t *testing.T) {
+	addrs := testAddresses(500)
+	indexFn := writeTestChunk(t, t.TempDir(), addrs)
+
+	for _, mode := range []ReaderMode{ReaderFile, ReaderMemory, ReaderMmap} {
+		bl, err := OpenBloomWith(ToBloomPath(indexFn), true /* check */, mode)
+		if err != nil {
+			t.Fatal(mode, err)
+		}
+		if bl.File != nil || !bl.isLoaded() {
+			t.Error(mode, "bloom should be in memory")
+		}
+		if bl.NInserted() != uint64(len(addrs)) {
+			t.Error(mode, "expected", len(addrs), "inserted addresses, got", bl.NInserted())
+		}
+
+		chunk, err := OpenIndexWith(indexFn, true /* check */, mode)
+		if err != nil {
+			t.Fatal(mode, err)
+		}
+		if int(chunk.Header.AddressCount) != len(addrs) {
+			t.Error(mode, "expected", len(addrs), "addresses, got", chunk.Header.AddressCount)
+		}
+
+		for i, addr := range addrs {
+			if !bl.isMemberBytes(addr) {
+				t.Error(mode, "address should be member, but isn't", addr.Hex())
+				break
+			}
+			res := chunk.ReadAppearances(addr)
+			if res.Err != nil || res.AppRecords == nil || len(*res.AppRecords) != 1+i%3/2 {
+				t.Error(mode, "wrong appearances for", addr.Hex(), res.Err)
+				break
+			}
+		}
+
+		bl.Close()
+		chunk.Close()
+	}
+
+	if _, err := ParseReaderMode("disc"); err == nil {
+		t.Error("expected error for unknown reader mode")
+	}
+}
+
+func Test_ChunkCache(t *testing.T) {
+	addrs := testAddresses(100)
+	paths := []string{}
+	for i := 0; i < 3; i++ {
+		dir := filepath.Join(t.TempDir(), fmt.Sprintf("%d", i))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, ToBloomPath(writeTestChunk(t, dir, addrs)))
+	}
+
+	cache := NewChunkCache(ReaderMmap, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			handle, err := cache.Acquire(paths[i%len(paths)])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer handle.Release()
+
+			hits, _ := handle.Bloom.FilterMembers(addrs)
+			if len(hits) != len(addrs) {
+				t.Error("expected", len(addrs), "hits, got", len(hits))
+			}
+			chunk, err := handle.Index()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if results := chunk.ReadAppearancesBatch(hits); len(results) != len(hits) || results[0].AppRecords == nil {
+				t.Error("batch search failed")
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.Len() != 2 {
+		t.Error("expected cache of 2 chunks, got", cache.Len())
+	}
+
+	// A handle remains usable after it is evicted until it is released
+	handle, err := cache.Acquire(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Purge()
+	if cache.Len() != 0 {
+		t.Error("expected empty cache, got", cache.Len())
+	}
+	if hits, _ := handle.Bloom.FilterMembers(addrs[:1]); len(hits) != 1 {
+		t.Error("evicted handle should be usable until released")
+	}
+	handle.Release()
+	if handle.Bloom != nil {
+		t.Error("released evicted handle should be closed")
+	}
+
+	// A chunk that changes on disc is re-opened
+	first, _ := cache.Acquire(paths[1])
+	first.Release()
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(paths[1], later, later); err != nil {
+		t.Fatal(err)
+	}
+	second, err := cache.Acquire(paths[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Error("changed chunk should have been re-opened")
+	}
+	second.Release()
+
+	// ...as is a chunk whose index alone changes
+	if err := os.Chtimes(ToIndexPath(paths[1]), later, later); err != nil {
+		t.Fatal(err)
+	}
+	third, err := cache.Acquire(paths[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == second {
+		t.Error("chunk with changed index should have been re-opened")
+	}
+	third.Release()
+}
+
+func Test_ReplaceMapped(t *testing.T) {
+	addrs := testAddresses(50)
+	indexFn := writeTestChunk(t, t.TempDir(), addrs)
+
+	chunk, err := OpenIndexWith(indexFn, true /* check */, ReaderMmap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chunk.Close()
+
+	// Tagging the chunk replaces the file, so the mapped copy is unchanged
+	before := chunk.Header.Hash
+	tagged := Index{}
+	if err = tagged.updateTag("trueblocks-core@v0.0.1", indexFn); err != nil {
+		t.Fatal(err)
+	}
+	if header, err := chunk.readHeader(false /* check */); err != nil || header.Hash != before {
+		t.Error("the mapped chunk changed", err)
+	}
+	reread, err := OpenIndexWith(indexFn, false /* check */, ReaderMemory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reread.Close()
+	if reread.Header.Hash == before || reread.Header.AddressCount != chunk.Header.AddressCount {
+		t.Error("the chunk was not tagged")
+	}
+
+	// Appearances may be read from a chunk that has no File
+	pos := chunk.searchForAddressRecord(addrs[2])
+	record, err := chunk.readAddressRecord(pos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apps, err := chunk.ReadAppearancesAndReset(&record)
+	if err != nil || len(apps) != 2 || apps[0].BlockNumber != 2 {
+		t.Error("wrong appearances", apps, err)
+	}
+}
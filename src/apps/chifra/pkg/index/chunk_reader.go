@@ -0,0 +1,167 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+)
+
+// ReaderMode determines how index and bloom files are read when opened with OpenIndexWith and
+// OpenBloomWith (and therefore by the ChunkCache).
+type ReaderMode string
+
+const (
+	// ReaderFile reads the index from the file on disc for every lookup. Blooms are read into memory.
+	ReaderFile ReaderMode = "file"
+	// ReaderMemory reads both index and bloom files entirely into memory.
+	ReaderMemory ReaderMode = "memory"
+	// ReaderMmap memory-maps both index and bloom files.
+	ReaderMmap ReaderMode = "mmap"
+)
+
+// ParseReaderMode returns the ReaderMode for the given string. An empty string means ReaderFile.
+func ParseReaderMode(s string) (ReaderMode, error) {
+	switch mode := ReaderMode(s); mode {
+	case "":
+		return ReaderFile, nil
+	case ReaderFile, ReaderMemory, ReaderMmap:
+		return mode, nil
+	default:
+		return ReaderFile, fmt.Errorf("unknown index reader %q (must be one of file, memory, or mmap)", s)
+	}
+}
+
+// loadFile returns the contents of the file either read into memory or memory-mapped.
+func loadFile(path string, mode ReaderMode) (data []byte, mapped bool, err error) {
+	if mode == ReaderMmap {
+		data, err = mapFile(path)
+		return data, err == nil, err
+	}
+	data, err = os.ReadFile(path)
+	return data, false, err
+}
+
+// OpenIndexWith opens an Index using the given ReaderMode. With ReaderFile, this is the same as OpenIndex.
+// Otherwise, the contents of the file are held in memory, File is nil, and the Index may be searched from
+// multiple goroutines. The caller must Close the Index.
+func OpenIndexWith(fileName string, check bool, mode ReaderMode) (Index, error) {
+	if mode == ReaderFile {
+		return OpenIndex(fileName, check)
+	}
+
+	fileName = ToIndexPath(fileName)
+
+	blkRange, err := base.RangeFromFilenameE(fileName)
+	if err != nil {
+		return Index{}, err
+	}
+
+	indexChunk := Index{
+		AddrTableStart: HeaderWidth,
+		Range:          blkRange,
+	}
+	if indexChunk.data, indexChunk.mapped, err = loadFile(fileName, mode); err != nil {
+		return Index{}, err
+	}
+
+	indexChunk.Header, err = indexChunk.readHeader(check)
+	if err != nil {
+		indexChunk.Close()
+		return Index{}, fmt.Errorf("%w: %s", err, fileName)
+	}
+
+	indexChunk.AppTableStart = int64(HeaderWidth + (indexChunk.Header.AddressCount * AddrRecordWidth))
+	return indexChunk, nil
+}
+
+// OpenBloomWith opens a Bloom using the given ReaderMode. In every mode, the bloom's bits are available
+// in memory when this returns (with ReaderMmap, Blooms point into the mapped file), File is nil, and the
+// Bloom may be tested from multiple goroutines. The caller must Close the Bloom.
+func OpenBloomWith(path string, check bool, mode ReaderMode) (Bloom, error) {
+	var bl Bloom
+	var err error
+
+	if mode == ReaderFile {
+		if err = bl.Read(path); err != nil {
+			return bl, err
+		}
+		bl.SizeOnDisc = file.FileSize(path)
+		return bl, nil
+	}
+
+	if bl.Range, err = base.RangeFromFilenameE(path); err != nil {
+		return bl, err
+	}
+
+	if bl.data, bl.mapped, err = loadFile(path, mode); err != nil {
+		return bl, err
+	}
+	bl.SizeOnDisc = int64(len(bl.data))
+
+	if err = bl.readFromBytes(check); err != nil {
+		bl.Close()
+		return bl, fmt.Errorf("%w: %s", err, path)
+	}
+
+	return bl, nil
+}
+
+// readFromBytes parses the bloom held in bl.data. The bloom bytes (or xor fingerprints) are not copied
+// but refer to bl.data directly.
+func (bl *Bloom) readFromBytes(check bool) error {
+	if err := bl.readHeaderFrom(bytes.NewReader(bl.data), check); err != nil {
+		return err
+	}
+
+	pos := bl.HeaderSize
+	next := func(n int64) ([]byte, error) {
+		if pos+n > int64(len(bl.data)) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		ret := bl.data[pos : pos+n]
+		pos += n
+		return ret, nil
+	}
+
+	b, err := next(4)
+	if err != nil {
+		return err
+	}
+	bl.Count = binary.LittleEndian.Uint32(b)
+
+	if bl.isXor() {
+		bl.Blooms = nil
+		bl.xor = &xorFilter{}
+		if b, err = next(xorFilterHeaderWidth); err != nil {
+			return err
+		}
+		if err = bl.xor.readHeader(bytes.NewReader(b)); err != nil {
+			return err
+		}
+		bl.xor.Fingerprints, err = next(3 * int64(bl.xor.BlockLength))
+		return err
+	}
+
+	widthInBytes := int64(bl.params().WidthInBytes())
+	bl.Blooms = make([]bloomBytes, bl.Count)
+	for i := uint32(0); i < bl.Count; i++ {
+		if b, err = next(4); err != nil {
+			return err
+		}
+		bl.Blooms[i].NInserted = binary.LittleEndian.Uint32(b)
+		if bl.Blooms[i].Bytes, err = next(widthInBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
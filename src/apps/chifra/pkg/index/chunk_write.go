@@ -3,7 +3,6 @@ package index
 import (
 	"encoding/binary"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -51,7 +50,11 @@ func (chunk *Chunk) Write(chain string, publisher base.Address, fileName string,
 
 	// We need somewhere to store our progress...
 	offset := uint32(0)
-	bl := Bloom{}
+	params, err := GetBloomParams(chain)
+	if err != nil {
+		return nil, err
+	}
+	bl := NewBloom(params)
 
 	// For each address in the sorted list...
 	for _, addrStr := range sorted {
@@ -88,36 +91,24 @@ func (chunk *Chunk) Write(chain string, publisher base.Address, fileName string,
 			}
 		}()
 
-		if fp, err := os.OpenFile(indexFn, os.O_WRONLY|os.O_CREATE, 0644); err == nil {
-			// defer fp.Close() // Note -- we don't defer because we want to close the file and possibly pin it below...
-
-			_, _ = fp.Seek(0, io.SeekStart) // already true, but can't hurt
+		// The file is replaced rather than rewritten since it may be memory-mapped by a running daemon
+		if err := replaceFile(indexFn, func(fp *os.File) error {
 			header := indexHeader{
 				Magic:           file.MagicNumber,
 				Hash:            base.BytesToHash(config.HeaderHash(config.ExpectedVersion())),
 				AddressCount:    uint32(len(addressTable)),
 				AppearanceCount: uint32(len(appearanceTable)),
 			}
-			if err = binary.Write(fp, binary.LittleEndian, header); err != nil {
-				return nil, err
-			}
-
-			if err = binary.Write(fp, binary.LittleEndian, addressTable); err != nil {
-				return nil, err
+			if err := binary.Write(fp, binary.LittleEndian, header); err != nil {
+				return err
 			}
 
-			if err = binary.Write(fp, binary.LittleEndian, appearanceTable); err != nil {
-				return nil, err
-			}
-
-			if err := fp.Sync(); err != nil {
-				return nil, err
-			}
-
-			if err := fp.Close(); err != nil { // Close the file so we can pin it
-				return nil, err
+			if err := binary.Write(fp, binary.LittleEndian, addressTable); err != nil {
+				return err
 			}
 
+			return binary.Write(fp, binary.LittleEndian, appearanceTable)
+		}); err == nil {
 			if _, err = bl.writeBloom(ToBloomPath(indexFn)); err != nil {
 				return nil, err
 			}
@@ -157,9 +148,10 @@ func (chunk *Chunk) Tag(tag, fileName string) (err error) {
 
 	defer func() {
 		// If the backup files still exist when the function ends, something went wrong, reset everything
+		// (the backups are moved rather than copied into place since the chunk may be memory-mapped)
 		if file.FileExists(indexBackup) || file.FileExists(bloomBackup) {
-			_, _ = file.Copy(bloomFn, bloomBackup)
-			_, _ = file.Copy(indexFn, indexBackup)
+			_ = os.Rename(bloomBackup, bloomFn)
+			_ = os.Rename(indexBackup, indexFn)
 			_ = os.Remove(bloomBackup)
 			_ = os.Remove(indexBackup)
 		}
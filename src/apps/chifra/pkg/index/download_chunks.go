@@ -66,6 +66,7 @@ type writeWorkerArguments struct {
 	progressChannel progressChan
 	cancel          context.CancelFunc
 	writeWg         *sync.WaitGroup
+	suffix          string
 }
 
 // worker function type as accepted by Ants
@@ -198,7 +199,7 @@ func getWriteWorker(chain string, workerArgs writeWorkerArguments, chunkType wal
 				logger.Warn(sigintTrap.TrapMessage)
 			}
 			trapChannel := sigintTrap.Enable(workerArgs.ctx, workerArgs.cancel, cleanOnQuit)
-			err := writeBytesToDisc(chain, chunkType, res)
+			err := writeBytesToDisc(chain, chunkType, res, workerArgs.suffix)
 			sigintTrap.Disable(trapChannel)
 			if errors.Is(workerArgs.ctx.Err(), context.Canceled) {
 				// Ctrl-C was pressed, cancel
@@ -226,6 +227,45 @@ func getWriteWorker(chain string, workerArgs writeWorkerArguments, chunkType wal
 // DownloadChunks downloads, unzips and saves the chunk of type indicated by chunkType
 // for each chunk in chunks. ProgressMsg is reported to progressChannel.
 func DownloadChunks(chain string, chunksToDownload []types.SimpleChunkRecord, chunkType walk.CacheType, poolSize int, progressChannel progressChan) {
+	downloadChunks(chain, chunksToDownload, chunkType, poolSize, progressChannel, "")
+}
+
+// PendingSuffix is appended to the names of the files saved by DownloadPendingChunks
+const PendingSuffix = ".pending"
+
+// DownloadPendingChunks is DownloadChunks except that each file is saved alongside the file it
+// replaces (with PendingSuffix appended to its name). The caller puts the files in place with
+// CommitPendingChunks once every download succeeded or removes them with RemovePendingChunks.
+func DownloadPendingChunks(chain string, chunksToDownload []types.SimpleChunkRecord, chunkType walk.CacheType, poolSize int, progressChannel progressChan) {
+	downloadChunks(chain, chunksToDownload, chunkType, poolSize, progressChannel, PendingSuffix)
+}
+
+// CommitPendingChunks renames the pending files of each of the chunks over the chunk's files
+func CommitPendingChunks(chain string, chunks []types.SimpleChunkRecord) error {
+	for _, chunk := range chunks {
+		for _, chunkType := range []walk.CacheType{walk.Index_Bloom, walk.Index_Final} {
+			fullPath := toChunkPath(chain, chunk.Range, chunkType)
+			if err := os.Rename(fullPath+PendingSuffix, fullPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RemovePendingChunks removes any pending files of the chunks
+func RemovePendingChunks(chain string, chunks []types.SimpleChunkRecord) {
+	for _, chunk := range chunks {
+		for _, chunkType := range []walk.CacheType{walk.Index_Bloom, walk.Index_Final} {
+			pendingPath := toChunkPath(chain, chunk.Range, chunkType) + PendingSuffix
+			if file.FileExists(pendingPath) {
+				_ = os.Remove(pendingPath)
+			}
+		}
+	}
+}
+
+func downloadChunks(chain string, chunksToDownload []types.SimpleChunkRecord, chunkType walk.CacheType, poolSize int, progressChannel progressChan, suffix string) {
 	// Context lets us handle Ctrl-C easily
 	ctx, cancel := context.WithCancel(context.Background())
 	defer func() {
@@ -254,6 +294,7 @@ func DownloadChunks(chain string, chunksToDownload []types.SimpleChunkRecord, ch
 		progressChannel: progressChannel,
 		cancel:          cancel,
 		writeWg:         &writeWg,
+		suffix:          suffix,
 	}
 	writePool, err := ants.NewPoolWithFunc(poolSize, getWriteWorker(chain, writeWorkerArgs, chunkType))
 	defer writePool.Release()
@@ -301,35 +342,34 @@ func DownloadChunks(chain string, chunksToDownload []types.SimpleChunkRecord, ch
 	}
 }
 
-// writeBytesToDisc save the downloaded bytes to disc
-func writeBytesToDisc(chain string, chunkType walk.CacheType, res *jobResult) error {
-	fullPath := config.PathToIndex(chain) + "finalized/" + res.rng + ".bin"
+// toChunkPath returns the path of the file of the given type for the chunk with the given range
+func toChunkPath(chain, rng string, chunkType walk.CacheType) string {
+	fullPath := config.PathToIndex(chain) + "finalized/" + rng + ".bin"
 	if chunkType == walk.Index_Bloom {
 		fullPath = ToBloomPath(fullPath)
 	}
-	outputFile, err := os.OpenFile(fullPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
-	if err != nil {
-		return fmt.Errorf("error creating output file file %s in writeBytesToDisc: [%s]", res.rng, err)
-	}
+	return fullPath
+}
 
-	// Save downloaded bytes to a file
-	_, err = io.Copy(outputFile, res.contents)
+// writeBytesToDisc save the downloaded bytes to disc (in the chunk's file with the suffix appended)
+func writeBytesToDisc(chain string, chunkType walk.CacheType, res *jobResult, suffix string) error {
+	fullPath := toChunkPath(chain, res.rng, chunkType)
+	// Save downloaded bytes to a file (which replaces any existing file since it may be memory-mapped)
+	err := replaceFile(fullPath+suffix, func(outputFile *os.File) error {
+		_, err := io.Copy(outputFile, res.contents)
+		return err
+	})
 	if err != nil {
-		if file.FileExists(outputFile.Name()) {
-			outputFile.Close()
-			os.Remove(outputFile.Name())
-			col := colors.Magenta
-			if fullPath == ToIndexPath(fullPath) {
-				col = colors.Yellow
-			}
-			logger.Warn("Failed download", col, res.rng, colors.Off, "(will retry)", strings.Repeat(" ", 30))
+		col := colors.Magenta
+		if fullPath == ToIndexPath(fullPath) {
+			col = colors.Yellow
 		}
+		logger.Warn("Failed download", col, res.rng, colors.Off, "(will retry)", strings.Repeat(" ", 30))
 		// Information about this error
 		// https://community.k6.io/t/warn-0040-request-failed-error-stream-error-stream-id-3-internal-error/777/2
 		return fmt.Errorf("error copying %s file in writeBytesToDisc: [%s]", res.rng, err)
 	}
 
-	outputFile.Close()
 	return nil
 }
 
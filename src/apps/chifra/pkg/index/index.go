@@ -1,7 +1,9 @@
 package index
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -34,6 +36,8 @@ type Index struct {
 	Range          base.FileRange
 	AddrTableStart int64
 	AppTableStart  int64
+	data           []byte // if non-nil, the contents of the file (in memory or memory-mapped) and File is nil
+	mapped         bool
 }
 
 // OpenIndex returns an Index with an opened file pointer to the given fileName. The HeaderRecord
@@ -67,11 +71,27 @@ func OpenIndex(fileName string, check bool) (Index, error) {
 	return indexChunk, nil
 }
 
-// Close closes the Index's associated File pointer (if opened)
+// Close closes the Index's associated File pointer (if opened) and releases its memory (if mapped)
 func (chunk *Index) Close() error {
 	if chunk.File != nil {
 		chunk.File.Close()
 		chunk.File = nil
 	}
+	if chunk.data != nil {
+		data := chunk.data
+		chunk.data = nil
+		if chunk.mapped {
+			return unmapFile(data)
+		}
+	}
 	return nil
 }
+
+// readerAt returns the source of the Index's data, either the bytes in memory or the file on disc.
+// Unlike seeking and reading, reading at an offset is safe from multiple goroutines.
+func (chunk *Index) readerAt() io.ReaderAt {
+	if chunk.data != nil {
+		return bytes.NewReader(chunk.data)
+	}
+	return chunk.File
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"io"
 	"sort"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -32,28 +31,19 @@ func (chunk *Index) searchForAddressRecord(address base.Address) int {
 			return true
 		}
 
-		readLocation := int64(HeaderWidth + pos*AddrRecordWidth)
-		_, err := chunk.File.Seek(readLocation, io.SeekStart)
+		addressRec, err := chunk.readAddressRecord(pos)
 		if err != nil {
 			fmt.Println(err)
 			return false
 		}
 
-		addressRec := AddressRecord{}
-		if err = binary.Read(chunk.File, binary.LittleEndian, &addressRec); err != nil {
-			fmt.Println(err)
-			return false
-		}
-
 		return bytes.Compare(addressRec.Address.Bytes(), address.Bytes()) >= 0
 	}
 
 	pos := sort.Search(int(chunk.Header.AddressCount), compareFunc)
 
-	readLocation := int64(HeaderWidth + pos*AddrRecordWidth)
-	_, _ = chunk.File.Seek(readLocation, io.SeekStart)
-	rec := AddressRecord{}
-	if err := binary.Read(chunk.File, binary.LittleEndian, &rec); err != nil {
+	rec, err := chunk.readAddressRecord(pos)
+	if err != nil {
 		return -1
 	}
 
@@ -63,3 +53,17 @@ func (chunk *Index) searchForAddressRecord(address base.Address) int {
 
 	return pos
 }
+
+// readAddressRecord reads the address record at the given position in the address table. It does not
+// move the file pointer, so it may be called from multiple goroutines.
+func (chunk *Index) readAddressRecord(pos int) (AddressRecord, error) {
+	var buf [AddrRecordWidth]byte
+	rec := AddressRecord{}
+	if _, err := chunk.readerAt().ReadAt(buf[:], int64(HeaderWidth+pos*AddrRecordWidth)); err != nil {
+		return rec, err
+	}
+	rec.Address = base.BytesToAddress(buf[:20])
+	rec.Offset = binary.LittleEndian.Uint32(buf[20:24])
+	rec.Count = binary.LittleEndian.Uint32(buf[24:28])
+	return rec, nil
+}
@@ -16,35 +16,17 @@ type AppearanceRecord struct {
 	TransactionId uint32 `json:"transactionIndex"`
 }
 
+// ReadAppearancesAndReset returns the appearances of the address record. The appearances are read at their
+// offset (whether the Index is on disc or in memory), so the position of the Index's File is unchanged.
 func (chunk *Index) ReadAppearancesAndReset(addrRecord *AddressRecord) (apps []AppearanceRecord, err error) {
-	offset, err := chunk.File.Seek(0, io.SeekCurrent)
-	if err != nil {
-		return apps, err
-	}
-
-	apps, err = chunk.readAppearanceRecords(addrRecord)
-	if err != nil {
-		return apps, err
-	}
-
-	_, err = chunk.File.Seek(offset, io.SeekStart)
-	if err != nil {
-		return apps, err
-	}
-
-	return apps, nil
+	return chunk.readAppearanceRecords(addrRecord)
 }
 
 func (chunk *Index) readAppearanceRecords(addrRecord *AddressRecord) (apps []AppearanceRecord, err error) {
 	readLocation := int64(HeaderWidth + AddrRecordWidth*chunk.Header.AddressCount + AppRecordWidth*addrRecord.Offset)
 
-	_, err = chunk.File.Seek(readLocation, io.SeekStart)
-	if err != nil {
-		return
-	}
-
 	apps = make([]AppearanceRecord, addrRecord.Count)
-	err = binary.Read(chunk.File, binary.LittleEndian, &apps)
+	err = binary.Read(io.NewSectionReader(chunk.readerAt(), readLocation, int64(AppRecordWidth*addrRecord.Count)), binary.LittleEndian, &apps)
 
 	return
 }
@@ -1,6 +1,7 @@
 package index
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -23,8 +24,13 @@ type indexHeader struct {
 func (chunk *Index) readHeader(check bool) (indexHeader, error) {
 	var header indexHeader
 
-	_, _ = chunk.File.Seek(0, io.SeekStart) // already true, but can't hurt
-	if err := binary.Read(chunk.File, binary.LittleEndian, &header); err != nil {
+	var r io.Reader = chunk.File
+	if chunk.data != nil {
+		r = bytes.NewReader(chunk.data)
+	} else {
+		_, _ = chunk.File.Seek(0, io.SeekStart) // already true, but can't hurt
+	}
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
 		return header, err
 	}
 
@@ -14,23 +14,11 @@ import (
 // This is a non-recoverable operation. The caller must take care of making a backup of
 // the file before we start if desired.
 func (idx *Index) updateTag(tag, fileName string) error {
-	var err error
-	if idx.File, err = os.OpenFile(fileName, os.O_RDWR, 0644); err != nil {
-		return err
-
-	} else {
-		defer func() {
-			_ = idx.File.Sync() // probably redundant
-			_ = idx.File.Close()
-			idx.File = nil
-		}()
-
+	return rewriteFile(fileName, func(fp *os.File) error {
 		// don't love this, but it saves us from having to read in and preserve the header
-		_, _ = idx.File.Seek(int64(unsafe.Sizeof(idx.Header.Magic)), io.SeekStart)
-		if err = binary.Write(idx.File, binary.LittleEndian, base.BytesToHash(config.HeaderHash(tag))); err != nil {
+		if _, err := fp.Seek(int64(unsafe.Sizeof(idx.Header.Magic)), io.SeekStart); err != nil {
 			return err
 		}
-	}
-
-	return nil
+		return binary.Write(fp, binary.LittleEndian, base.BytesToHash(config.HeaderHash(tag)))
+	})
 }
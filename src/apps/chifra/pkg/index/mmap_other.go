@@ -0,0 +1,19 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+//go:build !unix
+
+package index
+
+import "os"
+
+// mapFile reads the entire file into memory on platforms without memory-mapping.
+func mapFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// unmapFile releases memory returned by mapFile
+func unmapFile(data []byte) error {
+	return nil
+}
@@ -0,0 +1,358 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+)
+
+// PostingList is a secondary index for a single, heavily used (hot) address. It carries every appearance of
+// the address in the finalized chunks preceeding NextBlock, sorted by block and transaction, so that they may
+// be read from a single file rather than visiting (and binary searching) every chunk in the index.
+//
+// Posting lists are built for the addresses listed in the chain's hotAddresses.txt file by chifra chunks
+// postings and are extended by the scraper each time it consolidates a new chunk.
+type PostingList struct {
+	Address     base.Address
+	NextBlock   uint64 // the first block not covered by the posting list
+	Appearances []AppearanceRecord
+}
+
+// postingHeader is stored at the front of each posting list file and is followed by Count AppearanceRecords
+type postingHeader struct {
+	Magic     uint32
+	Hash      base.Hash
+	Address   base.Address
+	NextBlock uint64
+	Count     uint32
+}
+
+// HotAddressesPath returns the path to the file listing the chain's hot addresses, one per line.
+func HotAddressesPath(chain string) string {
+	return filepath.Join(config.MustGetPathToChainConfig(chain), "hotAddresses.txt")
+}
+
+// HotAddresses returns the valid addresses found in the chain's hotAddresses.txt file. Blank lines and lines
+// starting with '#' are ignored.
+func HotAddresses(chain string) []base.Address {
+	ret := []base.Address{}
+	seen := map[base.Address]bool{}
+	for _, line := range file.AsciiFileToLines(HotAddressesPath(chain)) {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !base.IsValidAddress(line) {
+			logger.Warn("Invalid hot address ignored:", line)
+			continue
+		}
+		addr := base.HexToAddress(line)
+		if !seen[addr] {
+			seen[addr] = true
+			ret = append(ret, addr)
+		}
+	}
+	return ret
+}
+
+// ToPostingPath returns the path to the address's posting list. Posting lists are partitioned into folders
+// by the first byte of the address. The path is built from the full address (addr.Hex() shortens the
+// zero address to 0x0).
+func ToPostingPath(chain string, addr base.Address) string {
+	name := "0x" + hex.EncodeToString(addr.Bytes())
+	return filepath.Join(config.PathToIndex(chain), "postings", name[2:4], name+".bin")
+}
+
+// ReadPostingList reads the address's posting list with a single sequential read. It returns an error
+// satisfying os.IsNotExist if there is no posting list for the address.
+func ReadPostingList(chain string, addr base.Address) (*PostingList, error) {
+	fileName := ToPostingPath(chain, addr)
+	fp, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	r := bufio.NewReader(fp)
+	var header postingHeader
+	if err = binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, fileName)
+	}
+
+	if header.Magic != file.PostingMagicNumber {
+		return nil, fmt.Errorf("PostingList.Read: %w %x %x: %s", ErrIncorrectMagic, header.Magic, file.PostingMagicNumber, fileName)
+	}
+
+	if header.Hash != base.BytesToHash(config.HeaderHash(config.ExpectedVersion())) {
+		return nil, fmt.Errorf("PostingList.Read: %w %x: %s", ErrIncorrectHash, header.Hash, fileName)
+	}
+
+	pl := PostingList{
+		Address:     header.Address,
+		NextBlock:   header.NextBlock,
+		Appearances: make([]AppearanceRecord, header.Count),
+	}
+	if err = binary.Read(r, binary.LittleEndian, pl.Appearances); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, fileName)
+	}
+
+	return &pl, nil
+}
+
+// Write writes the posting list to a temporary file and then moves it into place, so readers never see
+// a partially written list.
+func (pl *PostingList) Write(chain string) error {
+	fileName := ToPostingPath(chain, pl.Address)
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+
+	tmpName := fileName + ".tmp"
+	fp, err := os.OpenFile(tmpName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(fp)
+	header := postingHeader{
+		Magic:     file.PostingMagicNumber,
+		Hash:      base.BytesToHash(config.HeaderHash(config.ExpectedVersion())),
+		Address:   pl.Address,
+		NextBlock: pl.NextBlock,
+		Count:     uint32(len(pl.Appearances)),
+	}
+	if err = binary.Write(w, binary.LittleEndian, header); err == nil {
+		if err = binary.Write(w, binary.LittleEndian, pl.Appearances); err == nil {
+			err = w.Flush()
+		}
+	}
+	if closeErr := fp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	return os.Rename(tmpName, fileName)
+}
+
+// Since returns the appearances in the posting list at or after the given block.
+func (pl *PostingList) Since(block uint64) []AppearanceRecord {
+	pos := sort.Search(len(pl.Appearances), func(i int) bool {
+		return uint64(pl.Appearances[i].BlockNumber) >= block
+	})
+	return pl.Appearances[pos:]
+}
+
+// AppendChunk adds the address's appearances in the chunk with the given range to the posting list. It
+// returns false (and does nothing) if the chunk does not immediately follow the blocks already covered.
+func (pl *PostingList) AppendChunk(rng base.FileRange, apps []AppearanceRecord) bool {
+	if rng.First != pl.NextBlock {
+		return false
+	}
+
+	sorted := make([]AppearanceRecord, len(apps))
+	copy(sorted, apps)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].BlockNumber == sorted[j].BlockNumber {
+			return sorted[i].TransactionId < sorted[j].TransactionId
+		}
+		return sorted[i].BlockNumber < sorted[j].BlockNumber
+	})
+
+	pl.Appearances = append(pl.Appearances, sorted...)
+	pl.NextBlock = rng.Last + 1
+	return true
+}
+
+// UpdatePostingLists extends the existing posting lists of the chain's hot addresses with a newly written
+// chunk. The appearance map is keyed by address as it is when the chunk is written. Posting lists that do
+// not end immediately before the chunk are left alone (chifra chunks postings brings them up to date).
+func UpdatePostingLists(chain string, rng base.FileRange, appMap map[string][]AppearanceRecord) error {
+	lists := make(map[base.Address]*PostingList)
+	for _, addr := range HotAddresses(chain) {
+		pl, err := ReadPostingList(chain, addr)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		lists[addr] = pl
+	}
+	if len(lists) == 0 {
+		return nil
+	}
+
+	// The map's keys are not necessarily formatted as base.Address.Hex formats them (the zero address,
+	// for example), so we look the lists up by address
+	apps := make(map[base.Address][]AppearanceRecord, len(lists))
+	for key, records := range appMap {
+		addr := base.HexToAddress(key)
+		if lists[addr] != nil {
+			apps[addr] = append(apps[addr], records...)
+		}
+	}
+
+	for addr, pl := range lists {
+		if pl.AppendChunk(rng, apps[addr]) {
+			if err := pl.Write(chain); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// BuildPostingLists brings the posting lists for the given addresses up to date with the finalized chunks
+// on disc, creating those that do not exist. Each chunk whose bloom filter hits one of the addresses is
+// searched once for all of them. It returns the updated posting lists.
+func BuildPostingLists(chain string, addrs []base.Address) ([]*PostingList, error) {
+	lists := make(map[base.Address]*PostingList, len(addrs))
+	for _, addr := range addrs {
+		pl, err := ReadPostingList(chain, addr)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				logger.Warn("Rebuilding posting list:", err)
+			}
+			pl = &PostingList{Address: addr}
+		}
+		lists[addr] = pl
+	}
+
+	bloomPath := filepath.Join(config.PathToIndex(chain), "blooms")
+	entries, err := os.ReadDir(bloomPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := make([]base.FileRange, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".bloom") {
+			continue
+		}
+		if rng, err := base.RangeFromFilenameE(entry.Name()); err == nil {
+			ranges = append(ranges, rng)
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].First < ranges[j].First
+	})
+
+	for _, rng := range ranges {
+		needed := make([]base.Address, 0, len(addrs))
+		for _, addr := range addrs {
+			if lists[addr].NextBlock == rng.First {
+				needed = append(needed, addr)
+			}
+		}
+		if len(needed) == 0 {
+			continue
+		}
+
+		found, err := searchChunk(filepath.Join(bloomPath, rng.String()+".bloom"), needed)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range needed {
+			lists[addr].AppendChunk(rng, found[addr])
+		}
+	}
+
+	ret := make([]*PostingList, 0, len(addrs))
+	for _, addr := range addrs {
+		if err := lists[addr].Write(chain); err != nil {
+			return nil, err
+		}
+		ret = append(ret, lists[addr])
+	}
+	return ret, nil
+}
+
+// searchChunk returns the appearances of the given addresses in the chunk whose bloom filter is at
+// bloomFilename. The index is opened only if the bloom hits.
+func searchChunk(bloomFilename string, addrs []base.Address) (map[base.Address][]AppearanceRecord, error) {
+	ret := make(map[base.Address][]AppearanceRecord)
+
+	bl, err := OpenBloom(bloomFilename, true /* check */)
+	if err != nil {
+		bl.Close()
+		return nil, err
+	}
+	hits, err := bl.FilterMembers(addrs)
+	bl.Close()
+	if err != nil || len(hits) == 0 {
+		return ret, err
+	}
+
+	indexFilename := ToIndexPath(bloomFilename)
+	if !file.FileExists(indexFilename) {
+		return nil, fmt.Errorf("the index chunk %s is required to build posting lists, download it with chifra init --all", indexFilename)
+	}
+
+	indexChunk, err := OpenIndex(indexFilename, true /* check */)
+	if err != nil {
+		return nil, err
+	}
+	defer indexChunk.Close()
+
+	for _, result := range indexChunk.ReadAppearancesBatch(hits) {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		if result.AppRecords != nil {
+			ret[result.Address] = *result.AppRecords
+		}
+	}
+	return ret, nil
+}
+
+// TruncatePostingLists removes any appearances at or after the given block from all of the chain's
+// posting lists. This must be done whenever the index is truncated.
+func TruncatePostingLists(chain string, nextBlock uint64) error {
+	postingsPath := filepath.Join(config.PathToIndex(chain), "postings")
+	return filepath.Walk(postingsPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".bin") {
+			return nil
+		}
+
+		addr := base.HexToAddress(strings.TrimSuffix(filepath.Base(path), ".bin"))
+		pl, err := ReadPostingList(chain, addr)
+		if err != nil {
+			return err
+		}
+		if pl.NextBlock <= nextBlock {
+			return nil
+		}
+		pl.Appearances = pl.Appearances[:len(pl.Appearances)-len(pl.Since(nextBlock))]
+		pl.NextBlock = nextBlock
+		return pl.Write(chain)
+	})
+}
+
+// RemovePostingLists removes all of the chain's posting lists. This must be done whenever finalized
+// chunks are removed or replaced.
+func RemovePostingLists(chain string) error {
+	return os.RemoveAll(filepath.Join(config.PathToIndex(chain), "postings"))
+}
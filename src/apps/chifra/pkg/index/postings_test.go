@@ -0,0 +1,79 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func Test_PostingList(t *testing.T) {
+	pl := PostingList{Address: base.HexToAddress("0x1234567890123456789012345678901234567890")}
+
+	chunks := []struct {
+		rng      base.FileRange
+		apps     []AppearanceRecord
+		expected bool
+	}{
+		{base.FileRange{First: 0, Last: 99}, []AppearanceRecord{{BlockNumber: 10, TransactionId: 2}, {BlockNumber: 10, TransactionId: 1}}, true},
+		{base.FileRange{First: 200, Last: 299}, []AppearanceRecord{{BlockNumber: 250}}, false}, // not contiguous
+		{base.FileRange{First: 100, Last: 199}, nil, true},
+		{base.FileRange{First: 200, Last: 299}, []AppearanceRecord{{BlockNumber: 299, TransactionId: 4}, {BlockNumber: 200}}, true},
+	}
+	for i, chunk := range chunks {
+		if got := pl.AppendChunk(chunk.rng, chunk.apps); got != chunk.expected {
+			t.Error(i, "expected AppendChunk to return", chunk.expected, "got", got)
+		}
+	}
+
+	if pl.NextBlock != 300 {
+		t.Error("expected NextBlock 300, got", pl.NextBlock)
+	}
+	expected := []AppearanceRecord{{BlockNumber: 10, TransactionId: 1}, {BlockNumber: 10, TransactionId: 2}, {BlockNumber: 200}, {BlockNumber: 299, TransactionId: 4}}
+	if len(pl.Appearances) != len(expected) {
+		t.Fatal("expected", expected, "got", pl.Appearances)
+	}
+	for i := range expected {
+		if pl.Appearances[i] != expected[i] {
+			t.Error("expected", expected, "got", pl.Appearances)
+			break
+		}
+	}
+
+	tests := []struct {
+		block    uint64
+		expected int
+	}{
+		{0, 4},
+		{10, 4},
+		{11, 2},
+		{200, 2},
+		{201, 1},
+		{300, 0},
+	}
+	for _, test := range tests {
+		if got := len(pl.Since(test.block)); got != test.expected {
+			t.Error("Since", test.block, "expected", test.expected, "got", got)
+		}
+	}
+}
+
+func Test_ToPostingPath(t *testing.T) {
+	tests := []struct {
+		addr     base.Address
+		expected string
+	}{
+		{base.HexToAddress("0x1234567890123456789012345678901234567890"), "12/0x1234567890123456789012345678901234567890.bin"},
+		{base.ZeroAddr, "00/0x0000000000000000000000000000000000000000.bin"},
+	}
+	for _, test := range tests {
+		if got := ToPostingPath("mainnet", test.addr); !strings.HasSuffix(got, filepath.Join("postings", test.expected)) {
+			t.Error("expected a path ending in", test.expected, "got", got)
+		}
+	}
+}
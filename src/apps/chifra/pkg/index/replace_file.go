@@ -0,0 +1,52 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package index
+
+import (
+	"io"
+	"os"
+)
+
+// replaceFile writes a new version of the file to a temporary file and then moves it into place. Chunks
+// may be memory-mapped by a running daemon (see ReaderMmap). Rewriting a mapped file in place changes the
+// data under its readers (and truncating it crashes them), while a renamed file leaves the mapped version
+// intact until its readers close it.
+func replaceFile(fileName string, write func(fp *os.File) error) error {
+	tmpName := fileName + ".tmp"
+	fp, err := os.OpenFile(tmpName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	if err = write(fp); err == nil {
+		err = fp.Sync()
+	}
+	if closeErr := fp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	return os.Rename(tmpName, fileName)
+}
+
+// rewriteFile replaces the file with a copy of itself modified by the given function (which may seek
+// and write over any part of the copy)
+func rewriteFile(fileName string, modify func(fp *os.File) error) error {
+	src, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return replaceFile(fileName, func(fp *os.File) error {
+		if _, err := io.Copy(fp, src); err != nil {
+			return err
+		}
+		return modify(fp)
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package manifest
+
+import (
+	"sort"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// DiffReason describes why a chunk was reported as different between two manifests
+type DiffReason string
+
+const (
+	DiffLocalOnly  DiffReason = "localOnly"
+	DiffRemoteOnly DiffReason = "remoteOnly"
+	DiffRange      DiffReason = "range"
+	DiffBloomHash  DiffReason = "bloomHash"
+	DiffIndexHash  DiffReason = "indexHash"
+	DiffBothHashes DiffReason = "bothHashes"
+)
+
+// ChunkDiff carries a single difference between a local and a remote manifest. Either
+// Local or Remote may be nil if the chunk appears in only one of the two manifests.
+type ChunkDiff struct {
+	Range  string
+	Reason DiffReason
+	Local  *types.SimpleChunkRecord
+	Remote *types.SimpleChunkRecord
+}
+
+// Diff compares the receiver (the local manifest) against the remote manifest chunk by chunk.
+// Chunks with identical ranges are compared by their Bloom filter and index hashes. Chunks
+// whose ranges do not appear in the other manifest are reported as range mismatches if they
+// overlap a chunk in the other manifest, otherwise as present in only one of the two. The
+// result is sorted by range.
+func (m *Manifest) Diff(remote *Manifest) []ChunkDiff {
+	localMap := make(map[string]*types.SimpleChunkRecord, len(m.Chunks))
+	for i := range m.Chunks {
+		localMap[m.Chunks[i].Range] = &m.Chunks[i]
+	}
+	remoteMap := make(map[string]*types.SimpleChunkRecord, len(remote.Chunks))
+	for i := range remote.Chunks {
+		remoteMap[remote.Chunks[i].Range] = &remote.Chunks[i]
+	}
+
+	overlapsAny := func(rng string, chunks []types.SimpleChunkRecord) bool {
+		r := base.RangeFromRangeString(rng)
+		for _, chunk := range chunks {
+			if r.Intersects(base.RangeFromRangeString(chunk.Range)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	diffs := []ChunkDiff{}
+	for i := range m.Chunks {
+		local := &m.Chunks[i]
+		if rem, ok := remoteMap[local.Range]; ok {
+			bloomDiffers := local.BloomHash != rem.BloomHash
+			indexDiffers := local.IndexHash != rem.IndexHash
+			reason := DiffReason("")
+			switch {
+			case bloomDiffers && indexDiffers:
+				reason = DiffBothHashes
+			case bloomDiffers:
+				reason = DiffBloomHash
+			case indexDiffers:
+				reason = DiffIndexHash
+			}
+			if reason != "" {
+				diffs = append(diffs, ChunkDiff{Range: local.Range, Reason: reason, Local: local, Remote: rem})
+			}
+		} else if overlapsAny(local.Range, remote.Chunks) {
+			diffs = append(diffs, ChunkDiff{Range: local.Range, Reason: DiffRange, Local: local})
+		} else {
+			diffs = append(diffs, ChunkDiff{Range: local.Range, Reason: DiffLocalOnly, Local: local})
+		}
+	}
+
+	for i := range remote.Chunks {
+		rem := &remote.Chunks[i]
+		if _, ok := localMap[rem.Range]; ok {
+			continue
+		} else if overlapsAny(rem.Range, m.Chunks) {
+			diffs = append(diffs, ChunkDiff{Range: rem.Range, Reason: DiffRange, Remote: rem})
+		} else {
+			diffs = append(diffs, ChunkDiff{Range: rem.Range, Reason: DiffRemoteOnly, Remote: rem})
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Range < diffs[j].Range
+	})
+
+	return diffs
+}
+
+// NeedsDownload returns true if the remote side of the difference should be downloaded in
+// order to bring the local index in line with the remote manifest.
+func (d *ChunkDiff) NeedsDownload() bool {
+	return d.Remote != nil
+}
+
+// Reconcile returns a copy of the remote manifest extended with any local chunks that strictly
+// follow the last remote chunk (such as those produced by a locally running scraper), along
+// with the local chunks that do not survive the merge and must be removed from disc. Local
+// chunks that follow the remote manifest are kept only if they continue it without a gap.
+func (m *Manifest) Reconcile(remote *Manifest) (*Manifest, []types.SimpleChunkRecord) {
+	merged := *remote
+	merged.Chunks = append([]types.SimpleChunkRecord{}, remote.Chunks...)
+
+	inRemote := make(map[string]bool, len(remote.Chunks))
+	for _, chunk := range remote.Chunks {
+		inRemote[chunk.Range] = true
+	}
+
+	var next uint64
+	if len(remote.Chunks) > 0 {
+		next = base.RangeFromRangeString(remote.Chunks[len(remote.Chunks)-1].Range).Last + 1
+	}
+
+	removed := []types.SimpleChunkRecord{}
+	for _, chunk := range m.Chunks {
+		if inRemote[chunk.Range] {
+			continue
+		}
+		rng := base.RangeFromRangeString(chunk.Range)
+		if rng.First == next {
+			merged.Chunks = append(merged.Chunks, chunk)
+			next = rng.Last + 1
+		} else {
+			removed = append(removed, chunk)
+		}
+	}
+
+	merged.ChunkMap = make(map[string]*types.SimpleChunkRecord, len(merged.Chunks))
+	for i := range merged.Chunks {
+		merged.ChunkMap[merged.Chunks[i].Range] = &merged.Chunks[i]
+	}
+
+	return &merged, removed
+}
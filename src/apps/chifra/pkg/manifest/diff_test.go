@@ -0,0 +1,93 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func chunk(rng, bloom, index string) types.SimpleChunkRecord {
+	return types.SimpleChunkRecord{
+		Range:     rng,
+		BloomHash: base.IpfsHash(bloom),
+		IndexHash: base.IpfsHash(index),
+	}
+}
+
+func TestManifestDiff(t *testing.T) {
+	local := &Manifest{Chunks: []types.SimpleChunkRecord{
+		chunk("000000000-000000100", "b1", "i1"),
+		chunk("000000101-000000200", "b2", "i2x"),
+		chunk("000000201-000000250", "b3", "i3"),
+		chunk("000000251-000000400", "b4", "i4"),
+		chunk("000000401-000000500", "b5", "i5"),
+	}}
+	remote := &Manifest{Chunks: []types.SimpleChunkRecord{
+		chunk("000000000-000000100", "b1", "i1"),
+		chunk("000000101-000000200", "b2", "i2"),
+		chunk("000000201-000000300", "b3r", "i3r"),
+		chunk("000000301-000000400", "b4r", "i4r"),
+	}}
+
+	expected := []struct {
+		rng    string
+		reason DiffReason
+	}{
+		{"000000101-000000200", DiffIndexHash},
+		{"000000201-000000250", DiffRange},
+		{"000000201-000000300", DiffRange},
+		{"000000251-000000400", DiffRange},
+		{"000000301-000000400", DiffRange},
+		{"000000401-000000500", DiffLocalOnly},
+	}
+
+	diffs := local.Diff(remote)
+	if len(diffs) != len(expected) {
+		t.Fatalf("expected %d differences, got %d: %v", len(expected), len(diffs), diffs)
+	}
+	for i, diff := range diffs {
+		if diff.Range != expected[i].rng || diff.Reason != expected[i].reason {
+			t.Errorf("difference %d: expected %s %s, got %s %s", i, expected[i].rng, expected[i].reason, diff.Range, diff.Reason)
+		}
+	}
+
+	if diffs := remote.Diff(remote); len(diffs) != 0 {
+		t.Errorf("expected no differences between identical manifests, got %d", len(diffs))
+	}
+}
+
+func TestManifestReconcile(t *testing.T) {
+	remote := &Manifest{Chunks: []types.SimpleChunkRecord{
+		chunk("000000000-000000100", "b1", "i1"),
+		chunk("000000101-000000200", "b2", "i2"),
+	}}
+
+	local := &Manifest{Chunks: []types.SimpleChunkRecord{
+		chunk("000000000-000000100", "b1", "i1"),
+		chunk("000000101-000000150", "b2", "i2"),
+		chunk("000000151-000000200", "b3", "i3"),
+		chunk("000000201-000000300", "b4", "i4"),
+		chunk("000000301-000000400", "b5", "i5"),
+	}}
+	merged, removed := local.Reconcile(remote)
+	if len(merged.Chunks) != 4 || merged.Chunks[3].Range != "000000301-000000400" {
+		t.Errorf("expected local chunks following the remote manifest to be kept, got %v", merged.Chunks)
+	}
+	if len(removed) != 2 || removed[0].Range != "000000101-000000150" || removed[1].Range != "000000151-000000200" {
+		t.Errorf("expected two overlapping chunks to be removed, got %v", removed)
+	}
+
+	gapped := &Manifest{Chunks: []types.SimpleChunkRecord{
+		chunk("000000000-000000100", "b1", "i1"),
+		chunk("000000250-000000300", "b4", "i4"),
+	}}
+	merged, removed = gapped.Reconcile(remote)
+	if len(merged.Chunks) != 2 || len(removed) != 1 {
+		t.Errorf("expected gapped local chunk to be removed, got %v and %v", merged.Chunks, removed)
+	}
+}
@@ -29,8 +29,18 @@ func customCreateName(chain string, name *types.SimpleName) (err error) {
 	name.IsCustom = true
 	loadedCustomNamesMutex.Lock()
 	defer loadedCustomNamesMutex.Unlock()
+
+	change := newChange(OpCreate, name.Address, nil, name)
+	if existing, ok := loadedCustomNames[name.Address]; ok {
+		change = newChange(OpUpdate, name.Address, &existing, name)
+	}
+
 	loadedCustomNames[name.Address] = *name
-	return writeCustomNames(db)
+	loadedCustomIndex = nil
+	if err = writeCustomNames(db); err != nil {
+		return
+	}
+	return appendChanges(chain, change)
 }
 
 func regularCreateName(chain string, name *types.SimpleName) (err error) {
@@ -39,5 +49,6 @@ func regularCreateName(chain string, name *types.SimpleName) (err error) {
 
 	name.IsCustom = false
 	loadedRegularNames[name.Address] = *name
+	loadedRegularIndex = nil
 	return
 }
@@ -14,34 +14,35 @@ import (
 )
 
 var loadedCustomNames map[base.Address]types.SimpleName = map[base.Address]types.SimpleName{}
+var loadedCustomIndex *nameIndex
 var loadedCustomNamesMutex sync.Mutex
 
 func loadCustomMap(chain string, terms []string, parts Parts, namesMap *map[base.Address]types.SimpleName) (err error) {
-	if len(loadedCustomNames) != 0 {
-		// We have already loaded the data
-		for _, name := range loadedCustomNames {
-			if doSearch(&name, terms, parts) {
-				(*namesMap)[name.Address] = name
-			}
-		}
-		return
-	}
 	loadedCustomNamesMutex.Lock()
 	defer loadedCustomNamesMutex.Unlock()
 
-	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_RDONLY)
-	if err != nil {
-		return err
-	}
-	defer db.Close()
+	if len(loadedCustomNames) == 0 {
+		db, err := openDatabaseFile(chain, DatabaseCustom, os.O_RDONLY)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
 
-	loadedCustomNames, err = unmarshallCustomNames(db, terms, parts, namesMap)
-	if err != nil {
-		return err
-	}
-	if parts&Testing != 0 {
-		loadTestNames(terms, parts, &loadedCustomNames, namesMap)
+		loadedCustomNames, err = unmarshallCustomNames(db, nil, parts, &map[base.Address]types.SimpleName{})
+		if err != nil {
+			return err
+		}
+		if parts&Testing != 0 {
+			loadTestNames(nil, parts, &loadedCustomNames, &map[base.Address]types.SimpleName{})
+		}
+		loadedCustomIndex = loadIndex(getDatabasePath(chain, DatabaseCustom), loadedCustomNames)
+
+	} else if loadedCustomIndex == nil || len(loadedCustomIndex.Addresses) != len(loadedCustomNames) {
+		// The names have been edited, so the index is rebuilt (it is persisted the next time the file is read)
+		loadedCustomIndex = buildIndex(loadedCustomNames)
 	}
+
+	searchNames(loadedCustomNames, loadedCustomIndex, compileQuery(terms, parts), namesMap)
 	return
 }
 
@@ -29,7 +29,16 @@ func customSetDeleted(chain string, address base.Address, deleted bool) (name *t
 	}
 	defer db.Close()
 
-	return changeDeleted(db, address, deleted)
+	previous := loadedCustomNames[address]
+	if name, err = changeDeleted(db, address, deleted); err != nil {
+		return
+	}
+
+	operation := OpDelete
+	if !deleted {
+		operation = OpUndelete
+	}
+	return name, appendChanges(chain, newChange(operation, address, &previous, name))
 }
 
 func changeDeleted(output *os.File, address base.Address, deleted bool) (*types.SimpleName, error) {
@@ -43,5 +52,6 @@ func changeDeleted(output *os.File, address base.Address, deleted bool) (*types.
 	loadedCustomNamesMutex.Lock()
 	defer loadedCustomNamesMutex.Unlock()
 	loadedCustomNames[name.Address] = name
+	loadedCustomIndex = nil
 	return &name, writeCustomNames(output)
 }
@@ -0,0 +1,245 @@
+package names
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+// EnsTag is the tag given to names derived from ENS reverse records
+const EnsTag = "90-Individuals:ENS"
+
+// EnsStaleBlocks is the number of blocks (about one week on mainnet) after which a cached ENS
+// resolution is no longer used and the address is resolved again
+var EnsStaleBlocks = base.Blknum(50400)
+
+// ensRecord is the result of resolving an address's primary ENS name at a given block. Addresses without
+// a (verified) primary name are cached with an empty name so they are not resolved again.
+type ensRecord struct {
+	Address     base.Address
+	Name        string
+	BlockNumber base.Blknum
+}
+
+var loadedEnsNames map[base.Address]ensRecord = map[base.Address]ensRecord{}
+var loadedEnsChain string
+var loadedEnsNamesMutex sync.Mutex
+
+// loadEnsMap adds the cached ENS names that match the search terms to the names map
+func loadEnsMap(chain string, terms []string, parts Parts, namesMap *map[base.Address]types.SimpleName) error {
+	loadedEnsNamesMutex.Lock()
+	defer loadedEnsNamesMutex.Unlock()
+
+	if err := readEnsCache(chain); err != nil {
+		return err
+	}
+
+	for _, record := range loadedEnsNames {
+		if len(record.Name) == 0 {
+			continue
+		}
+		name := record.toName()
+		if doSearch(&name, terms, parts) {
+			(*namesMap)[name.Address] = name
+		}
+	}
+	return nil
+}
+
+// ResolveEnsNames returns the primary ENS names (verified by forward resolution) of the addresses at the given
+// block. Addresses without a primary name are not included in the result. Results are cached on disk, and
+// a cached result is used if it was resolved at or before the given block and within EnsStaleBlocks of it.
+// On chains without ENS, names are resolved on mainnet at its latest block, and it is that block which is
+// cached and compared. Newly resolved names are also available to later calls to LoadNamesMap with the Ens part.
+func ResolveEnsNames(conn *rpc.Connection, addrs []base.Address, bn base.Blknum) (map[base.Address]types.SimpleName, error) {
+	ensConn, sameChain := conn.EnsConnection()
+	if !sameChain {
+		bn = ensConn.GetLatestBlockNumber()
+	}
+
+	loadedEnsNamesMutex.Lock()
+	defer loadedEnsNamesMutex.Unlock()
+
+	if err := readEnsCache(conn.Chain); err != nil {
+		return nil, err
+	}
+
+	ret := make(map[base.Address]types.SimpleName, len(addrs))
+	missing := make([]base.Address, 0, len(addrs))
+	seen := make(map[base.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		if seen[addr] || addr.IsZero() {
+			continue
+		}
+		seen[addr] = true
+		if record, ok := loadedEnsNames[addr]; ok && record.isFresh(bn) {
+			if len(record.Name) > 0 {
+				ret[addr] = record.toName()
+			}
+		} else {
+			missing = append(missing, addr)
+		}
+	}
+
+	if len(missing) == 0 {
+		return ret, nil
+	}
+
+	resolved, err := ensConn.GetEnsNames(missing, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	dirty := false
+	for _, addr := range missing {
+		record := ensRecord{Address: addr, Name: resolved[addr], BlockNumber: bn}
+		if len(record.Name) > 0 {
+			ret[addr] = record.toName()
+		}
+		// Keep only the most recent resolution of each address
+		if existing, ok := loadedEnsNames[addr]; !ok || existing.BlockNumber <= bn {
+			loadedEnsNames[addr] = record
+			dirty = true
+		}
+	}
+
+	if dirty {
+		if err := writeEnsCache(conn.Chain); err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+// isFresh returns true if the cached resolution may be used for a lookup at the given block
+func (r *ensRecord) isFresh(bn base.Blknum) bool {
+	return r.BlockNumber <= bn && bn-r.BlockNumber < EnsStaleBlocks
+}
+
+func (r *ensRecord) toName() types.SimpleName {
+	return types.SimpleName{
+		Address: r.Address,
+		Name:    r.Name,
+		Tags:    EnsTag,
+		Source:  "ENS",
+	}
+}
+
+// getEnsCachePath returns the path to the file in which ENS resolutions are cached. It is a variable so
+// that tests may keep the cache elsewhere.
+var getEnsCachePath = func(chain string) string {
+	return filepath.Join(config.PathToCache(chain), "names", "names_ens.tab")
+}
+
+// isEnsCacheDisabled returns true during test runs, which keep ENS resolutions in memory only so that
+// they neither depend on nor change the cache on disk
+func isEnsCacheDisabled() bool {
+	return os.Getenv("TEST_MODE") == "true"
+}
+
+var ensCacheHeader = []string{"address", "name", "blockNumber"}
+
+// readEnsCache reads the cached ENS resolutions if they have not already been read. The caller must
+// hold loadedEnsNamesMutex.
+func readEnsCache(chain string) error {
+	if loadedEnsChain == chain {
+		return nil
+	}
+	loadedEnsNames = map[base.Address]ensRecord{}
+	loadedEnsChain = chain
+	if isEnsCacheDisabled() {
+		return nil
+	}
+
+	db, err := os.Open(getEnsCachePath(chain))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer db.Close()
+
+	reader := csv.NewReader(db)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = len(ensCacheHeader)
+	if _, err := reader.Read(); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		addr := base.HexToAddress(record[0])
+		loadedEnsNames[addr] = ensRecord{
+			Address:     addr,
+			Name:        record[1],
+			BlockNumber: utils.MustParseUint(record[2]),
+		}
+	}
+	return nil
+}
+
+// writeEnsCache writes all of the ENS resolutions to the cache, sorted by address. The caller must
+// hold loadedEnsNamesMutex.
+func writeEnsCache(chain string) error {
+	if isEnsCacheDisabled() {
+		return nil
+	}
+
+	cachePath := getEnsCachePath(chain)
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return err
+	}
+
+	records := make([]ensRecord, 0, len(loadedEnsNames))
+	for _, record := range loadedEnsNames {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Address.Hex() < records[j].Address.Hex()
+	})
+
+	tmpPath := cachePath + ".tmp"
+	db, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(db)
+	writer.Comma = '\t'
+	_ = writer.Write(ensCacheHeader)
+	for _, record := range records {
+		_ = writer.Write([]string{record.Address.Hex(), record.Name, strconv.FormatUint(record.BlockNumber, 10)})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		db.Close()
+		return err
+	}
+	if err := db.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, cachePath)
+}
@@ -0,0 +1,69 @@
+package names
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func TestEnsCache(t *testing.T) {
+	t.Setenv("TEST_MODE", "false")
+	cachePath := filepath.Join(t.TempDir(), "names_ens.tab")
+	defer func(saved func(string) string) { getEnsCachePath = saved }(getEnsCachePath)
+	getEnsCachePath = func(string) string { return cachePath }
+
+	named := base.HexToAddress("0xd8da6bf26964af9d7eed9e03e53415d37aa96045")
+	unnamed := base.HexToAddress("0x000000000000000000000000000000000000dead")
+
+	loadedEnsNamesMutex.Lock()
+	loadedEnsChain = "mainnet"
+	loadedEnsNames = map[base.Address]ensRecord{
+		named:   {Address: named, Name: "vitalik.eth", BlockNumber: 18000000},
+		unnamed: {Address: unnamed, BlockNumber: 18000000},
+	}
+	err := writeEnsCache("mainnet")
+	loadedEnsNamesMutex.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ClearCache()
+
+	namesMap := map[base.Address]types.SimpleName{}
+	if err := loadEnsMap("mainnet", []string{}, Ens, &namesMap); err != nil {
+		t.Fatal(err)
+	}
+	if len(namesMap) != 1 {
+		t.Fatal("expected one name, got", len(namesMap))
+	}
+	if name := namesMap[named]; name.Name != "vitalik.eth" || name.Tags != EnsTag {
+		t.Error("unexpected name", name)
+	}
+
+	loadedEnsNamesMutex.Lock()
+	record := loadedEnsNames[unnamed]
+	loadedEnsNamesMutex.Unlock()
+	if record.BlockNumber != 18000000 || len(record.Name) != 0 {
+		t.Error("expected the unnamed address to be cached", record)
+	}
+}
+
+func TestEnsRecordIsFresh(t *testing.T) {
+	record := ensRecord{BlockNumber: 1000}
+	tests := []struct {
+		bn       base.Blknum
+		expected bool
+	}{
+		{999, false},
+		{1000, true},
+		{1000 + EnsStaleBlocks - 1, true},
+		{1000 + EnsStaleBlocks, false},
+	}
+	for _, test := range tests {
+		if got := record.isFresh(test.bn); got != test.expected {
+			t.Error("isFresh", test.bn, "expected", test.expected, "got", got)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package names
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// The operations recorded in the custom names change log
+const (
+	OpCreate   = "create"
+	OpUpdate   = "update"
+	OpDelete   = "delete"
+	OpUndelete = "undelete"
+	OpRemove   = "remove"
+	OpRollback = "rollback"
+)
+
+// NameChange is a single change to the custom names database. Old is nil for a newly created name and
+// New is nil for a removed name.
+type NameChange struct {
+	Timestamp base.Timestamp    `json:"timestamp"`
+	Operation string            `json:"operation"`
+	User      string            `json:"user"`
+	Address   base.Address      `json:"address"`
+	Old       *types.SimpleName `json:"old,omitempty"`
+	New       *types.SimpleName `json:"new,omitempty"`
+}
+
+// pendingCustomChanges are changes made in memory by UpdateName which are logged only when the custom
+// names are written (and not at all if they are written to a dry run). Guarded by loadedCustomNamesMutex.
+var pendingCustomChanges []NameChange
+
+// getHistoryPath returns the path to the change log of the custom names database (which is kept next
+// to the database)
+func getHistoryPath(chain string) string {
+	return strings.TrimSuffix(getDatabasePath(chain, DatabaseCustom), ".tab") + "_history.json"
+}
+
+// newChange returns a change to the name at the address made now by the current user
+func newChange(operation string, address base.Address, before, after *types.SimpleName) NameChange {
+	change := NameChange{
+		Timestamp: time.Now().Unix(),
+		Operation: operation,
+		User:      currentUser(),
+		Address:   address,
+	}
+	if before != nil {
+		old := *before
+		change.Old = &old
+	}
+	if after != nil {
+		updated := *after
+		change.New = &updated
+	}
+	return change
+}
+
+// currentUser returns the name of the user making changes (TB_NAMES_USER, if set, or the login name)
+func currentUser() string {
+	if name := os.Getenv("TB_NAMES_USER"); len(name) > 0 {
+		return name
+	}
+	if u, err := user.Current(); err == nil && len(u.Username) > 0 {
+		return u.Username
+	}
+	return "unknown"
+}
+
+// appendChanges appends the changes (one JSON object per line) to the change log
+func appendChanges(chain string, changes ...NameChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	historyPath := getHistoryPath(chain)
+	if err := os.MkdirAll(filepath.Dir(historyPath), 0755); err != nil {
+		return err
+	}
+	log, err := os.OpenFile(historyPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+
+	if err = file.Lock(log); err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Unlock(log)
+	}()
+
+	encoder := json.NewEncoder(log)
+	for _, change := range changes {
+		if err = encoder.Encode(&change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadHistory returns the logged changes to the custom names database, oldest first. If any addresses are
+// given, only the changes to those addresses are returned.
+func ReadHistory(chain string, addrs []base.Address) ([]NameChange, error) {
+	wanted := make(map[base.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		wanted[addr] = true
+	}
+
+	ret := []NameChange{}
+	log, err := os.Open(getHistoryPath(chain))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ret, nil
+		}
+		return nil, err
+	}
+	defer log.Close()
+
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var change NameChange
+		if err := json.Unmarshal(line, &change); err != nil {
+			return nil, err
+		}
+		if len(wanted) == 0 || wanted[change.Address] {
+			ret = append(ret, change)
+		}
+	}
+	return ret, scanner.Err()
+}
+
+// Rollback restores the custom names database to its state at the given time by undoing every logged
+// change made after that time. The changes made by the rollback are themselves logged (so a rollback may
+// be rolled back) and returned.
+func Rollback(chain string, ts base.Timestamp) ([]NameChange, error) {
+	if err := loadCustomMap(chain, nil, Custom, &map[base.Address]types.SimpleName{}); err != nil {
+		return nil, err
+	}
+
+	history, err := ReadHistory(chain, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The state of each address at the given time is the old record of its first change after that time
+	order := []base.Address{}
+	targets := map[base.Address]*types.SimpleName{}
+	for _, change := range history {
+		if change.Timestamp <= ts {
+			continue
+		}
+		if _, ok := targets[change.Address]; !ok {
+			targets[change.Address] = change.Old
+			order = append(order, change.Address)
+		}
+	}
+
+	loadedCustomNamesMutex.Lock()
+	defer loadedCustomNamesMutex.Unlock()
+
+	changes := []NameChange{}
+	for _, address := range order {
+		target := targets[address]
+		current, exists := loadedCustomNames[address]
+		switch {
+		case target == nil && !exists:
+			continue
+		case target == nil:
+			delete(loadedCustomNames, address)
+			changes = append(changes, newChange(OpRollback, address, &current, nil))
+		case exists && sameName(&current, target):
+			continue
+		default:
+			target.IsCustom = true
+			loadedCustomNames[address] = *target
+			if exists {
+				changes = append(changes, newChange(OpRollback, address, &current, target))
+			} else {
+				changes = append(changes, newChange(OpRollback, address, nil, target))
+			}
+		}
+	}
+
+	if len(changes) == 0 {
+		return changes, nil
+	}
+	loadedCustomIndex = nil
+
+	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_WRONLY|os.O_TRUNC)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	if err = writeCustomNames(db); err != nil {
+		return nil, err
+	}
+	return changes, appendChanges(chain, changes...)
+}
+
+// sameName returns true if the two names would be stored identically in the database
+func sameName(a, b *types.SimpleName) bool {
+	return a.Tags == b.Tags &&
+		a.Address == b.Address &&
+		a.Name == b.Name &&
+		a.Symbol == b.Symbol &&
+		a.Source == b.Source &&
+		a.Decimals == b.Decimals &&
+		a.Petname == b.Petname &&
+		a.Deleted == b.Deleted &&
+		a.IsCustom == b.IsCustom &&
+		a.IsPrefund == b.IsPrefund &&
+		a.IsContract == b.IsContract &&
+		a.IsErc20 == b.IsErc20 &&
+		a.IsErc721 == b.IsErc721
+}
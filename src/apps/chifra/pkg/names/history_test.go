@@ -0,0 +1,100 @@
+package names
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+func TestHistoryAndRollback(t *testing.T) {
+	t.Setenv("TEST_MODE", "true")
+	chain := utils.GetTestChain()
+	defer func() {
+		ClearCache()
+		_ = os.RemoveAll(path.Join(os.TempDir(), "trueblocks"))
+	}()
+	ClearCache()
+	_ = os.Remove(getHistoryPath(chain))
+
+	addr := base.HexToAddress("0x1f9090aae28b8a3dceadf281b0f12828e676c326")
+	other := base.HexToAddress("0x000000000000541e251335090ac5b47176af4f7e")
+	if err := CreateName(DatabaseCustom, chain, &types.SimpleName{Address: addr, Name: "first name"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := CreateName(DatabaseCustom, chain, &types.SimpleName{Address: addr, Name: "second name"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SetDeleted(DatabaseCustom, chain, addr, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := CreateName(DatabaseCustom, chain, &types.SimpleName{Address: other, Name: "other name"}); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := ReadHistory(chain, []base.Address{addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{OpCreate, OpUpdate, OpDelete}
+	if len(history) != len(expected) {
+		t.Fatal("expected", len(expected), "changes, got", len(history))
+	}
+	for i, change := range history {
+		if change.Operation != expected[i] {
+			t.Error("change", i, "expected", expected[i], "got", change.Operation)
+		}
+	}
+	if history[0].Old != nil || history[0].New.Name != "first name" {
+		t.Error("unexpected create", history[0])
+	}
+	if history[1].Old.Name != "first name" || history[1].New.Name != "second name" {
+		t.Error("unexpected update", history[1])
+	}
+	if history[2].Old.Deleted || !history[2].New.Deleted {
+		t.Error("unexpected delete", history[2])
+	}
+
+	// Spread the changes out in time so we can roll back to between them
+	all, err := ReadHistory(chain, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range all {
+		all[i].Timestamp = base.Timestamp(100 * (i + 1))
+	}
+	_ = os.Remove(getHistoryPath(chain))
+	if err := appendChanges(chain, all...); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := Rollback(chain, 150)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Fatal("expected two changes, got", len(changes))
+	}
+	if name := loadedCustomNames[addr]; name.Name != "first name" || name.Deleted {
+		t.Error("rollback did not restore the name", name)
+	}
+	if _, ok := loadedCustomNames[other]; ok {
+		t.Error("rollback did not remove the later name")
+	}
+
+	// The rollback is logged, and the database on disk is restored
+	history, _ = ReadHistory(chain, []base.Address{addr})
+	if last := history[len(history)-1]; last.Operation != OpRollback || last.New.Name != "first name" {
+		t.Error("rollback was not logged", last)
+	}
+	ClearCache()
+	if _, err := LoadNamesMap(chain, Custom, nil); err != nil {
+		t.Fatal(err)
+	}
+	if name := ReadName(DatabaseCustom, chain, addr); name == nil || name.Name != "first name" {
+		t.Error("rollback was not written", name)
+	}
+}
@@ -0,0 +1,276 @@
+package names
+
+import (
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// nameIndex is an inverted index from trigrams to the names whose fields (other than the address)
+// contain them. It holds both the trigrams of each (lower cased) field and the padded trigrams of each
+// word of each field, so it can find candidates for substring, prefix, and fuzzy terms. Candidates are
+// always checked against the query, so the index need only never miss a name.
+type nameIndex struct {
+	Addresses []string            // the addresses of the indexed names (a name's id is its position)
+	Grams     map[string][]uint32 // for each trigram, the sorted ids of the names that contain it
+}
+
+// indexVersion is incremented whenever the format of the persisted index changes
+const indexVersion = 1
+
+// indexFile is the persisted form of an index along with what is needed to know it is current
+type indexFile struct {
+	Version uint64
+	Size    int64
+	ModTime int64
+	Index   nameIndex
+}
+
+// buildIndex builds an index of the names
+func buildIndex(names map[base.Address]types.SimpleName) *nameIndex {
+	addrs := make([]base.Address, 0, len(names))
+	for addr := range names {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Hex() < addrs[j].Hex()
+	})
+
+	idx := &nameIndex{
+		Addresses: make([]string, 0, len(addrs)),
+		Grams:     make(map[string][]uint32),
+	}
+	for id, addr := range addrs {
+		name := names[addr]
+		idx.Addresses = append(idx.Addresses, addr.Hex())
+		for _, gram := range nameGrams(&name) {
+			idx.Grams[gram] = append(idx.Grams[gram], uint32(id))
+		}
+	}
+	return idx
+}
+
+// nameGrams returns the distinct trigrams of the name's fields and of the words in those fields
+func nameGrams(name *types.SimpleName) []string {
+	seen := map[string]bool{}
+	ret := []string{}
+	add := func(grams []string) {
+		for _, gram := range grams {
+			if !seen[gram] {
+				seen[gram] = true
+				ret = append(ret, gram)
+			}
+		}
+	}
+	for _, value := range []string{name.Name, name.Symbol, name.Tags, name.Source, name.Petname} {
+		value = strings.ToLower(value)
+		add(trigrams(value))
+		for _, word := range splitWords(value) {
+			add(wordGrams(word))
+		}
+	}
+	return ret
+}
+
+// candidates returns the addresses of the names that may match the query. If none of the query's terms
+// can be narrowed with the index, it returns false and every name must be checked.
+func (idx *nameIndex) candidates(q *query) ([]base.Address, bool) {
+	var ids []uint32
+	narrowed := false
+	for i := range q.terms {
+		termIds, ok := idx.termCandidates(&q.terms[i])
+		if !ok {
+			continue
+		}
+		if !narrowed {
+			ids = termIds
+			narrowed = true
+		} else {
+			ids = intersect(ids, termIds)
+		}
+		if len(ids) == 0 {
+			break
+		}
+	}
+	if !narrowed {
+		return nil, false
+	}
+
+	ret := make([]base.Address, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, base.HexToAddress(idx.Addresses[id]))
+	}
+	return ret, true
+}
+
+// termCandidates returns the ids of the names that may match the term, or false if the index cannot
+// narrow the search for this term
+func (idx *nameIndex) termCandidates(t *queryTerm) ([]uint32, bool) {
+	switch {
+	case t.field == fieldAddress:
+		return nil, false
+
+	case t.fuzzy:
+		grams := wordGrams(t.text)
+		if len(grams) == 0 {
+			return nil, false
+		}
+		// A word whose similarity to the term is at least FuzzyThreshold shares at least this many trigrams
+		need := int(FuzzyThreshold*float64(len(grams)) + 0.999999)
+		if need < 1 {
+			need = 1
+		}
+		counts := map[uint32]int{}
+		for _, gram := range grams {
+			for _, id := range idx.Grams[gram] {
+				counts[id]++
+			}
+		}
+		ret := make([]uint32, 0, len(counts))
+		for id, count := range counts {
+			if count >= need {
+				ret = append(ret, id)
+			}
+		}
+		sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+		return ret, true
+
+	case t.prefix:
+		// The start of a word is represented by its padded trigrams
+		return idx.allOf(trigrams("  " + strings.ToLower(t.text)))
+
+	case len(t.literal) >= 3 && !strings.Contains(t.literal, "\t"):
+		if t.field == fieldAny && isHexLike(t.literal) {
+			// The literal may match (part of) an address, which is not indexed
+			return nil, false
+		}
+		return idx.allOf(trigrams(t.literal))
+	}
+	return nil, false
+}
+
+// allOf returns the ids of the names that contain all of the trigrams
+func (idx *nameIndex) allOf(grams []string) ([]uint32, bool) {
+	if len(grams) == 0 {
+		return nil, false
+	}
+	ret := idx.Grams[grams[0]]
+	for _, gram := range grams[1:] {
+		if len(ret) == 0 {
+			break
+		}
+		ret = intersect(ret, idx.Grams[gram])
+	}
+	return ret, true
+}
+
+// intersect returns the ids in both of the sorted lists
+func intersect(a, b []uint32) []uint32 {
+	ret := make([]uint32, 0, len(a))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			ret = append(ret, a[i])
+			i++
+			j++
+		}
+	}
+	return ret
+}
+
+func isHexLike(s string) bool {
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefx", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// searchNames adds the names that match the query to the names map, using the index (if given) to
+// avoid checking every name
+func searchNames(names map[base.Address]types.SimpleName, idx *nameIndex, q *query, namesMap *map[base.Address]types.SimpleName) {
+	if len(q.terms) == 0 {
+		for addr, name := range names {
+			(*namesMap)[addr] = name
+		}
+		return
+	}
+
+	if idx != nil {
+		if addrs, ok := idx.candidates(q); ok {
+			for _, addr := range addrs {
+				if name, ok := names[addr]; ok {
+					if matched, _ := q.match(&name); matched {
+						(*namesMap)[addr] = name
+					}
+				}
+			}
+			return
+		}
+	}
+
+	for addr, name := range names {
+		name := name
+		if matched, _ := q.match(&name); matched {
+			(*namesMap)[addr] = name
+		}
+	}
+}
+
+// getIndexPath returns the path to the persisted index of the names database
+func getIndexPath(dbPath string) string {
+	return strings.TrimSuffix(dbPath, filepath.Ext(dbPath)) + ".idx"
+}
+
+// loadIndex returns the index of the names which were loaded from the database at dbPath. The index is
+// read from alongside the database if it was built from the database's current contents. Otherwise, it
+// is built and (if possible) persisted.
+func loadIndex(dbPath string, names map[base.Address]types.SimpleName) *nameIndex {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return buildIndex(names)
+	}
+
+	indexPath := getIndexPath(dbPath)
+	if f, err := os.Open(indexPath); err == nil {
+		var persisted indexFile
+		err = gob.NewDecoder(f).Decode(&persisted)
+		f.Close()
+		if err == nil &&
+			persisted.Version == indexVersion &&
+			persisted.Size == info.Size() &&
+			persisted.ModTime == info.ModTime().UnixNano() &&
+			len(persisted.Index.Addresses) == len(names) {
+			return &persisted.Index
+		}
+	}
+
+	idx := buildIndex(names)
+	persisted := indexFile{
+		Version: indexVersion,
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		Index:   *idx,
+	}
+	tmpPath := indexPath + ".tmp"
+	if f, err := os.Create(tmpPath); err == nil {
+		err = gob.NewEncoder(f).Encode(&persisted)
+		f.Close()
+		if err == nil {
+			_ = os.Rename(tmpPath, indexPath)
+		} else {
+			_ = os.Remove(tmpPath)
+		}
+	}
+	return idx
+}
@@ -30,6 +30,8 @@ const (
 	MatchCase Parts = 0x10
 	Expanded  Parts = 0x20
 	Tags      Parts = 0x40
+	Ens       Parts = 0x80
+	Prefix    Parts = 0x100
 )
 
 type SortBy int
@@ -43,6 +45,7 @@ const (
 	// SortByDecimals
 	SortByTags
 	// SortByPetname
+	SortByRank
 )
 
 // LoadNamesArray loads the names from the cache and returns an array of names
@@ -61,8 +64,28 @@ func LoadNamesArray(chain string, parts Parts, sortBy SortBy, terms []string) ([
 		}
 	}
 
+	var scores map[base.Address]float64
+	if sortBy == SortByRank {
+		if q := compileQuery(terms, parts); q.ranked {
+			scores = make(map[base.Address]float64, len(names))
+			for i := range names {
+				_, scores[names[i].Address] = q.match(&names[i])
+			}
+		} else {
+			// Only fuzzy and prefix searches are ranked. Other searches keep the address order.
+			sortBy = SortByAddress
+		}
+	}
+
 	sort.Slice(names, func(i, j int) bool {
 		switch sortBy {
+		case SortByRank:
+			// Best matches first (names that match equally well are sorted by address)
+			si, sj := scores[names[i].Address], scores[names[j].Address]
+			if si != sj {
+				return si > sj
+			}
+			return names[i].Address.Hex() < names[j].Address.Hex()
 		case SortByName:
 			return names[i].Name < names[j].Name
 		case SortByTags:
@@ -101,6 +124,11 @@ func LoadNamesMap(chain string, parts Parts, terms []string) (map[base.Address]t
 		}
 	}
 
+	// Load the cached ENS names next (so that regular and custom names overwrite them)
+	if parts&Ens != 0 {
+		_ = loadEnsMap(chain, terms, parts, &namesMap)
+	}
+
 	if parts&Regular != 0 {
 		namesPath := filepath.Join(config.MustGetPathToChainConfig(chain), "names.tab")
 		_ = loadRegularMap(chain, namesPath, terms, parts, &namesMap)
@@ -122,8 +150,16 @@ func ClearCache() {
 	loadedCustomNamesMutex.Lock()
 	defer loadedCustomNamesMutex.Unlock()
 
+	loadedEnsNamesMutex.Lock()
+	defer loadedEnsNamesMutex.Unlock()
+
 	loadedRegularNames = make(map[base.Address]types.SimpleName)
+	loadedRegularIndex = nil
 	loadedCustomNames = make(map[base.Address]types.SimpleName)
+	loadedCustomIndex = nil
+	pendingCustomChanges = nil
+	loadedEnsNames = make(map[base.Address]ensRecord)
+	loadedEnsChain = ""
 }
 
 var requiredColumns = []string{
@@ -216,12 +252,20 @@ const (
 	DatabaseDryRun  DatabaseType = "<dryrun>"
 )
 
+// getDatabasePath returns the path to the names database of the given kind
+func getDatabasePath(chain string, kind DatabaseType) string {
+	if kind == DatabaseCustom && os.Getenv("TEST_MODE") == "true" {
+		return path.Join(os.TempDir(), "trueblocks", "names_custom.tab")
+	}
+	return filepath.Join(config.MustGetPathToChainConfig(chain), string(kind))
+}
+
 func openDatabaseFile(chain string, kind DatabaseType, openFlag int) (*os.File, error) {
 	if kind == DatabaseDryRun {
 		return os.Stdout, nil
 	}
 
-	filePath := filepath.Join(config.MustGetPathToChainConfig(chain), string(kind))
+	filePath := getDatabasePath(chain, kind)
 	var permissions fs.FileMode = 0666
 
 	if kind == DatabaseCustom && os.Getenv("TEST_MODE") == "true" {
@@ -230,7 +274,6 @@ func openDatabaseFile(chain string, kind DatabaseType, openFlag int) (*os.File,
 			return nil, err
 		}
 
-		filePath = path.Join(os.TempDir(), "trueblocks", "names_custom.tab")
 		openFlag |= os.O_CREATE
 		// On Mac, the permissions must be set to 0777
 		permissions = 0777
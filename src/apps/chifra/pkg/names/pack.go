@@ -0,0 +1,299 @@
+package names
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PackVersion is the version of the name pack format
+const PackVersion = 1
+
+// OpConflict is reported (but not logged) by ImportPack for a name in a pack which differs from an
+// existing custom name that the merge policy kept
+const OpConflict = "conflict"
+
+// NamePack is a shareable set of names. A pack may be signed, in which case the signature covers
+// everything in the pack other than the signer and the signature.
+type NamePack struct {
+	Version   uint64         `json:"version"`
+	Chain     string         `json:"chain"`
+	Created   base.Timestamp `json:"created"`
+	Publisher string         `json:"publisher"`
+	Names     []PackName     `json:"names"`
+	Signer    string         `json:"signer,omitempty"`
+	Signature string         `json:"signature,omitempty"`
+}
+
+// PackName is a name as it is shared in a name pack (only the fields that describe the address)
+type PackName struct {
+	Address    base.Address `json:"address"`
+	Tags       string       `json:"tags"`
+	Name       string       `json:"name"`
+	Symbol     string       `json:"symbol,omitempty"`
+	Source     string       `json:"source,omitempty"`
+	Decimals   uint64       `json:"decimals,omitempty"`
+	IsContract bool         `json:"isContract,omitempty"`
+	IsErc20    bool         `json:"isErc20,omitempty"`
+	IsErc721   bool         `json:"isErc721,omitempty"`
+}
+
+// MergePolicy determines which name is kept when a name in a pack differs from an existing custom name
+type MergePolicy string
+
+const (
+	PolicyMine     MergePolicy = "mine"     // keep the existing name
+	PolicyTheirs   MergePolicy = "theirs"   // take the name from the pack
+	PolicyPriority MergePolicy = "priority" // take the name whose source has the higher priority
+)
+
+// NewNamePack returns an unsigned pack of the (non-deleted) names sorted by address
+func NewNamePack(chain string, names []types.SimpleName) *NamePack {
+	pack := &NamePack{
+		Version:   PackVersion,
+		Chain:     chain,
+		Created:   time.Now().Unix(),
+		Publisher: currentUser(),
+		Names:     make([]PackName, 0, len(names)),
+	}
+	for _, name := range names {
+		if name.Deleted {
+			continue
+		}
+		pack.Names = append(pack.Names, PackName{
+			Address:    name.Address,
+			Tags:       name.Tags,
+			Name:       name.Name,
+			Symbol:     name.Symbol,
+			Source:     name.Source,
+			Decimals:   name.Decimals,
+			IsContract: name.IsContract,
+			IsErc20:    name.IsErc20,
+			IsErc721:   name.IsErc721,
+		})
+	}
+	sort.Slice(pack.Names, func(i, j int) bool {
+		return pack.Names[i].Address.Hex() < pack.Names[j].Address.Hex()
+	})
+	return pack
+}
+
+// GetSigningKey returns the key with which name packs are signed (from TB_NAMES_SIGNINGKEY) or nil if
+// there is no such key
+func GetSigningKey() (*ecdsa.PrivateKey, error) {
+	hexKey := strings.TrimPrefix(os.Getenv("TB_NAMES_SIGNINGKEY"), "0x")
+	if len(hexKey) == 0 {
+		return nil, nil
+	}
+	key, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TB_NAMES_SIGNINGKEY: %w", err)
+	}
+	return key, nil
+}
+
+// hash returns the hash of the signed contents of the pack
+func (p *NamePack) hash() ([]byte, error) {
+	contents := *p
+	contents.Signer = ""
+	contents.Signature = ""
+	bytes, err := json.Marshal(&contents)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(bytes), nil
+}
+
+// Sign signs the pack with the key
+func (p *NamePack) Sign(key *ecdsa.PrivateKey) error {
+	hash, err := p.hash()
+	if err != nil {
+		return err
+	}
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		return err
+	}
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	p.Signer = strings.ToLower(signer.Hex())
+	p.Signature = base.Bytes2Hex(sig)
+	return nil
+}
+
+// Verify returns true if the pack is signed by its signer and false if it is not signed. It returns an
+// error if the pack is signed but the signature does not match its contents or its signer.
+func (p *NamePack) Verify() (bool, error) {
+	if len(p.Signature) == 0 && len(p.Signer) == 0 {
+		return false, nil
+	}
+
+	hash, err := p.hash()
+	if err != nil {
+		return false, err
+	}
+	pub, err := crypto.SigToPub(hash, base.Hex2Bytes(strings.TrimPrefix(p.Signature, "0x")))
+	if err != nil {
+		return false, fmt.Errorf("the name pack's signature is invalid: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pub); !strings.EqualFold(recovered.Hex(), p.Signer) {
+		return false, fmt.Errorf("the name pack was not signed by %s (was it modified?)", p.Signer)
+	}
+	return true, nil
+}
+
+// ErrUntrustedPack is returned by VerifyTrusted for a pack that is unsigned or not signed by a trusted signer
+var ErrUntrustedPack = errors.New("the name pack is not signed by a trusted signer")
+
+// VerifyTrusted returns nil if the pack is signed by one of the trusted signers. It returns an error
+// wrapping ErrUntrustedPack if the pack is unsigned or signed by another signer, and the error from
+// Verify if the signature does not match the pack.
+func (p *NamePack) VerifyTrusted(trusted []string) error {
+	signed, err := p.Verify()
+	if err != nil {
+		return err
+	}
+	if !signed {
+		return fmt.Errorf("%w: it is not signed", ErrUntrustedPack)
+	}
+	for _, signer := range trusted {
+		if strings.EqualFold(signer, p.Signer) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: it is signed by %s", ErrUntrustedPack, p.Signer)
+}
+
+// WritePack writes the pack to the file
+func WritePack(path string, pack *NamePack) error {
+	bytes, err := json.MarshalIndent(pack, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bytes, '\n'), 0644)
+}
+
+// ReadPack reads a pack from the file
+func ReadPack(path string) (*NamePack, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var pack NamePack
+	if err := json.Unmarshal(bytes, &pack); err != nil {
+		return nil, fmt.Errorf("%s is not a name pack: %w", path, err)
+	}
+	if pack.Version == 0 || pack.Version > PackVersion {
+		return nil, fmt.Errorf("%s has an unsupported name pack version (%d)", path, pack.Version)
+	}
+	return &pack, nil
+}
+
+// ImportPack merges the names in the pack into the custom names database, resolving names which differ
+// from existing custom names with the policy. With PolicyPriority, priorities lists the sources (highest
+// priority first). It returns the changes (with OpConflict for differing names which were kept). Unless
+// dryRun is true, the changes are made and logged.
+func ImportPack(chain string, pack *NamePack, policy MergePolicy, priorities []string, dryRun bool) ([]NameChange, error) {
+	if pack.Chain != chain {
+		return nil, fmt.Errorf("the name pack is for chain %s, not %s", pack.Chain, chain)
+	}
+	if err := loadCustomMap(chain, nil, Custom, &map[base.Address]types.SimpleName{}); err != nil {
+		return nil, err
+	}
+
+	loadedCustomNamesMutex.Lock()
+	defer loadedCustomNamesMutex.Unlock()
+
+	changes := []NameChange{}
+	for _, theirs := range pack.Names {
+		name := theirs.toName(pack.Publisher)
+		mine, exists := loadedCustomNames[name.Address]
+		switch {
+		case !exists:
+			changes = append(changes, newChange(OpCreate, name.Address, nil, &name))
+		case sameDescription(&mine, &name):
+			continue
+		case policy == PolicyTheirs || (policy == PolicyPriority && sourceRank(name.Source, priorities) < sourceRank(mine.Source, priorities)):
+			name.Deleted = mine.Deleted
+			changes = append(changes, newChange(OpUpdate, name.Address, &mine, &name))
+		default:
+			changes = append(changes, newChange(OpConflict, name.Address, &mine, &name))
+		}
+	}
+
+	if dryRun {
+		return changes, nil
+	}
+
+	applied := make([]NameChange, 0, len(changes))
+	for _, change := range changes {
+		if change.Operation != OpConflict {
+			loadedCustomNames[change.Address] = *change.New
+			applied = append(applied, change)
+		}
+	}
+	if len(applied) == 0 {
+		return changes, nil
+	}
+	loadedCustomIndex = nil
+
+	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_WRONLY|os.O_TRUNC)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	if err = writeCustomNames(db); err != nil {
+		return nil, err
+	}
+	return changes, appendChanges(chain, applied...)
+}
+
+func (p *PackName) toName(publisher string) types.SimpleName {
+	source := p.Source
+	if len(source) == 0 {
+		source = publisher
+	}
+	return types.SimpleName{
+		Address:    p.Address,
+		Tags:       p.Tags,
+		Name:       p.Name,
+		Symbol:     p.Symbol,
+		Source:     source,
+		Decimals:   p.Decimals,
+		Petname:    base.AddrToPetname(p.Address.Hex(), "-"),
+		IsCustom:   true,
+		IsContract: p.IsContract,
+		IsErc20:    p.IsErc20,
+		IsErc721:   p.IsErc721,
+	}
+}
+
+// sameDescription returns true if the two names describe their address identically
+func sameDescription(a, b *types.SimpleName) bool {
+	return a.Tags == b.Tags &&
+		a.Name == b.Name &&
+		a.Symbol == b.Symbol &&
+		a.Source == b.Source &&
+		a.Decimals == b.Decimals
+}
+
+// sourceRank returns the position of the source in the priorities (lower is higher priority). Sources
+// not in the list rank below all of those that are. The priorities are in lower case.
+func sourceRank(source string, priorities []string) int {
+	source = strings.ToLower(source)
+	for i, priority := range priorities {
+		if source == priority {
+			return i
+		}
+	}
+	return len(priorities)
+}
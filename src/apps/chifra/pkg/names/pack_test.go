@@ -0,0 +1,155 @@
+package names
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestPackSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pack := NewNamePack("mainnet", []types.SimpleName{
+		{Address: base.HexToAddress("0x2"), Name: "two"},
+		{Address: base.HexToAddress("0x1"), Name: "one"},
+		{Address: base.HexToAddress("0x3"), Name: "deleted", Deleted: true},
+	})
+	if len(pack.Names) != 2 || pack.Names[0].Name != "one" {
+		t.Fatal("expected the two non-deleted names sorted by address", pack.Names)
+	}
+
+	if signed, err := pack.Verify(); signed || err != nil {
+		t.Error("an unsigned pack should verify as unsigned", signed, err)
+	}
+	if err := pack.VerifyTrusted(nil); !errors.Is(err, ErrUntrustedPack) {
+		t.Error("an unsigned pack should not be trusted", err)
+	}
+
+	if err := pack.Sign(key); err != nil {
+		t.Fatal(err)
+	}
+	file := path.Join(t.TempDir(), "pack.json")
+	if err := WritePack(file, pack); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadPack(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed, err := read.Verify(); !signed || err != nil {
+		t.Error("a signed pack should verify", signed, err)
+	}
+	signer := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	if err := read.VerifyTrusted([]string{signer}); err != nil {
+		t.Error("a pack signed by a trusted signer should be trusted", err)
+	}
+	if err := read.VerifyTrusted([]string{"0xf503017d7baf7fbc0fff7492b751025c6a78179b"}); !errors.Is(err, ErrUntrustedPack) {
+		t.Error("a pack signed by another signer should not be trusted", err)
+	}
+
+	read.Names[0].Name = "tampered"
+	if _, err := read.Verify(); err == nil {
+		t.Error("a modified pack should not verify")
+	}
+	if err := read.VerifyTrusted([]string{signer}); err == nil || errors.Is(err, ErrUntrustedPack) {
+		t.Error("a modified pack should fail verification even if its signer is trusted", err)
+	}
+}
+
+func TestImportPack(t *testing.T) {
+	t.Setenv("TEST_MODE", "true")
+	chain := utils.GetTestChain()
+	defer func() {
+		ClearCache()
+		_ = os.RemoveAll(path.Join(os.TempDir(), "trueblocks"))
+	}()
+	ClearCache()
+	_ = os.Remove(getHistoryPath(chain))
+
+	mine := base.HexToAddress("0x1f9090aae28b8a3dceadf281b0f12828e676c326")
+	curated := base.HexToAddress("0x000000000000541e251335090ac5b47176af4f7e")
+	fresh := base.HexToAddress("0x00000000219ab540356cbb839cbe05303d7705fa")
+	for _, name := range []types.SimpleName{
+		{Address: mine, Name: "my name", Source: "community"},
+		{Address: curated, Name: "curated name", Source: "curated"},
+	} {
+		name := name
+		if err := CreateName(DatabaseCustom, chain, &name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pack := NewNamePack(chain, []types.SimpleName{
+		{Address: mine, Name: "their name", Source: "curated"},
+		{Address: curated, Name: "their curated name", Source: "community"},
+		{Address: fresh, Name: "new name", Source: "community"},
+	})
+
+	priorities := []string{"curated", "community"}
+	operations := func(changes []NameChange) map[base.Address]string {
+		ret := map[base.Address]string{}
+		for _, change := range changes {
+			ret[change.Address] = change.Operation
+		}
+		return ret
+	}
+
+	tests := []struct {
+		policy   MergePolicy
+		expected map[base.Address]string
+	}{
+		{PolicyMine, map[base.Address]string{mine: OpConflict, curated: OpConflict, fresh: OpCreate}},
+		{PolicyTheirs, map[base.Address]string{mine: OpUpdate, curated: OpUpdate, fresh: OpCreate}},
+		{PolicyPriority, map[base.Address]string{mine: OpUpdate, curated: OpConflict, fresh: OpCreate}},
+	}
+	for _, test := range tests {
+		changes, err := ImportPack(chain, pack, test.policy, priorities, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := operations(changes)
+		for addr, op := range test.expected {
+			if got[addr] != op {
+				t.Error(test.policy, addr.Hex(), "expected", op, "got", got[addr])
+			}
+		}
+	}
+
+	// A dry run changes nothing
+	if _, ok := loadedCustomNames[fresh]; ok {
+		t.Error("a dry run should not change the database")
+	}
+
+	if _, err := ImportPack(chain, pack, PolicyPriority, priorities, false); err != nil {
+		t.Fatal(err)
+	}
+	if loadedCustomNames[mine].Name != "their name" || loadedCustomNames[curated].Name != "curated name" || loadedCustomNames[fresh].Name != "new name" {
+		t.Error("the import was not applied according to the policy")
+	}
+	history, _ := ReadHistory(chain, []base.Address{fresh})
+	if len(history) != 1 || history[0].Operation != OpCreate {
+		t.Error("the import was not logged", history)
+	}
+
+	// Importing the same pack again changes nothing
+	changes, err := ImportPack(chain, pack, PolicyTheirs, priorities, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := operations(changes); len(got) != 1 || got[curated] != OpUpdate {
+		t.Error("expected only the kept conflict to change on a second import", got)
+	}
+
+	if _, err := ImportPack("gnosis", pack, PolicyMine, priorities, true); err == nil {
+		t.Error("expected an error importing a pack for another chain")
+	}
+}
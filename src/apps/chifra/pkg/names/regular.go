@@ -12,47 +12,43 @@ import (
 
 // TODO: Test if there's a performance differnce between using an array here (which would work just as well) and a map
 var loadedRegularNames map[base.Address]types.SimpleName = map[base.Address]types.SimpleName{}
+var loadedRegularIndex *nameIndex
 var loadedRegularNamesMutex sync.Mutex
 
 // loadRegularMap loads the regular names from the cache
 func loadRegularMap(chain string, thePath string, terms []string, parts Parts, ret *map[base.Address]types.SimpleName) error {
-	if len(loadedRegularNames) != 0 {
-		// We have already loaded the data
-		for _, name := range loadedRegularNames {
-			if doSearch(&name, terms, parts) {
-				(*ret)[name.Address] = name
-			}
-		}
-		return nil
-	}
-
 	loadedRegularNamesMutex.Lock()
 	defer loadedRegularNamesMutex.Unlock()
 
-	db, err := openDatabaseFile(chain, DatabaseRegular, os.O_RDONLY)
-	if err != nil {
-		return err
-	}
-	defer db.Close()
-
-	reader, err := NewNameReader(db, NameReaderTab)
-	if err != nil {
-		return err
-	}
-
-	for {
-		n, err := reader.Read()
-		if err == io.EOF {
-			break
+	if len(loadedRegularNames) == 0 {
+		db, err := openDatabaseFile(chain, DatabaseRegular, os.O_RDONLY)
+		if err != nil {
+			return err
 		}
+		defer db.Close()
+
+		reader, err := NewNameReader(db, NameReaderTab)
 		if err != nil {
-			logger.Fatal(err)
+			return err
 		}
-		loadedRegularNames[n.Address] = n
-		if doSearch(&n, terms, parts) {
-			(*ret)[n.Address] = n
+
+		for {
+			n, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				logger.Fatal(err)
+			}
+			loadedRegularNames[n.Address] = n
 		}
+		loadedRegularIndex = loadIndex(getDatabasePath(chain, DatabaseRegular), loadedRegularNames)
+
+	} else if loadedRegularIndex == nil || len(loadedRegularIndex.Addresses) != len(loadedRegularNames) {
+		// The names have been edited in memory, so the index is rebuilt (but not persisted)
+		loadedRegularIndex = buildIndex(loadedRegularNames)
 	}
 
+	searchNames(loadedRegularNames, loadedRegularIndex, compileQuery(terms, parts), ret)
 	return nil
 }
@@ -37,5 +37,9 @@ func customRemoveName(chain string, address base.Address) (*types.SimpleName, er
 	loadedCustomNamesMutex.Lock()
 	defer loadedCustomNamesMutex.Unlock()
 	delete(loadedCustomNames, address)
-	return &name, writeCustomNames(db)
+	loadedCustomIndex = nil
+	if err = writeCustomNames(db); err != nil {
+		return nil, err
+	}
+	return &name, appendChanges(chain, newChange(OpRemove, address, &name, nil))
 }
@@ -2,34 +2,261 @@ package names
 
 import (
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
 
+// FuzzyThreshold is the minimum trigram similarity between a fuzzy term (~term) and a word in a name's
+// fields for the name to match
+var FuzzyThreshold = 0.3
+
+type nameField int
+
+const (
+	fieldAny nameField = iota
+	fieldName
+	fieldSymbol
+	fieldAddress
+	fieldTags
+	fieldSource
+	fieldPetname
+)
+
+// queryFields maps the field qualifiers allowed in search terms (e.g. tag:defi) to fields
+var queryFields = map[string]nameField{
+	"name":    fieldName,
+	"symbol":  fieldSymbol,
+	"address": fieldAddress,
+	"addr":    fieldAddress,
+	"tag":     fieldTags,
+	"tags":    fieldTags,
+	"source":  fieldSource,
+	"petname": fieldPetname,
+}
+
+// queryTerm is a single compiled search term. A term may be qualified by a field (field:value), in
+// which case only that field is searched. A term starting with ~ matches fuzzily (by trigram similarity
+// to the words of the searched fields). With Prefix, any other term matches the words starting with it.
+// Otherwise, a term is a (case insensitive, unless MatchCase) regular expression as it always has been.
+type queryTerm struct {
+	field   nameField
+	text    string
+	re      *regexp.Regexp
+	prefix  bool
+	fuzzy   bool
+	literal string // the lower cased text of a regular expression term without special characters
+}
+
+// query is a compiled list of search terms, all of which must match
+type query struct {
+	terms     []queryTerm
+	parts     Parts
+	matchCase bool
+	ranked    bool // true if any of the terms is fuzzy or a prefix
+}
+
+// maxCachedQueries is the number of compiled queries kept before the cache is cleared
+const maxCachedQueries = 256
+
+var queryCache = map[string]*query{}
+var queryCacheMutex sync.Mutex
+
+// compileQuery compiles the search terms. Compiled queries are cached, so that regular expressions are
+// compiled once per query rather than once per record.
+func compileQuery(terms []string, parts Parts) *query {
+	key := strconv.Itoa(int(parts)) + "\x00" + strings.Join(terms, "\x00")
+	queryCacheMutex.Lock()
+	defer queryCacheMutex.Unlock()
+	if q, ok := queryCache[key]; ok {
+		return q
+	}
+
+	q := &query{
+		terms:     make([]queryTerm, 0, len(terms)),
+		parts:     parts,
+		matchCase: parts&MatchCase != 0,
+	}
+	verb := "(?i)"
+	if q.matchCase {
+		verb = ""
+	}
+
+	for _, term := range terms {
+		t := queryTerm{field: fieldAny, text: term}
+		if i := strings.Index(term, ":"); i > 0 {
+			if field, ok := queryFields[strings.ToLower(term[:i])]; ok {
+				t.field = field
+				t.text = term[i+1:]
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(t.text, "~") && len(t.text) > 1:
+			t.fuzzy = true
+			t.text = strings.ToLower(t.text[1:])
+			q.ranked = true
+		case parts&Prefix != 0 && len(t.text) > 0:
+			t.prefix = true
+			q.ranked = true
+			if !q.matchCase {
+				t.text = strings.ToLower(t.text)
+			}
+		default:
+			t.re = regexp.MustCompile(verb + t.text)
+			if regexp.QuoteMeta(t.text) == t.text {
+				t.literal = strings.ToLower(t.text)
+			}
+		}
+		q.terms = append(q.terms, t)
+	}
+
+	if len(queryCache) >= maxCachedQueries {
+		queryCache = map[string]*query{}
+	}
+	queryCache[key] = q
+	return q
+}
+
+// doSearch returns true if the name matches all of the search terms
 func doSearch(name *types.SimpleName, terms []string, parts Parts) bool {
 	if len(terms) == 0 {
 		return true
 	}
+	ok, _ := compileQuery(terms, parts).match(name)
+	return ok
+}
+
+// match returns true if the name matches each of the query's terms and, if so, the name's score. Each
+// regular expression term scores one. A fuzzy term scores the similarity of its best matching word and a
+// prefix term the fraction of its shortest matching word that it covers.
+func (q *query) match(name *types.SimpleName) (bool, float64) {
+	score := 0.0
+	for i := range q.terms {
+		t := &q.terms[i]
+		switch {
+		case t.fuzzy:
+			best := 0.0
+			for _, value := range q.values(name, t.field) {
+				for _, word := range splitWords(strings.ToLower(value)) {
+					if s := similarity(t.text, word); s > best {
+						best = s
+					}
+				}
+			}
+			if best < FuzzyThreshold {
+				return false, 0
+			}
+			score += best
+
+		case t.prefix:
+			best := 0.0
+			for _, value := range q.values(name, t.field) {
+				if !q.matchCase {
+					value = strings.ToLower(value)
+				}
+				for _, word := range splitWords(value) {
+					if strings.HasPrefix(word, t.text) {
+						if s := float64(len(t.text)) / float64(len(word)); s > best {
+							best = s
+						}
+					}
+				}
+			}
+			if best == 0 {
+				return false, 0
+			}
+			score += best
+
+		default:
+			if !t.re.MatchString(strings.Join(q.values(name, t.field), "\t")) {
+				return false, 0
+			}
+			score += 1
+		}
+	}
+	return true, score
+}
 
-	cnt := 0
-	searchStr := name.Name + "\t" + name.Symbol + "\t" + name.Address.Hex() + "\t" + name.Tags
-	if parts&Expanded != 0 {
-		searchStr += "\t" + name.Source + "\t" + name.Petname
+// values returns the values of the fields of the name searched by a term on the given field
+func (q *query) values(name *types.SimpleName, field nameField) []string {
+	switch field {
+	case fieldName:
+		return []string{name.Name}
+	case fieldSymbol:
+		return []string{name.Symbol}
+	case fieldAddress:
+		return []string{name.Address.Hex()}
+	case fieldTags:
+		return []string{name.Tags}
+	case fieldSource:
+		return []string{name.Source}
+	case fieldPetname:
+		return []string{name.Petname}
 	}
-	if parts&Tags != 0 {
+
+	if q.parts&Tags != 0 {
 		// only search tags
-		searchStr = name.Tags
+		return []string{name.Tags}
+	}
+	ret := []string{name.Name, name.Symbol, name.Address.Hex(), name.Tags}
+	if q.parts&Expanded != 0 {
+		ret = append(ret, name.Source, name.Petname)
 	}
+	return ret
+}
 
-	verb := "(?i)"
-	if parts&MatchCase != 0 {
-		verb = "(?)"
+// splitWords splits a string into words made of letters and digits
+func splitWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// wordGrams returns the trigrams of a word padded with two leading spaces and one trailing space (so
+// that short words and the starts of words are represented)
+func wordGrams(word string) []string {
+	return trigrams("  " + word + " ")
+}
+
+// trigrams returns the distinct three character substrings of s
+func trigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 3 {
+		return nil
 	}
-	for _, term := range terms {
-		if regexp.MustCompile(verb + term).MatchString(searchStr) {
-			cnt++
+	seen := make(map[string]bool, len(runes))
+	ret := make([]string, 0, len(runes)-2)
+	for i := 0; i+3 <= len(runes); i++ {
+		gram := string(runes[i : i+3])
+		if !seen[gram] {
+			seen[gram] = true
+			ret = append(ret, gram)
 		}
 	}
+	return ret
+}
 
-	return len(terms) <= cnt
+// similarity returns the trigram similarity (the size of the intersection over the size of the union
+// of the words' padded trigrams) of two lower cased words
+func similarity(a, b string) float64 {
+	gramsA := wordGrams(a)
+	gramsB := wordGrams(b)
+	if len(gramsA) == 0 || len(gramsB) == 0 {
+		return 0
+	}
+	inA := make(map[string]bool, len(gramsA))
+	for _, gram := range gramsA {
+		inA[gram] = true
+	}
+	common := 0
+	for _, gram := range gramsB {
+		if inA[gram] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(gramsA)+len(gramsB)-common)
 }
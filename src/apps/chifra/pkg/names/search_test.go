@@ -0,0 +1,147 @@
+package names
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func readTestNames(t *testing.T) map[base.Address]types.SimpleName {
+	reader, err := NewNameReader(strings.NewReader(inputValid), NameReaderTab)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret := map[base.Address]types.SimpleName{}
+	for {
+		name, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret[name.Address] = name
+	}
+	return ret
+}
+
+func searchResults(names map[base.Address]types.SimpleName, idx *nameIndex, terms []string, parts Parts) []string {
+	found := map[base.Address]types.SimpleName{}
+	searchNames(names, idx, compileQuery(terms, parts), &found)
+	ret := make([]string, 0, len(found))
+	for _, name := range found {
+		ret = append(ret, name.Name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func TestSearch(t *testing.T) {
+	names := readTestNames(t)
+	idx := buildIndex(names)
+
+	tests := []struct {
+		terms    []string
+		parts    Parts
+		expected []string
+	}{
+		{[]string{"gastoken"}, Regular, []string{"Chi Gastoken by 1inch"}},
+		{[]string{"GASTOKEN"}, Regular | MatchCase, []string{}},
+		{[]string{"dead"}, Regular, []string{"ENS: Burn Address"}},
+		{[]string{"0x0000000000001b84"}, Regular, []string{"HomeWork 🏠🛠️"}},
+		{[]string{"on.chain"}, Regular, []string{}},
+		{[]string{"on.chain"}, Regular | Expanded, []string{"Chi Gastoken by 1inch", "HomeWork 🏠🛠️"}},
+		{[]string{"tag:erc"}, Regular, []string{"Chi Gastoken by 1inch", "HomeWork 🏠🛠️"}},
+		{[]string{"tag:erc", "symbol:CHI"}, Regular, []string{"Chi Gastoken by 1inch"}},
+		{[]string{"name:chi", "symbol:hwk"}, Regular, []string{}},
+		{[]string{"source:etherscan"}, Regular, []string{"ENS: Burn Address", "dex.blue"}},
+		{[]string{"addr:541e"}, Regular, []string{"dex.blue"}},
+		{[]string{"petname:bee"}, Regular, []string{"ENS: Burn Address"}},
+		{[]string{"bur"}, Regular | Prefix, []string{"ENS: Burn Address"}},
+		{[]string{"urn"}, Regular | Prefix, []string{}},
+		{[]string{"name:home"}, Regular | Prefix, []string{"HomeWork 🏠🛠️"}},
+		{[]string{"gastoke*"}, Regular, []string{"Chi Gastoken by 1inch"}},
+		{[]string{"~gastokn"}, Regular, []string{"Chi Gastoken by 1inch"}},
+		{[]string{"~homewrk", "tag:erc721"}, Regular, []string{"HomeWork 🏠🛠️"}},
+		{[]string{"~zzzzzz"}, Regular, []string{}},
+	}
+
+	for _, test := range tests {
+		scanned := searchResults(names, nil, test.terms, test.parts)
+		if !reflect.DeepEqual(scanned, test.expected) {
+			t.Errorf("search %v: expected %v, got %v", test.terms, test.expected, scanned)
+		}
+		indexed := searchResults(names, idx, test.terms, test.parts)
+		if !reflect.DeepEqual(indexed, scanned) {
+			t.Errorf("indexed search %v: expected %v, got %v", test.terms, scanned, indexed)
+		}
+	}
+}
+
+func TestSearchRank(t *testing.T) {
+	q := compileQuery([]string{"~blu"}, Regular)
+	if !q.ranked {
+		t.Fatal("fuzzy query is not ranked")
+	}
+
+	exact := types.SimpleName{Name: "dex.blu"}
+	near := types.SimpleName{Name: "dex.blue"}
+	okExact, scoreExact := q.match(&exact)
+	okClose, scoreClose := q.match(&near)
+	if !okExact || !okClose {
+		t.Fatal("expected both names to match", okExact, okClose)
+	}
+	if scoreExact <= scoreClose {
+		t.Error("expected the exact match to rank higher", scoreExact, scoreClose)
+	}
+	if scoreExact != 1 {
+		t.Error("expected the exact match to score one, got", scoreExact)
+	}
+
+	if compileQuery([]string{"blu*"}, Regular).ranked {
+		t.Error("regular expression query is ranked")
+	}
+	q = compileQuery([]string{"blu"}, Regular|Prefix)
+	if !q.ranked {
+		t.Fatal("prefix query is not ranked")
+	}
+	_, scoreExact = q.match(&exact)
+	_, scoreClose = q.match(&near)
+	if scoreExact <= scoreClose {
+		t.Error("expected the whole word to rank higher", scoreExact, scoreClose)
+	}
+}
+
+func TestIndexPersisted(t *testing.T) {
+	names := readTestNames(t)
+	dbPath := filepath.Join(t.TempDir(), "names.tab")
+	if err := os.WriteFile(dbPath, []byte(inputValid), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	built := loadIndex(dbPath, names)
+	if _, err := os.Stat(getIndexPath(dbPath)); err != nil {
+		t.Fatal("index was not persisted", err)
+	}
+
+	read := loadIndex(dbPath, names)
+	if !reflect.DeepEqual(built, read) {
+		t.Error("persisted index differs from the built index")
+	}
+
+	// A changed database invalidates the persisted index
+	delete(names, base.HexToAddress("0x000000000000541e251335090ac5b47176af4f7e"))
+	if err := os.WriteFile(dbPath, []byte(inputValid+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if rebuilt := loadIndex(dbPath, names); len(rebuilt.Addresses) != len(names) {
+		t.Error("expected the index to be rebuilt, got", len(rebuilt.Addresses), "names")
+	}
+}
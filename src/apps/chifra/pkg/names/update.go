@@ -29,7 +29,11 @@ func updateCustomName(chain string, name *types.SimpleName) (err error) {
 		return
 	}
 
+	// The change is logged when the names are written
+	existing := loadedCustomNames[name.Address]
+	pendingCustomChanges = append(pendingCustomChanges, newChange(OpUpdate, name.Address, &existing, name))
 	loadedCustomNames[name.Address] = *name
+	loadedCustomIndex = nil
 	return
 }
 
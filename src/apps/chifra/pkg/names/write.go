@@ -29,12 +29,23 @@ func customWriteNames(chain string, dryRun bool) (err error) {
 		database = DatabaseDryRun
 	}
 
-	return writeDatabase(
+	if err = writeDatabase(
 		chain,
 		Custom,
 		database,
 		loadedCustomNames,
-	)
+	); err != nil {
+		return
+	}
+
+	loadedCustomNamesMutex.Lock()
+	pending := pendingCustomChanges
+	pendingCustomChanges = nil
+	loadedCustomNamesMutex.Unlock()
+	if dryRun {
+		return
+	}
+	return appendChanges(chain, pending...)
 }
 
 func regularWriteNames(chain string, dryRun bool) (err error) {
@@ -110,6 +110,10 @@ func SetWriterForCommand(cmdName string, opts *globals.GlobalOptions) {
 // InitJsonWriterApi inits JsonWriter for API responses
 func InitJsonWriterApi(cmdName string, w io.Writer, opts *globals.GlobalOptions) {
 	_, ok := opts.Writer.(*output.JsonWriter)
+	if _, isModelWriter := opts.Writer.(output.ModelWriter); isModelWriter {
+		// the models are written as they are (for example, to a gRPC stream)
+		return
+	}
 	enabledForCmdsMutex.RLock()
 	defer enabledForCmdsMutex.RUnlock()
 	if enabledForCmds[cmdName] && opts.Format == "json" && !ok {
@@ -127,6 +131,8 @@ func InitJsonWriterApi(cmdName string, w io.Writer, opts *globals.GlobalOptions)
 // CloseJsonWriterIfNeededApi will close JsonWriter if the format is json
 func CloseJsonWriterIfNeededApi(cmdName string, err error, opts *globals.GlobalOptions) {
 	if enabledForCmds[cmdName] && opts.Format == "json" && err == nil {
-		opts.Writer.(*output.JsonWriter).Close()
+		if jw, ok := opts.Writer.(*output.JsonWriter); ok {
+			jw.Close()
+		}
 	}
 }
@@ -40,6 +40,19 @@ type OutputOptions struct {
 	Extra map[string]interface{}
 }
 
+// ModelWriter is implemented by writers which take the models themselves rather than their formatted
+// output (the gRPC server, for example, sends each model as a message)
+type ModelWriter interface {
+	io.Writer
+	WriteModel(model types.Model) error
+	WriteError(err error)
+}
+
+// errorWriter collects the errors reported while streaming JSON
+type errorWriter interface {
+	WriteError(err error)
+}
+
 var formatToSeparator = map[string]rune{
 	"csv": ',',
 	"txt": '\t',
@@ -52,6 +65,10 @@ func StreamWithTemplate(w io.Writer, model types.Model, tmpl *template.Template)
 
 // StreamModel streams a single `Model`
 func StreamModel(w io.Writer, model types.Model, options OutputOptions) error {
+	if mw, ok := w.(ModelWriter); ok {
+		return mw.WriteModel(model)
+	}
+
 	if options.Format == "json" {
 		jw, ok := w.(*JsonWriter)
 		if !ok {
@@ -132,7 +149,7 @@ func StreamMany[Raw types.RawData](ctx context.Context, fetchData fetchDataFunc[
 	}()
 
 	isJson := options.Format == "json" || options.ShowRaw
-	var jw *JsonWriter
+	var jw errorWriter
 	if !isJson {
 		defer func() {
 			if len(errsToReport) == 0 {
@@ -140,6 +157,8 @@ func StreamMany[Raw types.RawData](ctx context.Context, fetchData fetchDataFunc[
 			}
 			logErrors(errsToReport)
 		}()
+	} else if mw, ok := options.Writer.(ModelWriter); ok {
+		jw = mw
 	} else {
 		// If this hits, perhaps you've not added an entry to enabledForCmds
 		jw = options.Writer.(*JsonWriter)
@@ -177,6 +196,8 @@ func StreamMany[Raw types.RawData](ctx context.Context, fetchData fetchDataFunc[
 				}
 			}
 			if err != nil {
+				// the writer failed (the client went away, for example), so let the fetcher finish
+				drain(modelChan, errorChan)
 				return err
 			}
 			first = false
@@ -194,6 +215,7 @@ func StreamMany[Raw types.RawData](ctx context.Context, fetchData fetchDataFunc[
 			errsMutex.Unlock()
 
 		case <-ctx.Done():
+			drain(modelChan, errorChan)
 			err = ctx.Err()
 			if err == context.Canceled {
 				return nil
@@ -202,3 +224,16 @@ func StreamMany[Raw types.RawData](ctx context.Context, fetchData fetchDataFunc[
 		}
 	}
 }
+
+// drain lets the fetcher finish once StreamMany stops reading its channels. The models and errors it
+// still sends are dropped.
+func drain[Raw types.RawData](modelChan chan types.Modeler[Raw], errorChan chan error) {
+	go func() {
+		for range modelChan {
+		}
+	}()
+	go func() {
+		for range errorChan {
+		}
+	}()
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
@@ -193,6 +195,36 @@ func TestStreamMany(t *testing.T) {
 	}
 }
 
+type failingWriter struct{}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestStreamManyWriteError(t *testing.T) {
+	done := make(chan bool)
+	fetchData := func(models chan types.Modeler[types.RawReceipt], errorChan chan error) {
+		for i := 0; i < 3; i++ {
+			models <- &types.SimpleReceipt{BlockNumber: uint64(i)}
+		}
+		errorChan <- errors.New("not reported")
+		close(done)
+	}
+
+	err := StreamMany(context.Background(), fetchData, OutputOptions{
+		Writer: failingWriter{},
+		Format: "csv",
+	})
+	if err == nil {
+		t.Error("expected the write error")
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Error("the fetcher was left blocked after the write failed")
+	}
+}
+
 func TestApiFormat(t *testing.T) {
 	outputBuffer := &bytes.Buffer{}
 	renderData := func(models chan types.Modeler[types.RawReceipt], errorChan chan error) {
@@ -5,9 +5,13 @@
 package rpc
 
 import (
+	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	ensGo "github.com/wealdtech/go-ens/v3"
 )
 
@@ -80,3 +84,202 @@ func IsSame(a string, b string) bool {
 	ensAddr, _ := unused.GetEnsAddress(b)
 	return ensAddr == a
 }
+
+// EnsRegistry is the address of the ENS registry (the same on mainnet and its testnets)
+var EnsRegistry = base.HexToAddress("0x00000000000c2e074ec69a0dfb2997ba6c7d2e1e")
+
+var (
+	ensResolverSelector = base.Hex2Bytes("0178b8bf") // resolver(bytes32)
+	ensNameSelector     = base.Hex2Bytes("691f3431") // name(bytes32)
+	ensAddrSelector     = base.Hex2Bytes("3b3b57de") // addr(bytes32)
+)
+
+var ensMutex sync.Mutex
+var ensChains = map[string]bool{}
+
+// EnsConnection returns a connection to a chain on which the ENS registry is deployed. If the registry is
+// deployed on the connection's chain, that chain is used. Otherwise, we use mainnet. The second return
+// value is true if the returned connection is to the connection's own chain.
+func (conn *Connection) EnsConnection() (*Connection, bool) {
+	if conn.Chain == "mainnet" {
+		return conn, true
+	}
+
+	ensMutex.Lock()
+	hasEns, checked := ensChains[conn.Chain]
+	ensMutex.Unlock()
+	if !checked {
+		code, err := conn.GetContractCodeAt(EnsRegistry, conn.GetLatestBlockNumber())
+		hasEns = err == nil && len(code) > 0
+		ensMutex.Lock()
+		ensChains[conn.Chain] = hasEns
+		ensMutex.Unlock()
+	}
+
+	if hasEns {
+		return conn, true
+	}
+	return TempConnection("mainnet"), false
+}
+
+// GetEnsName returns the primary ENS name of the address at the given block. The name is returned only if
+// it resolves (forward) back to the address.
+func (conn *Connection) GetEnsName(addr base.Address, bn base.Blknum) (string, bool) {
+	if addr.IsZero() {
+		return "", false
+	}
+	if names, err := conn.GetEnsNames([]base.Address{addr}, bn); err == nil {
+		if name, ok := names[addr]; ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// GetEnsNames returns the primary ENS names of each of the addresses at the given block. Only addresses
+// whose reverse record is set and whose name resolves (forward) back to the address are included in the
+// returned map. The lookups are made in four rounds (reverse resolver, name, forward resolver, address),
+// each of which is sent as a single batch with Multicall. If the ENS registry is not deployed on the
+// connection's chain, the names are resolved on mainnet at its latest block.
+func (conn *Connection) GetEnsNames(addrs []base.Address, bn base.Blknum) (map[base.Address]string, error) {
+	ret := make(map[base.Address]string, len(addrs))
+	if len(addrs) == 0 {
+		return ret, nil
+	}
+
+	ensConn, sameChain := conn.EnsConnection()
+	if !sameChain {
+		bn = ensConn.GetLatestBlockNumber()
+	}
+
+	// Round one: the resolvers of each address's reverse node
+	reverseNodes := make([][32]byte, 0, len(addrs))
+	for _, addr := range addrs {
+		node, err := ensGo.NameHash(ReverseNode(addr))
+		if err != nil {
+			return nil, err
+		}
+		reverseNodes = append(reverseNodes, node)
+	}
+	reverseResolvers, err := ensConn.ensResolvers(reverseNodes, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round two: the names recorded by those resolvers
+	requests := make([]MulticallRequest, 0, len(addrs))
+	indices := make([]int, 0, len(addrs))
+	for i := range addrs {
+		if !reverseResolvers[i].IsZero() {
+			requests = append(requests, MulticallRequest{Target: reverseResolvers[i], Data: packEnsCall(ensNameSelector, reverseNodes[i])})
+			indices = append(indices, i)
+		}
+	}
+	results, err := ensConn.Multicall(requests, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[int]string, len(indices))
+	forwardNodes := make([][32]byte, 0, len(indices))
+	forwardIndices := make([]int, 0, len(indices))
+	for j, i := range indices {
+		if !results[j].Success {
+			continue
+		}
+		name, err := unpackEnsString(results[j].ReturnData)
+		if err != nil || len(name) == 0 {
+			continue
+		}
+		if normalized, err := ensGo.Normalize(name); err != nil || normalized != name {
+			continue
+		}
+		node, err := ensGo.NameHash(name)
+		if err != nil {
+			continue
+		}
+		candidates[i] = name
+		forwardNodes = append(forwardNodes, node)
+		forwardIndices = append(forwardIndices, i)
+	}
+
+	// Round three: the resolvers of each name
+	forwardResolvers, err := ensConn.ensResolvers(forwardNodes, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round four: the addresses to which the names resolve (which must be the original addresses)
+	requests = requests[:0]
+	indices = indices[:0]
+	for j, i := range forwardIndices {
+		if !forwardResolvers[j].IsZero() {
+			requests = append(requests, MulticallRequest{Target: forwardResolvers[j], Data: packEnsCall(ensAddrSelector, forwardNodes[j])})
+			indices = append(indices, i)
+		}
+	}
+	if results, err = ensConn.Multicall(requests, bn); err != nil {
+		return nil, err
+	}
+	for j, i := range indices {
+		if results[j].Success && len(results[j].ReturnData) >= 32 {
+			if base.BytesToAddress(results[j].ReturnData[12:32]) == addrs[i] {
+				ret[addrs[i]] = candidates[i]
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// ReverseNode returns the ENS name of the reverse record of the address (i.e. <addr>.addr.reverse)
+func ReverseNode(addr base.Address) string {
+	return strings.TrimPrefix(addr.Hex(), "0x") + ".addr.reverse"
+}
+
+// ensResolvers returns the resolver recorded in the ENS registry for each of the nodes
+func (conn *Connection) ensResolvers(nodes [][32]byte, bn base.Blknum) ([]base.Address, error) {
+	requests := make([]MulticallRequest, 0, len(nodes))
+	for _, node := range nodes {
+		requests = append(requests, MulticallRequest{Target: EnsRegistry, Data: packEnsCall(ensResolverSelector, node)})
+	}
+	results, err := conn.Multicall(requests, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]base.Address, len(nodes))
+	for i, result := range results {
+		if result.Success && len(result.ReturnData) >= 32 {
+			ret[i] = base.BytesToAddress(result.ReturnData[12:32])
+		}
+	}
+	return ret, nil
+}
+
+// packEnsCall returns the call data of a function taking a single bytes32 (a node)
+func packEnsCall(selector []byte, node [32]byte) []byte {
+	data := make([]byte, 0, len(selector)+len(node))
+	data = append(data, selector...)
+	return append(data, node[:]...)
+}
+
+var ensStringArgs = func() abi.Arguments {
+	t, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: t}}
+}()
+
+// unpackEnsString decodes the string returned by a resolver's name function
+func unpackEnsString(data []byte) (string, error) {
+	unpacked, err := ensStringArgs.Unpack(data)
+	if err != nil {
+		return "", err
+	}
+	if len(unpacked) != 1 {
+		return "", fmt.Errorf("expected one value, got %d", len(unpacked))
+	}
+	return unpacked[0].(string), nil
+}
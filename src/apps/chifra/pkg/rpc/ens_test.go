@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func TestReverseNode(t *testing.T) {
+	addr := base.HexToAddress("0xD8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	expected := "d8da6bf26964af9d7eed9e03e53415d37aa96045.addr.reverse"
+	if got := ReverseNode(addr); got != expected {
+		t.Error("expected", expected, "got", got)
+	}
+}
+
+func TestUnpackEnsString(t *testing.T) {
+	// The ABI encoding of the string "vitalik.eth"
+	data := base.Hex2Bytes("0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000b" +
+		"766974616c696b2e657468000000000000000000000000000000000000000000")
+	name, err := unpackEnsString(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "vitalik.eth" {
+		t.Error("expected vitalik.eth, got", name)
+	}
+
+	if _, err := unpackEnsString([]byte{0x01}); err == nil {
+		t.Error("expected an error for invalid data")
+	}
+}
+
+func TestPackEnsCall(t *testing.T) {
+	var node [32]byte
+	node[31] = 0x01
+	data := packEnsCall(ensResolverSelector, node)
+	if len(data) != 36 || base.Bytes2Hex(data[:4]) != "0178b8bf" || data[35] != 0x01 {
+		t.Error("unexpected call data", base.Bytes2Hex(data))
+	}
+	if len(ensResolverSelector) != 4 {
+		t.Error("the selector was modified")
+	}
+}
+
+func TestGetEnsNameZeroAddress(t *testing.T) {
+	// The zero address is never looked up, so no node is needed
+	conn := &Connection{Chain: "mainnet"}
+	if name, ok := conn.GetEnsName(base.ZeroAddr, 18000000); ok || len(name) > 0 {
+		t.Error("expected no name for the zero address, got", name)
+	}
+}
@@ -63,7 +63,7 @@ func (conn *Connection) getLogsSimple(filter types.SimpleLogFilter) ([]types.Sim
 		FromBlock string   `json:"fromBlock"`
 		ToBlock   string   `json:"toBlock"`
 		Address   []string `json:"address"` // sorry for the weird conversion...
-		Topics    []string `json:"topics"`
+		Topics    []any    `json:"topics"`
 	}{
 		FromBlock: fmt.Sprintf("0x%x", filter.FromBlock),
 		ToBlock:   fmt.Sprintf("0x%x", filter.ToBlock),
@@ -71,8 +71,23 @@ func (conn *Connection) getLogsSimple(filter types.SimpleLogFilter) ([]types.Sim
 	for _, addr := range filter.Emitters {
 		p.Address = append(p.Address, addr.Hex())
 	}
-	for _, topic := range filter.Topics {
-		p.Topics = append(p.Topics, topic.Hex())
+	if len(filter.EventTopics) > 0 {
+		// Each position matches any of its topics (or any topic at all if it has none)
+		for _, topics := range filter.EventTopics {
+			if len(topics) == 0 {
+				p.Topics = append(p.Topics, nil)
+				continue
+			}
+			hexes := make([]string, 0, len(topics))
+			for _, topic := range topics {
+				hexes = append(hexes, topic.Hex())
+			}
+			p.Topics = append(p.Topics, hexes)
+		}
+	} else {
+		for _, topic := range filter.Topics {
+			p.Topics = append(p.Topics, topic.Hex())
+		}
 	}
 
 	method := "eth_getLogs"
@@ -0,0 +1,215 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package rpc
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Multicall3Address is the address at which the Multicall3 contract is deployed on most chains
+var Multicall3Address = base.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// MulticallBatchSize is the maximum number of calls aggregated into a single eth_call
+var MulticallBatchSize = 500
+
+const multicall3Abi = `[{"name":"aggregate3","type":"function","stateMutability":"payable",
+"inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],
+"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`
+
+var aggregate3 = func() abi.Method {
+	parsed, err := abi.JSON(strings.NewReader(multicall3Abi))
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Methods["aggregate3"]
+}()
+
+// MulticallRequest is a single call to be made as part of a Multicall
+type MulticallRequest struct {
+	Target base.Address
+	Data   []byte
+}
+
+// MulticallResult is the result of a single call made as part of a Multicall. If the call reverted,
+// Success is false and ReturnData holds the revert data (if any).
+type MulticallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicallDeployment remembers, per chain, the range of blocks in which the Multicall3 contract is
+// known to exist (or not exist) so its code is checked only rarely.
+type multicallDeployment struct {
+	deployedAt    uint64 // the earliest block at which the contract was found (0 if never found)
+	notDeployedAt uint64 // the latest block at which the contract was not found
+	checked       bool
+}
+
+var multicallMutex sync.Mutex
+var multicallDeployments = map[string]*multicallDeployment{}
+
+// IsMulticallAvailable returns true if the Multicall3 contract is deployed on the chain at the given block
+func (conn *Connection) IsMulticallAvailable(bn uint64) bool {
+	multicallMutex.Lock()
+	dep := multicallDeployments[conn.Chain]
+	if dep == nil {
+		dep = &multicallDeployment{}
+		multicallDeployments[conn.Chain] = dep
+	}
+	if dep.deployedAt != 0 && bn >= dep.deployedAt {
+		multicallMutex.Unlock()
+		return true
+	}
+	if dep.checked && bn <= dep.notDeployedAt {
+		multicallMutex.Unlock()
+		return false
+	}
+	multicallMutex.Unlock()
+
+	code, err := conn.GetContractCodeAt(Multicall3Address, bn)
+	deployed := err == nil && len(code) > 0
+
+	multicallMutex.Lock()
+	defer multicallMutex.Unlock()
+	if deployed {
+		if dep.deployedAt == 0 || bn < dep.deployedAt {
+			dep.deployedAt = bn
+		}
+	} else if err == nil && (!dep.checked || bn > dep.notDeployedAt) {
+		dep.notDeployedAt = bn
+		dep.checked = true
+	}
+	return deployed
+}
+
+// Multicall makes each of the calls at the given block and returns their results in the same order.
+// If the Multicall3 contract is deployed, the calls are aggregated into batches of MulticallBatchSize
+// calls each sent as a single eth_call. Otherwise (or if an aggregated call fails), the calls are sent
+// as a batch of individual eth_calls.
+func (conn *Connection) Multicall(calls []MulticallRequest, bn uint64) ([]MulticallResult, error) {
+	ret := make([]MulticallResult, 0, len(calls))
+	useMulticall := conn.IsMulticallAvailable(bn)
+	for start := 0; start < len(calls); start += MulticallBatchSize {
+		batch := calls[start:utils.Min(start+MulticallBatchSize, len(calls))]
+		var results []MulticallResult
+		var err error
+		if useMulticall {
+			if results, err = conn.aggregate(batch, bn); err != nil {
+				useMulticall = false
+			}
+		}
+		if !useMulticall {
+			if results, err = conn.batchCall(batch, bn); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, results...)
+	}
+	return ret, nil
+}
+
+// aggregate makes the calls in a single call to the Multicall3 contract's aggregate3 function
+func (conn *Connection) aggregate(calls []MulticallRequest, bn uint64) ([]MulticallResult, error) {
+	args := make([]multicall3Call, 0, len(calls))
+	for _, c := range calls {
+		args = append(args, multicall3Call{Target: c.Target.Common(), AllowFailure: true, CallData: c.Data})
+	}
+	packed, err := aggregate3.Inputs.Pack(args)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := query.Query[string](conn.Chain, "eth_call", query.Params{
+		map[string]any{
+			"to":   Multicall3Address.Hex(),
+			"data": "0x" + base.Bytes2Hex(append(aggregate3.ID, packed...)),
+		},
+		fmt.Sprintf("0x%x", bn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAggregate3(*output, len(calls))
+}
+
+// decodeAggregate3 decodes the return value of aggregate3 which must contain n results. A call that
+// succeeds but returns nothing (for example, a call to an address with no code) is reported as
+// unsuccessful as it is by batchCall.
+func decodeAggregate3(output string, n int) ([]MulticallResult, error) {
+	unpacked, err := aggregate3.Outputs.Unpack(base.Hex2Bytes(strings.TrimPrefix(output, "0x")))
+	if err != nil {
+		return nil, err
+	}
+	decoded := *abi.ConvertType(unpacked[0], new([]multicall3Result)).(*[]multicall3Result)
+	if len(decoded) != n {
+		return nil, fmt.Errorf("multicall returned %d results for %d calls", len(decoded), n)
+	}
+
+	ret := make([]MulticallResult, 0, n)
+	for _, r := range decoded {
+		ret = append(ret, MulticallResult{
+			Success:    r.Success && len(r.ReturnData) > 0,
+			ReturnData: r.ReturnData,
+		})
+	}
+	return ret, nil
+}
+
+// batchCall makes the calls as a batch of individual eth_calls. A call that reverts (or returns nothing)
+// is reported as unsuccessful.
+func (conn *Connection) batchCall(calls []MulticallRequest, bn uint64) ([]MulticallResult, error) {
+	payloads := make([]query.BatchPayload, 0, len(calls))
+	for i, c := range calls {
+		payloads = append(payloads, query.BatchPayload{
+			Key: fmt.Sprintf("%d", i),
+			Payload: &query.Payload{
+				Method: "eth_call",
+				Params: query.Params{
+					map[string]any{
+						"to":   c.Target.Hex(),
+						"data": "0x" + base.Bytes2Hex(c.Data),
+					},
+					fmt.Sprintf("0x%x", bn),
+				},
+			},
+		})
+	}
+
+	output, err := query.QueryBatch[string](conn.Chain, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]MulticallResult, 0, len(calls))
+	for i := range calls {
+		var r MulticallResult
+		if value := output[fmt.Sprintf("%d", i)]; value != nil && len(*value) > 2 {
+			r.Success = true
+			r.ReturnData = base.Hex2Bytes((*value)[2:])
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
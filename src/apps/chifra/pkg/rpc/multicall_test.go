@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func TestDecodeAggregate3(t *testing.T) {
+	expected := []multicall3Result{
+		{Success: true, ReturnData: base.Hex2Bytes("0000000000000000000000000000000000000000000000000000000000000012")},
+		{Success: false, ReturnData: []byte{}},
+		{Success: true, ReturnData: []byte{}}, // no code at the target
+	}
+	packed, err := aggregate3.Outputs.Pack(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := decodeAggregate3("0x"+base.Bytes2Hex(packed), len(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		success := expected[i].Success && len(expected[i].ReturnData) > 0
+		if r.Success != success || !bytes.Equal(r.ReturnData, expected[i].ReturnData) {
+			t.Error("wrong result", i, r)
+		}
+	}
+
+	if _, err := decodeAggregate3("0x"+base.Bytes2Hex(packed), 2); err == nil {
+		t.Error("expected an error for the wrong number of results")
+	}
+	if _, err := decodeAggregate3("0x", 2); err == nil {
+		t.Error("expected an error for empty output (no Multicall3 contract)")
+	}
+
+	// The selector of aggregate3((address,bool,bytes)[])
+	if got := base.Bytes2Hex(aggregate3.ID); got != "82ad56cb" {
+		t.Error("wrong aggregate3 selector", got)
+	}
+}
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// GetRevertData replays a call with eth_call at the given block and returns the data the call reverted
+// with (as a hex string). If the call does not revert, or reverts without data, the result is empty. To
+// replay a transaction, pass its parent block: the replay does not see earlier transactions in the
+// transaction's own block, so it may not revert the same way.
+func (conn *Connection) GetRevertData(from, to base.Address, value *big.Int, gas base.Gas, data []byte, bn base.Blknum) (string, error) {
+	ec, err := conn.getClient()
+	if err != nil {
+		return "", err
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from.Common(),
+		Gas:   gas,
+		Value: value,
+		Data:  data,
+	}
+	if !to.IsZero() {
+		toAddr := to.Common()
+		msg.To = &toAddr
+	}
+
+	_, err = ec.CallContract(context.Background(), msg, new(big.Int).SetUint64(bn))
+	if err == nil {
+		return "", nil
+	}
+
+	var dataErr gethRpc.DataError
+	if !errors.As(err, &dataErr) {
+		return "", err
+	}
+	if revertData, ok := dataErr.ErrorData().(string); ok {
+		if _, err := hexutil.Decode(revertData); err == nil {
+			return revertData, nil
+		}
+	}
+	// The call reverted without data (or with data the node did not report as hex)
+	return "", nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+)
+
+// GetStorageAt returns the contents of the given storage slots of an address at the given block. The
+// slots are read in a single batch and returned in the order requested.
+func (conn *Connection) GetStorageAt(address base.Address, slots []base.Hash, bn uint64) ([]base.Hash, error) {
+	payloads := make([]query.BatchPayload, 0, len(slots))
+	for i, slot := range slots {
+		payloads = append(payloads, query.BatchPayload{
+			Key: fmt.Sprintf("%d", i),
+			Payload: &query.Payload{
+				Method: "eth_getStorageAt",
+				Params: query.Params{
+					address,
+					slot.Hex(),
+					fmt.Sprintf("0x%x", bn),
+				},
+			},
+		})
+	}
+
+	results, err := query.QueryBatch[string](conn.Chain, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]base.Hash, 0, len(slots))
+	for i := range slots {
+		value := results[fmt.Sprintf("%d", i)]
+		if value == nil || len(*value) == 0 {
+			return nil, fmt.Errorf("could not read storage slot %s of %s at block %d", slots[i].Hex(), address.Hex(), bn)
+		}
+		ret = append(ret, base.HexToHash(*value))
+	}
+	return ret, nil
+}
+
+// PrestateAccount is the state of an account as reported by the node's prestate tracer. In diff mode,
+// only the modified parts of the account's state are reported.
+type PrestateAccount struct {
+	Balance string            `json:"balance,omitempty"`
+	Nonce   uint64            `json:"nonce,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// PrestateDiff is the state of the accounts touched by a transaction before (Pre) and after (Post)
+// the transaction. An account found in Pre but not in Post was destroyed, and a storage slot found
+// in an account's Pre but not in its Post was cleared.
+type PrestateDiff struct {
+	TransactionIndex uint64
+	TransactionHash  base.Hash
+	Pre              map[base.Address]PrestateAccount
+	Post             map[base.Address]PrestateAccount
+}
+
+// GetStateDiffsByBlock returns the state changes made by each transaction in the given block using the
+// node's prestateTracer in diff mode. The node must support debug_traceBlockByNumber.
+func (conn *Connection) GetStateDiffsByBlock(bn uint64) ([]PrestateDiff, error) {
+	type rawPrestate struct {
+		TxHash string `json:"txHash"`
+		Result struct {
+			Pre  map[string]PrestateAccount `json:"pre"`
+			Post map[string]PrestateAccount `json:"post"`
+		} `json:"result"`
+		Error string `json:"error"`
+	}
+
+	method := "debug_traceBlockByNumber"
+	params := query.Params{
+		fmt.Sprintf("0x%x", bn),
+		map[string]any{
+			"tracer": "prestateTracer",
+			"tracerConfig": map[string]any{
+				"diffMode": true,
+			},
+		},
+	}
+
+	rawDiffs, err := query.QuerySlice[rawPrestate](conn.Chain, method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccounts := func(raw map[string]PrestateAccount) map[base.Address]PrestateAccount {
+		ret := make(map[base.Address]PrestateAccount, len(raw))
+		for addr, account := range raw {
+			ret[base.HexToAddress(addr)] = account
+		}
+		return ret
+	}
+
+	ret := make([]PrestateDiff, 0, len(rawDiffs))
+	for i, raw := range rawDiffs {
+		if len(raw.Error) > 0 {
+			return nil, fmt.Errorf("tracing transaction %d.%d failed: %s", bn, i, raw.Error)
+		}
+		ret = append(ret, PrestateDiff{
+			TransactionIndex: uint64(i),
+			TransactionHash:  base.HexToHash(raw.TxHash),
+			Pre:              toAccounts(raw.Result.Pre),
+			Post:             toAccounts(raw.Result.Post),
+		})
+	}
+	return ret, nil
+}
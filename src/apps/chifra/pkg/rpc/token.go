@@ -111,17 +111,21 @@ func (conn *Connection) GetTokenState(tokenAddress base.Address, hexBlockNo stri
 		return
 	}
 
-	name, _ := decode.ArticulateStringOrBytes(*results["name"])
-	symbol, _ := decode.ArticulateStringOrBytes(*results["symbol"])
+	return tokenFromResults(tokenAddress, *results["name"], *results["symbol"], *results["decimals"], *results["totalSupply"], *results["erc721"])
+}
+
+// tokenFromResults builds a token from the hex encoded results of the calls made by GetTokenState
+func tokenFromResults(tokenAddress base.Address, rawName, rawSymbol, rawDecimals, rawTotalSupply, rawErc721 string) (*types.SimpleToken, error) {
+	name, _ := decode.ArticulateStringOrBytes(rawName)
+	symbol, _ := decode.ArticulateStringOrBytes(rawSymbol)
 
 	var decimals uint64 = 0
-	rawDecimals := *results["decimals"]
 	parsedDecimals, parseErr := strconv.ParseUint(rawDecimals, 0, 8)
 	if parseErr == nil {
 		decimals = uint64(parsedDecimals)
 	}
 
-	totalSupply := base.HexToWei(*results["totalSupply"])
+	totalSupply := base.HexToWei(rawTotalSupply)
 
 	// According to ERC-20, name, symbol and decimals are optional, but such a token
 	// would be of no use to us
@@ -130,21 +134,50 @@ func (conn *Connection) GetTokenState(tokenAddress base.Address, hexBlockNo stri
 	}
 
 	tokenType := types.TokenErc20
-	erc721, erc721Err := decode.ArticulateBool(*results["erc721"])
+	erc721, erc721Err := decode.ArticulateBool(rawErc721)
 	if erc721Err == nil && erc721 {
 		tokenType = types.TokenErc721
 	}
 
-	token = &types.SimpleToken{
+	return &types.SimpleToken{
 		Address:     tokenAddress,
 		Decimals:    decimals,
 		Name:        name,
 		Symbol:      symbol,
 		TotalSupply: *totalSupply,
 		TokenType:   tokenType,
+	}, nil
+}
+
+// GetTokenStatesAt returns the state of each of the tokens at the given block. The calls for all of the
+// tokens are aggregated with Multicall. If an address is not a token, its entry is nil.
+func (conn *Connection) GetTokenStatesAt(tokens []base.Address, bn uint64) ([]*types.SimpleToken, error) {
+	selectors := []string{tokenStateName, tokenStateSymbol, tokenStateDecimals, tokenStateTotalSupply, erc721SupportsInterfaceData}
+
+	calls := make([]MulticallRequest, 0, len(tokens)*len(selectors))
+	for _, token := range tokens {
+		for _, selector := range selectors {
+			calls = append(calls, MulticallRequest{Target: token, Data: base.Hex2Bytes(selector[2:])})
+		}
 	}
 
-	return
+	results, err := conn.Multicall(calls, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*types.SimpleToken, len(tokens))
+	for i, token := range tokens {
+		raw := make([]string, len(selectors))
+		for j := range selectors {
+			raw[j] = "0x"
+			if r := results[i*len(selectors)+j]; r.Success {
+				raw[j] = "0x" + base.Bytes2Hex(r.ReturnData)
+			}
+		}
+		ret[i], _ = tokenFromResults(token, raw[0], raw[1], raw[2], raw[3], raw[4])
+	}
+	return ret, nil
 }
 
 // GetTokenBalanceAt returns token balance for given block. `hexBlockNo` can be "latest" or "" for the latest block or
@@ -181,3 +214,36 @@ func (conn *Connection) GetTokenBalanceAt(token, holder base.Address, hexBlockNo
 
 	return base.HexToWei(*output["balance"]), nil
 }
+
+// GetTokenBalancesAt returns the balance of holders[i] in tokens[i] for each i at the given block. The
+// calls are aggregated with Multicall. If a call fails, its balance is nil.
+func (conn *Connection) GetTokenBalancesAt(tokens, holders []base.Address, bn uint64) ([]*big.Int, error) {
+	if len(tokens) != len(holders) {
+		return nil, fmt.Errorf("got %d tokens but %d holders", len(tokens), len(holders))
+	}
+
+	calls := make([]MulticallRequest, 0, len(tokens))
+	for i := range tokens {
+		calls = append(calls, MulticallRequest{
+			Target: tokens[i],
+			Data:   base.Hex2Bytes(tokenStateBalanceOf[2:] + holders[i].Pad32()),
+		})
+	}
+
+	results, err := conn.Multicall(calls, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*big.Int, len(results))
+	for i, r := range results {
+		if r.Success {
+			data := r.ReturnData
+			if len(data) > 32 {
+				data = data[:32]
+			}
+			ret[i] = new(big.Int).SetBytes(data)
+		}
+	}
+	return ret, nil
+}
@@ -8,6 +8,7 @@
 package rpc
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -196,3 +197,34 @@ func TestGetState_NonStandard(t *testing.T) {
 		t.Fatal("wrong total supply:", token.TotalSupply)
 	}
 }
+
+func TestGetTokenStatesAt_Multicall(t *testing.T) {
+	chain := utils.GetTestChain()
+	conn := TempConnection(chain)
+
+	// Multicall3 was deployed at block 14353601, so check both with and without it
+	for _, bn := range []uint64{14000000, 15000000} {
+		tokens, err := conn.GetTokenStatesAt([]base.Address{tokenAddress, nftAddress, nonStandard1}, bn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tokens[0] == nil || tokens[0].Symbol != "DAI" {
+			t.Fatal("wrong DAI state at block", bn, tokens[0])
+		}
+		if tokens[1] == nil || !tokens[1].TokenType.IsErc721() {
+			t.Fatal("wrong NFT state at block", bn, tokens[1])
+		}
+		if tokens[2] == nil || tokens[2].Symbol == "" {
+			t.Fatal("wrong non-standard state at block", bn, tokens[2])
+		}
+
+		single, err := conn.GetTokenBalanceAt(tokenAddress, tokenAddress, fmt.Sprintf("0x%x", bn))
+		if err != nil {
+			t.Fatal(err)
+		}
+		balances, err := conn.GetTokenBalancesAt([]base.Address{tokenAddress}, []base.Address{tokenAddress}, bn)
+		if err != nil || balances[0] == nil || balances[0].Cmp(single) != 0 {
+			t.Fatal("batched balance does not match single balance at block", bn, balances, single, err)
+		}
+	}
+}
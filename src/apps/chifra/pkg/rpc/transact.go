@@ -0,0 +1,65 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum"
+)
+
+// GetPendingNonce returns the nonce of the next transaction sent by the address (including those
+// that are pending)
+func (conn *Connection) GetPendingNonce(addr base.Address) (uint64, error) {
+	ec, err := conn.getClient()
+	if err != nil {
+		return 0, err
+	}
+	return ec.PendingNonceAt(context.Background(), addr.Common())
+}
+
+// GetFeeSuggestion returns the node's suggested priority fee per gas and a maximum fee per gas
+// that covers the priority fee plus twice the latest block's base fee
+func (conn *Connection) GetFeeSuggestion() (maxFee *big.Int, priorityFee *big.Int, err error) {
+	ec, err := conn.getClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if priorityFee, err = ec.SuggestGasTipCap(context.Background()); err != nil {
+		return nil, nil, err
+	}
+
+	header, err := ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.BaseFee == nil {
+		return nil, nil, errors.New("the chain does not support EIP-1559 transactions")
+	}
+
+	maxFee = new(big.Int).Mul(header.BaseFee, big.NewInt(2))
+	maxFee.Add(maxFee, priorityFee)
+	return maxFee, priorityFee, nil
+}
+
+// EstimateGas returns the node's estimate of the gas needed to send the transaction
+func (conn *Connection) EstimateGas(from, to base.Address, value *big.Int, data []byte) (uint64, error) {
+	ec, err := conn.getClient()
+	if err != nil {
+		return 0, err
+	}
+
+	toAddr := to.Common()
+	return ec.EstimateGas(context.Background(), ethereum.CallMsg{
+		From:  from.Common(),
+		To:    &toAddr,
+		Value: value,
+		Data:  data,
+	})
+}
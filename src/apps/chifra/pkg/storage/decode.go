@@ -0,0 +1,74 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package storage
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// Decode interprets the contents of the storage slot at loc according to the location's type. It
+// returns an empty string if the location has no type or if the value cannot be decoded from this slot
+// alone (as is the case for long strings and for structs, arrays, and mappings).
+func (loc *Location) Decode(word []byte) string {
+	if loc.typ == nil || len(word) != 32 {
+		return ""
+	}
+
+	if loc.typ.Encoding == "bytes" {
+		return decodeShortBytes(word, loc.typ.Label == "string")
+	}
+	if loc.typ.Encoding != "inplace" || len(loc.typ.Members) > 0 || len(loc.typ.Base) > 0 {
+		return ""
+	}
+
+	end := 32 - loc.Offset
+	start := end - loc.Size
+	if start < 0 || end > 32 {
+		return ""
+	}
+	value := word[start:end]
+
+	label := loc.typ.Label
+	switch {
+	case label == "bool":
+		return strconv.FormatBool(new(big.Int).SetBytes(value).Sign() != 0)
+	case label == "address" || strings.HasPrefix(label, "contract ") || strings.HasPrefix(label, "address "):
+		addr := base.BytesToAddress(value)
+		return addr.Hex()
+	case strings.HasPrefix(label, "uint") || strings.HasPrefix(label, "enum "):
+		return new(big.Int).SetBytes(value).String()
+	case strings.HasPrefix(label, "int"):
+		n := new(big.Int).SetBytes(value)
+		if len(value) > 0 && value[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(value)*8)))
+		}
+		return n.String()
+	case strings.HasPrefix(label, "bytes"):
+		return "0x" + base.Bytes2Hex(value)
+	}
+	return ""
+}
+
+// decodeShortBytes decodes a string or bytes value stored in a single slot. Values of 32 bytes or
+// more are stored elsewhere and are not decoded.
+func decodeShortBytes(word []byte, isString bool) string {
+	if word[31]&1 == 1 {
+		return ""
+	}
+	length := int(word[31] / 2)
+	if length > 31 {
+		return ""
+	}
+	value := word[:length]
+	if isString && utf8.Valid(value) {
+		return string(value)
+	}
+	return "0x" + base.Bytes2Hex(value)
+}
@@ -0,0 +1,7 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+// Package storage computes the location of Solidity state variables in a contract's storage (including
+// elements of mappings, arrays, and structs) and decodes the values found there.
+package storage
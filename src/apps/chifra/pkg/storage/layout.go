@@ -0,0 +1,87 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Layout is a contract's storage layout as produced by the Solidity compiler (solc --storage-layout)
+type Layout struct {
+	Storage []Variable       `json:"storage"`
+	Types   map[string]*Type `json:"types"`
+}
+
+// Variable is a state variable or a member of a struct in a storage layout
+type Variable struct {
+	Label  string `json:"label"`
+	Offset int    `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// Type describes one of the types found in a storage layout. Encoding is one of inplace,
+// mapping, dynamic_array, or bytes.
+type Type struct {
+	Encoding      string     `json:"encoding"`
+	Label         string     `json:"label"`
+	NumberOfBytes string     `json:"numberOfBytes"`
+	Base          string     `json:"base,omitempty"`
+	Key           string     `json:"key,omitempty"`
+	Value         string     `json:"value,omitempty"`
+	Members       []Variable `json:"members,omitempty"`
+}
+
+// Size returns the number of bytes occupied by a value of the type
+func (t *Type) Size() int {
+	n, _ := strconv.Atoi(t.NumberOfBytes)
+	return n
+}
+
+// LoadLayout reads a storage layout from a file. The file may contain the layout itself or any
+// JSON object (such as a build artifact) carrying the layout in its storageLayout field.
+func LoadLayout(path string) (*Layout, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapped struct {
+		StorageLayout *Layout `json:"storageLayout"`
+	}
+	if err := json.Unmarshal(contents, &wrapped); err == nil && wrapped.StorageLayout != nil {
+		return wrapped.StorageLayout, wrapped.StorageLayout.check()
+	}
+
+	var layout Layout
+	if err := json.Unmarshal(contents, &layout); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &layout, layout.check()
+}
+
+func (l *Layout) check() error {
+	if len(l.Storage) == 0 {
+		return fmt.Errorf("the storage layout contains no variables")
+	}
+	for _, v := range l.Storage {
+		if l.Types[v.Type] == nil {
+			return fmt.Errorf("the storage layout does not describe type %s of variable %s", v.Type, v.Label)
+		}
+	}
+	return nil
+}
+
+func (l *Layout) variable(label string) *Variable {
+	for i := range l.Storage {
+		if l.Storage[i].Label == label {
+			return &l.Storage[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package storage
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Location is the position of a value in a contract's storage
+type Location struct {
+	Spec   string    // the specification from which the location was computed
+	Slot   base.Hash // the storage slot holding the value
+	Offset int       // the offset of the value in the slot, in bytes counting from the right
+	Size   int       // the number of bytes occupied by the value (at most 32)
+	Type   string    // the Solidity type of the value if a layout was used
+	typ    *Type
+}
+
+// accessor is either an index (into a mapping or an array) or a struct member
+type accessor struct {
+	key    string
+	member string
+}
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// Resolve computes the storage location described by spec. A spec starts with either a slot number
+// (decimal or hex) or, if a layout is given, the name of a state variable. It may be followed by any
+// number of [key] accessors (into mappings or arrays) or .member accessors (into structs). Without a
+// layout, every [key] is treated as a mapping key and encoded from its appearance: an address, a
+// 32-byte hex value, a quoted string, or a number.
+func Resolve(spec string, layout *Layout) (*Location, error) {
+	head, accessors, err := parseSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := Location{Spec: spec, Size: 32}
+	var slot *big.Int
+	if n, ok := parseNumber(head); ok {
+		slot = n
+	} else if layout == nil {
+		return nil, fmt.Errorf("%s: a storage layout is required to locate variable %s", spec, head)
+	} else if v := layout.variable(head); v == nil {
+		return nil, fmt.Errorf("%s: variable %s not found in the storage layout", spec, head)
+	} else {
+		slot, _ = parseNumber(v.Slot)
+		loc.Offset = v.Offset
+		loc.typ = layout.Types[v.Type]
+	}
+
+	for _, acc := range accessors {
+		if loc.typ == nil {
+			if len(acc.member) > 0 {
+				return nil, fmt.Errorf("%s: a storage layout is required to locate member %s", spec, acc.member)
+			}
+			key, err := encodeRawKey(acc.key)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", spec, err)
+			}
+			slot = mappingSlot(key, slot)
+			continue
+		}
+
+		typ := loc.typ
+		var next string
+		switch {
+		case typ.Encoding == "mapping":
+			if len(acc.member) > 0 {
+				return nil, fmt.Errorf("%s: %s has no member %s", spec, typ.Label, acc.member)
+			}
+			key, err := encodeKey(acc.key, layout.Types[typ.Key])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", spec, err)
+			}
+			slot, loc.Offset, next = mappingSlot(key, slot), 0, typ.Value
+
+		case typ.Encoding == "dynamic_array" || (typ.Encoding == "inplace" && len(typ.Base) > 0):
+			if len(acc.member) > 0 {
+				return nil, fmt.Errorf("%s: %s has no member %s", spec, typ.Label, acc.member)
+			}
+			index, ok := parseNumber(acc.key)
+			if !ok {
+				return nil, fmt.Errorf("%s: invalid array index %s", spec, acc.key)
+			}
+			if length, ok := staticLength(typ.Label); ok && typ.Encoding == "inplace" && index.Cmp(length) >= 0 {
+				return nil, fmt.Errorf("%s: index %s is out of bounds for %s", spec, acc.key, typ.Label)
+			}
+			start := slot
+			if typ.Encoding == "dynamic_array" {
+				start = new(big.Int).SetBytes(crypto.Keccak256(pad32(slot)))
+			}
+			elem := layout.Types[typ.Base]
+			if elem == nil {
+				return nil, fmt.Errorf("%s: the storage layout does not describe type %s", spec, typ.Base)
+			}
+			slot, loc.Offset = elementSlot(start, index, elem.Size())
+			next = typ.Base
+
+		case typ.Encoding == "inplace" && len(typ.Members) > 0:
+			if len(acc.member) == 0 {
+				return nil, fmt.Errorf("%s: %s cannot be indexed", spec, typ.Label)
+			}
+			var member *Variable
+			for i := range typ.Members {
+				if typ.Members[i].Label == acc.member {
+					member = &typ.Members[i]
+					break
+				}
+			}
+			if member == nil {
+				return nil, fmt.Errorf("%s: %s has no member %s", spec, typ.Label, acc.member)
+			}
+			memberSlot, _ := parseNumber(member.Slot)
+			slot, loc.Offset, next = new(big.Int).Add(slot, memberSlot), member.Offset, member.Type
+
+		default:
+			return nil, fmt.Errorf("%s: %s cannot be indexed", spec, typ.Label)
+		}
+
+		if loc.typ = layout.Types[next]; loc.typ == nil {
+			return nil, fmt.Errorf("%s: the storage layout does not describe type %s", spec, next)
+		}
+	}
+
+	if loc.typ != nil {
+		loc.Type = loc.typ.Label
+		if size := loc.typ.Size(); size < 32 {
+			loc.Size = size
+		}
+	}
+	loc.Slot = base.BytesToHash(pad32(slot))
+	return &loc, nil
+}
+
+// parseSpec splits a spec into its head and its accessors
+func parseSpec(spec string) (string, []accessor, error) {
+	spec = strings.TrimSpace(spec)
+	end := strings.IndexAny(spec, "[.")
+	if end == -1 {
+		end = len(spec)
+	}
+	head := spec[:end]
+	if len(head) == 0 {
+		return "", nil, fmt.Errorf("%s: invalid storage slot", spec)
+	}
+	if _, ok := parseNumber(head); !ok && !identifierRe.MatchString(head) {
+		return "", nil, fmt.Errorf("%s: invalid storage slot or variable %s", spec, head)
+	}
+
+	accessors := []accessor{}
+	rest := spec[end:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '[':
+			close := closingBracket(rest)
+			if close == -1 {
+				return "", nil, fmt.Errorf("%s: missing closing bracket", spec)
+			}
+			key := strings.TrimSpace(rest[1:close])
+			if len(key) == 0 {
+				return "", nil, fmt.Errorf("%s: empty key", spec)
+			}
+			accessors = append(accessors, accessor{key: key})
+			rest = rest[close+1:]
+		case '.':
+			end := strings.IndexAny(rest[1:], "[.")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			member := rest[1 : end+1]
+			if !identifierRe.MatchString(member) {
+				return "", nil, fmt.Errorf("%s: invalid member %s", spec, member)
+			}
+			accessors = append(accessors, accessor{member: member})
+			rest = rest[end+1:]
+		default:
+			return "", nil, fmt.Errorf("%s: unexpected character %c", spec, rest[0])
+		}
+	}
+	return head, accessors, nil
+}
+
+// closingBracket returns the position of the bracket closing the one at the start of s, skipping
+// over quoted strings
+func closingBracket(s string) int {
+	quote := byte(0)
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case s[i] == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseNumber parses a non-negative decimal or hex number
+func parseNumber(s string) (*big.Int, bool) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		if len(s) == 2 {
+			return nil, false
+		}
+		return new(big.Int).SetString(s[2:], 16)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if ok && n.Sign() < 0 {
+		return nil, false
+	}
+	return n, ok
+}
+
+// staticLength returns the length of a static array given its label (for example, uint256[3])
+func staticLength(label string) (*big.Int, bool) {
+	open := strings.LastIndex(label, "[")
+	if open == -1 || !strings.HasSuffix(label, "]") {
+		return nil, false
+	}
+	return parseNumber(label[open+1 : len(label)-1])
+}
+
+var slotModulus = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// pad32 left pads a number to 32 bytes. Slot arithmetic wraps around at 2^256.
+func pad32(n *big.Int) []byte {
+	ret := make([]byte, 32)
+	return new(big.Int).Mod(n, slotModulus).FillBytes(ret)
+}
+
+// mappingSlot returns the slot of the value at an encoded key in a mapping stored at slot
+func mappingSlot(key []byte, slot *big.Int) *big.Int {
+	return new(big.Int).SetBytes(crypto.Keccak256(key, pad32(slot)))
+}
+
+// elementSlot returns the slot and offset of the index-th element of an array whose elements of the
+// given size start at start. Elements of 16 bytes or fewer are packed into slots.
+func elementSlot(start, index *big.Int, size int) (*big.Int, int) {
+	if size <= 0 {
+		size = 32
+	}
+	if size <= 16 {
+		perSlot := big.NewInt(int64(32 / size))
+		q, r := new(big.Int).QuoRem(index, perSlot, new(big.Int))
+		return new(big.Int).Add(start, q), int(r.Int64()) * size
+	}
+	slotsPer := big.NewInt(int64((size + 31) / 32))
+	return new(big.Int).Add(start, new(big.Int).Mul(index, slotsPer)), 0
+}
+
+// encodeRawKey encodes a mapping key based on its appearance only
+func encodeRawKey(key string) ([]byte, error) {
+	switch {
+	case isQuoted(key):
+		return []byte(key[1 : len(key)-1]), nil
+	case base.IsValidAddress(key):
+		return encodeKey(key, &Type{Label: "address"})
+	case len(key) == 66 && isHex(key):
+		return encodeKey(key, &Type{Label: "bytes32"})
+	default:
+		return encodeKey(key, &Type{Label: "uint256"})
+	}
+}
+
+// encodeKey encodes a mapping key of the given type as it is hashed by the Solidity compiler
+func encodeKey(key string, typ *Type) ([]byte, error) {
+	if typ == nil {
+		return encodeRawKey(key)
+	}
+
+	label := typ.Label
+	switch {
+	case label == "address" || strings.HasPrefix(label, "contract ") || strings.HasPrefix(label, "address "):
+		if !base.IsValidAddress(key) {
+			return nil, fmt.Errorf("invalid address key %s", key)
+		}
+		addr := base.HexToAddress(key)
+		return pad32(new(big.Int).SetBytes(addr.Bytes())), nil
+
+	case label == "bool":
+		switch key {
+		case "true":
+			return pad32(big.NewInt(1)), nil
+		case "false":
+			return pad32(big.NewInt(0)), nil
+		}
+		return nil, fmt.Errorf("invalid bool key %s", key)
+
+	case strings.HasPrefix(label, "uint") || strings.HasPrefix(label, "enum "):
+		n, ok := parseNumber(key)
+		if !ok || n.BitLen() > 256 {
+			return nil, fmt.Errorf("invalid %s key %s", label, key)
+		}
+		return pad32(n), nil
+
+	case strings.HasPrefix(label, "int"):
+		n, ok := new(big.Int).SetString(key, 0)
+		if !ok || n.BitLen() > 255 {
+			return nil, fmt.Errorf("invalid %s key %s", label, key)
+		}
+		if n.Sign() < 0 {
+			n.Add(n, slotModulus)
+		}
+		return pad32(n), nil
+
+	case label == "string":
+		if isQuoted(key) {
+			key = key[1 : len(key)-1]
+		}
+		return []byte(key), nil
+
+	case label == "bytes":
+		if !isHex(key) {
+			return nil, fmt.Errorf("invalid bytes key %s", key)
+		}
+		return base.Hex2Bytes(key[2:]), nil
+
+	case strings.HasPrefix(label, "bytes"):
+		size, err := strconv.Atoi(label[len("bytes"):])
+		if err != nil || !isHex(key) || len(key)-2 > size*2 {
+			return nil, fmt.Errorf("invalid %s key %s", label, key)
+		}
+		ret := make([]byte, 32)
+		copy(ret, base.Hex2Bytes(key[2:]))
+		return ret, nil
+	}
+
+	return nil, fmt.Errorf("unsupported mapping key type %s", label)
+}
+
+func isQuoted(s string) bool {
+	return len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0]
+}
+
+func isHex(s string) bool {
+	return strings.HasPrefix(s, "0x") && len(s)%2 == 0 && base.IsHex(s)
+}
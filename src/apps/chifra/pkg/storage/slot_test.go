@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testLayout = `{
+  "storage": [
+    {"label": "owner", "offset": 0, "slot": "0", "type": "t_address"},
+    {"label": "paused", "offset": 20, "slot": "0", "type": "t_bool"},
+    {"label": "balances", "offset": 0, "slot": "1", "type": "t_mapping(t_address,t_uint256)"},
+    {"label": "values", "offset": 0, "slot": "2", "type": "t_array(t_uint256)dyn_storage"},
+    {"label": "small", "offset": 0, "slot": "3", "type": "t_array(t_uint64)4_storage"},
+    {"label": "info", "offset": 0, "slot": "4", "type": "t_struct(Info)10_storage"},
+    {"label": "name", "offset": 0, "slot": "6", "type": "t_string_storage"}
+  ],
+  "types": {
+    "t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+    "t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+    "t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+    "t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"},
+    "t_int8": {"encoding": "inplace", "label": "int8", "numberOfBytes": "1"},
+    "t_string_storage": {"encoding": "bytes", "label": "string", "numberOfBytes": "32"},
+    "t_mapping(t_address,t_uint256)": {"encoding": "mapping", "key": "t_address", "label": "mapping(address => uint256)", "numberOfBytes": "32", "value": "t_uint256"},
+    "t_array(t_uint256)dyn_storage": {"base": "t_uint256", "encoding": "dynamic_array", "label": "uint256[]", "numberOfBytes": "32"},
+    "t_array(t_uint64)4_storage": {"base": "t_uint64", "encoding": "inplace", "label": "uint64[4]", "numberOfBytes": "32"},
+    "t_struct(Info)10_storage": {"encoding": "inplace", "label": "struct Test.Info", "numberOfBytes": "64", "members": [
+      {"label": "total", "offset": 0, "slot": "0", "type": "t_uint256"},
+      {"label": "flag", "offset": 0, "slot": "1", "type": "t_bool"},
+      {"label": "delta", "offset": 1, "slot": "1", "type": "t_int8"}
+    ]}
+  }
+}`
+
+func word(n int64) []byte {
+	return pad32(big.NewInt(n))
+}
+
+func slotAt(n *big.Int) base.Hash {
+	return base.BytesToHash(pad32(n))
+}
+
+func TestResolve(t *testing.T) {
+	var layout Layout
+	if err := json.Unmarshal([]byte(testLayout), &layout); err != nil {
+		t.Fatal(err)
+	}
+	if err := layout.check(); err != nil {
+		t.Fatal(err)
+	}
+
+	holder := "0x000000000000000000000000000000000000dead"
+	holderKey := make([]byte, 32)
+	copy(holderKey[12:], base.Hex2Bytes(holder[2:]))
+	arrayStart := new(big.Int).SetBytes(crypto.Keccak256(word(2)))
+
+	tests := []struct {
+		spec   string
+		slot   base.Hash
+		offset int
+		size   int
+		typ    string
+	}{
+		{"0x10", slotAt(big.NewInt(16)), 0, 32, ""},
+		{"5[" + holder + "]", base.BytesToHash(crypto.Keccak256(holderKey, word(5))), 0, 32, ""},
+		{"owner", slotAt(big.NewInt(0)), 0, 20, "address"},
+		{"paused", slotAt(big.NewInt(0)), 20, 1, "bool"},
+		{"balances[" + holder + "]", base.BytesToHash(crypto.Keccak256(holderKey, word(1))), 0, 32, "uint256"},
+		{"values[0]", slotAt(arrayStart), 0, 32, "uint256"},
+		{"values[7]", slotAt(new(big.Int).Add(arrayStart, big.NewInt(7))), 0, 32, "uint256"},
+		{"small[2]", slotAt(big.NewInt(3)), 16, 8, "uint64"},
+		{"info.flag", slotAt(big.NewInt(5)), 0, 1, "bool"},
+		{"info.delta", slotAt(big.NewInt(5)), 1, 1, "int8"},
+	}
+
+	for _, test := range tests {
+		loc, err := Resolve(test.spec, &layout)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.spec, err)
+			continue
+		}
+		if loc.Slot != test.slot || loc.Offset != test.offset || loc.Size != test.size || loc.Type != test.typ {
+			t.Errorf("%s: got %s/%d/%d/%s, expected %s/%d/%d/%s", test.spec,
+				loc.Slot.Hex(), loc.Offset, loc.Size, loc.Type, test.slot.Hex(), test.offset, test.size, test.typ)
+		}
+	}
+
+	bad := []string{"", "missing", "owner[1]", "small[4]", "balances[0x1234]", "info.nope", "values.x", "3.member", "4[1"}
+	for _, spec := range bad {
+		if _, err := Resolve(spec, &layout); err == nil {
+			t.Errorf("%s: expected an error", spec)
+		}
+	}
+
+	if _, err := Resolve("balances", nil); err == nil {
+		t.Error("expected an error resolving a variable without a layout")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var layout Layout
+	if err := json.Unmarshal([]byte(testLayout), &layout); err != nil {
+		t.Fatal(err)
+	}
+
+	packed := make([]byte, 32)
+	packed[11] = 1    // paused
+	packed[31] = 0xad // low byte of owner
+	flags := make([]byte, 32)
+	flags[31] = 1    // info.flag
+	flags[30] = 0xfe // info.delta
+	short := make([]byte, 32)
+	copy(short, "hello")
+	short[31] = 10
+
+	tests := []struct {
+		spec     string
+		word     []byte
+		expected string
+	}{
+		{"owner", packed, "0x00000000000000000000000000000000000000ad"},
+		{"paused", packed, "true"},
+		{"info.flag", flags, "true"},
+		{"info.delta", flags, "-2"},
+		{"values[1]", word(12345), "12345"},
+		{"name", short, "hello"},
+		{"0x0", word(1), ""},
+	}
+
+	for _, test := range tests {
+		loc, err := Resolve(test.spec, &layout)
+		if err != nil {
+			t.Fatalf("%s: %v", test.spec, err)
+		}
+		if got := loc.Decode(test.word); got != test.expected {
+			t.Errorf("%s: got %q, expected %q", test.spec, got, test.expected)
+		}
+	}
+}
@@ -56,6 +56,7 @@ type SimpleFunction struct {
 	payable   bool
 	abiMethod *abi.Method
 	abiEvent  *abi.Event
+	abiError  *abi.Error
 	// EXISTING_CODE
 }
 
@@ -284,6 +285,20 @@ func FunctionFromAbiEvent(ethEvent *abi.Event) *SimpleFunction {
 	return function
 }
 
+// FunctionFromAbiError converts go-ethereum's abi.Error (a custom error declared in an ABI) to our
+// SimpleFunction. Like a function, an error is identified by a four-byte selector.
+func FunctionFromAbiError(ethError *abi.Error) *SimpleFunction {
+	function := &SimpleFunction{
+		Encoding:     "0x" + base.Bytes2Hex(ethError.ID.Bytes()[:4]),
+		Signature:    ethError.Sig,
+		Name:         ethError.Name,
+		FunctionType: "error",
+		Inputs:       argumentsToSimpleParameters(ethError.Inputs),
+	}
+	function.SetAbiError(ethError)
+	return function
+}
+
 // FunctionFromAbiMethod converts go-ethereum's abi.Method to our SimpleFunction
 func FunctionFromAbiMethod(ethMethod *abi.Method) *SimpleFunction {
 	// method.ID is our "four-byte"
@@ -409,6 +424,30 @@ func (s *SimpleFunction) AbiEventFromFunction() (ethMethod *abi.Event, err error
 	return
 }
 
+func (s *SimpleFunction) AbiErrorFromFunction() (ethError *abi.Error, err error) {
+	if s.FunctionType != "error" {
+		err = fmt.Errorf("AbiErrorFromFunction called for a %s", s.FunctionType)
+		return
+	}
+
+	removeUnknownTuples(s)
+	jsonAbi, err := json.Marshal([]any{s})
+	if err != nil {
+		return
+	}
+	res, err := abi.JSON(bytes.NewReader(jsonAbi))
+	if err != nil {
+		return
+	}
+	found, ok := res.Errors[s.Name]
+	if !ok {
+		err = fmt.Errorf("generating ABI error: error not found: %s", s.Name)
+		return
+	}
+	ethError = &found
+	return
+}
+
 // removeUnknownTuples replaces unknown tuple type with `bytes` type.
 // A tuple is unknown if we don't know its components (this can happen for
 // inputs/outputs of internal methods)
@@ -464,6 +503,22 @@ func (s *SimpleFunction) GetAbiEvent() (abiEvent *abi.Event, err error) {
 	return s.abiEvent, nil
 }
 
+func (s *SimpleFunction) SetAbiError(ethError *abi.Error) {
+	s.abiError = ethError
+}
+
+func (s *SimpleFunction) GetAbiError() (abiError *abi.Error, err error) {
+	if s.abiError == nil {
+		abiError, err = s.AbiErrorFromFunction()
+		if err != nil {
+			return
+		}
+		s.SetAbiError(abiError)
+		return
+	}
+	return s.abiError, nil
+}
+
 // Normalize sets StateMutability from `payable` field. It is only useful when
 // reading ABIs generated before Solidity 0.5.0, which use `payable` field:
 // https://docs.soliditylang.org/en/develop/050-breaking-changes.html#command-line-and-json-interfaces
@@ -33,6 +33,9 @@ type SimpleLogFilter struct {
 	Topics    []base.Hash    `json:"topics"`
 	raw       *RawLogFilter  `json:"-"`
 	// EXISTING_CODE
+	// EventTopics are the topics a log must have at each position (as in eth_getLogs). A nil entry
+	// matches any topic.
+	EventTopics [][]base.Hash `json:"-"`
 	// EXISTING_CODE
 }
 
@@ -79,7 +82,29 @@ func (filter *SimpleLogFilter) PassesFilter(log *SimpleLog) bool {
 	passesEmitter := len(filter.Emitters) == 0 || foundEmitter
 	passesTopic := len(filter.Topics) == 0 || topicsFound >= len(filter.Topics)
 
-	return passesEmitter && passesTopic
+	return passesEmitter && passesTopic && filter.passesEventTopics(log)
+}
+
+func (filter *SimpleLogFilter) passesEventTopics(log *SimpleLog) bool {
+	for i, allowed := range filter.EventTopics {
+		if len(allowed) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) {
+			return false
+		}
+		found := false
+		for _, t := range allowed {
+			if t == log.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
 }
 
 // EXISTING_CODE
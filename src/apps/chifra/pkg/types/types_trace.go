@@ -47,6 +47,7 @@ type SimpleTrace struct {
 	CompressedTrace  string             `json:"compressedTrace,omitempty"`
 	Error            string             `json:"error,omitempty"`
 	Result           *SimpleTraceResult `json:"result"`
+	RevertReason     string             `json:"revertReason,omitempty"`
 	Subtraces        uint64             `json:"subtraces"`
 	Timestamp        base.Timestamp     `json:"timestamp"`
 	TraceAddress     []uint64           `json:"traceAddress"`
@@ -143,6 +144,9 @@ func (s *SimpleTrace) Model(chain, format string, verbose bool, extraOptions map
 		if isArticulated {
 			model["articulatedTrace"] = articulatedTrace
 		}
+		if extraOptions["articulate"] == true && s.RevertReason != "" {
+			model["revertReason"] = s.RevertReason
+		}
 
 	} else {
 		to := hexutil.Encode(s.Action.To.Bytes())
@@ -85,6 +85,7 @@ type SimpleTransaction struct {
 	MaxPriorityFeePerGas base.Gas        `json:"maxPriorityFeePerGas"`
 	Nonce                uint64          `json:"nonce"`
 	Receipt              *SimpleReceipt  `json:"receipt"`
+	RevertReason         string          `json:"revertReason,omitempty"`
 	Timestamp            base.Timestamp  `json:"timestamp"`
 	To                   base.Address    `json:"to"`
 	Traces               []SimpleTrace   `json:"traces"`
@@ -171,6 +172,10 @@ func (s *SimpleTransaction) Model(chain, format string, verbose bool, extraOptio
 		if sm != "" && sm != "nonpayable" && sm != "view" {
 			articulatedTx["stateMutability"] = sm
 		}
+		if s.ArticulatedTx.Message != "" {
+			// for example, articulations guessed from the contract's bytecode are marked as such
+			articulatedTx["message"] = s.ArticulatedTx.Message
+		}
 	}
 
 	if format == "json" {
@@ -262,6 +267,10 @@ func (s *SimpleTransaction) Model(chain, format string, verbose bool, extraOptio
 			model["traces"] = make([]map[string]any, 0)
 		}
 
+		if extraOptions["articulate"] == true && s.RevertReason != "" {
+			model["revertReason"] = s.RevertReason
+		}
+
 		if isArticulated {
 			model["articulatedTx"] = articulatedTx
 
@@ -361,3 +361,632 @@ var Names_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "chifra.proto",
 }
+
+const (
+	Chifra_Export_FullMethodName       = "/Chifra/Export"
+	Chifra_List_FullMethodName         = "/Chifra/List"
+	Chifra_Blocks_FullMethodName       = "/Chifra/Blocks"
+	Chifra_Transactions_FullMethodName = "/Chifra/Transactions"
+	Chifra_Logs_FullMethodName         = "/Chifra/Logs"
+	Chifra_Traces_FullMethodName       = "/Chifra/Traces"
+	Chifra_State_FullMethodName        = "/Chifra/State"
+	Chifra_Tokens_FullMethodName       = "/Chifra/Tokens"
+	Chifra_When_FullMethodName         = "/Chifra/When"
+)
+
+// ChifraClient is the client API for Chifra service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChifraClient interface {
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Chifra_ExportClient, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (Chifra_ListClient, error)
+	Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (Chifra_BlocksClient, error)
+	Transactions(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (Chifra_TransactionsClient, error)
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Chifra_LogsClient, error)
+	Traces(ctx context.Context, in *TracesRequest, opts ...grpc.CallOption) (Chifra_TracesClient, error)
+	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (Chifra_StateClient, error)
+	Tokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (Chifra_TokensClient, error)
+	When(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (Chifra_WhenClient, error)
+}
+
+type chifraClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChifraClient(cc grpc.ClientConnInterface) ChifraClient {
+	return &chifraClient{cc}
+}
+
+func (c *chifraClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Chifra_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[0], Chifra_Export_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_ExportClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraExportClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (Chifra_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[1], Chifra_List_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_ListClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraListClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraListClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (Chifra_BlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[2], Chifra_Blocks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_BlocksClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraBlocksClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) Transactions(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (Chifra_TransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[3], Chifra_Transactions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_TransactionsClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraTransactionsClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Chifra_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[4], Chifra_Logs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_LogsClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraLogsClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) Traces(ctx context.Context, in *TracesRequest, opts ...grpc.CallOption) (Chifra_TracesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[5], Chifra_Traces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraTracesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_TracesClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraTracesClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraTracesClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (Chifra_StateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[6], Chifra_State_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_StateClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraStateClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) Tokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (Chifra_TokensClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[7], Chifra_Tokens_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraTokensClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_TokensClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraTokensClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraTokensClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chifraClient) When(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (Chifra_WhenClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Chifra_ServiceDesc.Streams[8], Chifra_When_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chifraWhenClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chifra_WhenClient interface {
+	Recv() (*Model, error)
+	grpc.ClientStream
+}
+
+type chifraWhenClient struct {
+	grpc.ClientStream
+}
+
+func (x *chifraWhenClient) Recv() (*Model, error) {
+	m := new(Model)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChifraServer is the server API for Chifra service.
+// All implementations must embed UnimplementedChifraServer
+// for forward compatibility
+type ChifraServer interface {
+	Export(*ExportRequest, Chifra_ExportServer) error
+	List(*ListRequest, Chifra_ListServer) error
+	Blocks(*BlocksRequest, Chifra_BlocksServer) error
+	Transactions(*TransactionsRequest, Chifra_TransactionsServer) error
+	Logs(*LogsRequest, Chifra_LogsServer) error
+	Traces(*TracesRequest, Chifra_TracesServer) error
+	State(*StateRequest, Chifra_StateServer) error
+	Tokens(*TokensRequest, Chifra_TokensServer) error
+	When(*WhenRequest, Chifra_WhenServer) error
+	mustEmbedUnimplementedChifraServer()
+}
+
+// UnimplementedChifraServer must be embedded to have forward compatible implementations.
+type UnimplementedChifraServer struct {
+}
+
+func (UnimplementedChifraServer) Export(*ExportRequest, Chifra_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedChifraServer) List(*ListRequest, Chifra_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedChifraServer) Blocks(*BlocksRequest, Chifra_BlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blocks not implemented")
+}
+func (UnimplementedChifraServer) Transactions(*TransactionsRequest, Chifra_TransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Transactions not implemented")
+}
+func (UnimplementedChifraServer) Logs(*LogsRequest, Chifra_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedChifraServer) Traces(*TracesRequest, Chifra_TracesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Traces not implemented")
+}
+func (UnimplementedChifraServer) State(*StateRequest, Chifra_StateServer) error {
+	return status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (UnimplementedChifraServer) Tokens(*TokensRequest, Chifra_TokensServer) error {
+	return status.Errorf(codes.Unimplemented, "method Tokens not implemented")
+}
+func (UnimplementedChifraServer) When(*WhenRequest, Chifra_WhenServer) error {
+	return status.Errorf(codes.Unimplemented, "method When not implemented")
+}
+func (UnimplementedChifraServer) mustEmbedUnimplementedChifraServer() {}
+
+// UnsafeChifraServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChifraServer will
+// result in compilation errors.
+type UnsafeChifraServer interface {
+	mustEmbedUnimplementedChifraServer()
+}
+
+func RegisterChifraServer(s grpc.ServiceRegistrar, srv ChifraServer) {
+	s.RegisterService(&Chifra_ServiceDesc, srv)
+}
+
+func _Chifra_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).Export(m, &chifraExportServer{stream})
+}
+
+type Chifra_ExportServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraExportServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).List(m, &chifraListServer{stream})
+}
+
+type Chifra_ListServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraListServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraListServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_Blocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).Blocks(m, &chifraBlocksServer{stream})
+}
+
+type Chifra_BlocksServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraBlocksServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_Transactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).Transactions(m, &chifraTransactionsServer{stream})
+}
+
+type Chifra_TransactionsServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraTransactionsServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).Logs(m, &chifraLogsServer{stream})
+}
+
+type Chifra_LogsServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraLogsServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_Traces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TracesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).Traces(m, &chifraTracesServer{stream})
+}
+
+type Chifra_TracesServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraTracesServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraTracesServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_State_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).State(m, &chifraStateServer{stream})
+}
+
+type Chifra_StateServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraStateServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_Tokens_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TokensRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).Tokens(m, &chifraTokensServer{stream})
+}
+
+type Chifra_TokensServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraTokensServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraTokensServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Chifra_When_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WhenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChifraServer).When(m, &chifraWhenServer{stream})
+}
+
+type Chifra_WhenServer interface {
+	Send(*Model) error
+	grpc.ServerStream
+}
+
+type chifraWhenServer struct {
+	grpc.ServerStream
+}
+
+func (x *chifraWhenServer) Send(m *Model) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Chifra_ServiceDesc is the grpc.ServiceDesc for Chifra service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Chifra_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Chifra",
+	HandlerType: (*ChifraServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Chifra_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "List",
+			Handler:       _Chifra_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Blocks",
+			Handler:       _Chifra_Blocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Transactions",
+			Handler:       _Chifra_Transactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _Chifra_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Traces",
+			Handler:       _Chifra_Traces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "State",
+			Handler:       _Chifra_State_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Tokens",
+			Handler:       _Chifra_Tokens_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "When",
+			Handler:       _Chifra_When_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "chifra.proto",
+}
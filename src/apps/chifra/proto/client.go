@@ -33,6 +33,24 @@ func GetContext() (context.Context, context.CancelFunc) {
 }
 
 func Connect(ctx context.Context) (connection *grpc.ClientConn, client NamesClient, err error) {
+	if connection, err = dial(ctx); err != nil {
+		return
+	}
+	client = NewNamesClient(connection)
+	return
+}
+
+// ConnectChifra connects to the server's Chifra service, which streams the output of the commands
+// that produce data
+func ConnectChifra(ctx context.Context) (connection *grpc.ClientConn, client ChifraClient, err error) {
+	if connection, err = dial(ctx); err != nil {
+		return
+	}
+	client = NewChifraClient(connection)
+	return
+}
+
+func dial(ctx context.Context) (connection *grpc.ClientConn, err error) {
 	if !file.FileExists(SocketAddress()) {
 		err = ErrServerNotRunning
 		return
@@ -56,7 +74,5 @@ func Connect(ctx context.Context) (connection *grpc.ClientConn, client NamesClie
 		}
 		return
 	}
-
-	client = NewNamesClient(connection)
 	return
 }
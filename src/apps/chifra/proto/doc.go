@@ -1,2 +1,2 @@
-// Package proto is used by some of the tools (currently only chifra names) to provide a gRPC server when needed.
+// Package proto is used by some of the tools (currently only chifra names) to provide a gRPC server when needed. It also defines the Chifra service, which streams the output of the commands that produce data.
 package proto
@@ -291,14 +291,15 @@ bool skipField(const CClassDefinition& model, const CMember& field, bool raw) {
             return field.name % "Date" || field.name % "Encoding" || field.name % "Ether" ||
                    field.name % "EtherGasPrice" || field.name % "CompressedTx" || field.name % "GasUsed" ||
                    field.name % "HasToken" || field.name % "IsError" || field.name % "Receipt" ||
-                   field.name % "Statements" || field.name % "Timestamp" || field.name % "Traces" || field.name % "raw";
+                   field.name % "Statements" || field.name % "Timestamp" || field.name % "Traces" || field.name % "raw" ||
+                   field.name % "RevertReason";
         }
         return false;
     }
 
     return contains(field.name, "::") || field.name == "InputsDict" || field.name == "OutputsDict" ||
            field.name == "Abi_source" || (!raw && field.name == "LogsBloom") || (raw && field.name == "IsError") ||
-           (raw && startsWith(field.name, "Compressed")) || (field.name == "raw" && raw) ||
+           (raw && startsWith(field.name, "Compressed")) || (raw && field.name % "RevertReason") || (field.name == "raw" && raw) ||
            (model.base_name == "Trace" && raw && field.name == "Timestamp") ||
            (model.base_name == "Log" && raw && field.name == "Date") ||
            (model.base_name == "Log" && raw && field.name == "Timestamp") || field.name == "Topic0" ||
@@ -570,6 +571,11 @@ string_q get_marshal_fields(const CClassDefinition& modelIn) {
             continue;
         }
 
+        // Revert reasons are found when articulating, so they are not cached
+        if (field.name % "revertReason") {
+            continue;
+        }
+
         string_q spec = specialCacheCase1(modelName, field);
         if (spec != "") {
             os << spec << endl;
@@ -669,6 +675,11 @@ string_q get_unmarshal_fields(const CClassDefinition& modelIn) {
             continue;
         }
 
+        // Revert reasons are found when articulating, so they are not cached
+        if (field.name % "revertReason") {
+            continue;
+        }
+
         string_q spec = specialCacheCase2(modelName, field);
         if (spec != "") {
             os << spec << endl;
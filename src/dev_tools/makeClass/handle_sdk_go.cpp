@@ -0,0 +1,239 @@
+/*-------------------------------------------------------------------------------------------
+ * qblocks - fast, easily-accessible, fully-decentralized data from blockchains
+ * copyright (c) 2016, 2021 TrueBlocks, LLC (http://trueblocks.io)
+ *
+ * This program is free software: you may redistribute it and/or modify it under the terms
+ * of the GNU General Public License as published by the Free Software Foundation, either
+ * version 3 of the License, or (at your option) any later version. This program is
+ * distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even
+ * the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details. You should have received a copy of the GNU General
+ * Public License along with this program. If not, see http://www.gnu.org/licenses/.
+ *-------------------------------------------------------------------------------------------*/
+#include "utillib.h"
+#include "options.h"
+
+extern string_q getGlobalFeature(const string_q& route, const string_q& feature);
+extern bool isCrud(const string_q& cmd);
+
+//------------------------------------------------------------------------------------------------------------
+// findGoSdkModel returns the data model with the given class name (or nullptr if there is none)
+const CClassDefinition* findGoSdkModel(const CClassDefinitionArray& models, const string_q& className) {
+    for (const CClassDefinition& model : models) {
+        if (model.class_name == className && model.doc_route != "no_doc") {
+            return &model;
+        }
+    }
+    return nullptr;
+}
+
+//------------------------------------------------------------------------------------------------------------
+// toGoSdkModel returns the type in the sdk a result decodes into (or the empty string if there is none)
+string_q toGoSdkModel(const CClassDefinitionArray& models, const string_q& type) {
+    for (const CClassDefinition& model : models) {
+        if (model.doc_route == type) {
+            // blocks are reported with their transactions' hashes unless the full transactions are requested
+            return model.base_name + (model.base_name == "Block" ? "[string]" : "");
+        }
+    }
+    return "";
+}
+
+//------------------------------------------------------------------------------------------------------------
+string_q toGoSdkList(const CStringArray& items) {
+    ostringstream os;
+    for (size_t i = 0; i < items.size(); i++) {
+        if (i > 0) {
+            os << (i < items.size() - 1 ? ", " : (items.size() == 2 ? " or " : ", or "));
+        }
+        os << items[i];
+    }
+    return os.str();
+}
+
+//------------------------------------------------------------------------------------------------------------
+string_q toGoSdkResults(const CClassDefinitionArray& models, const CStringArray& types) {
+    CStringArray goTypes, others;
+    for (auto type : types) {
+        string_q goType = toGoSdkModel(models, type);
+        if (goType.empty()) {
+            others.push_back(firstLower(type));
+        } else {
+            goTypes.push_back(goType);
+        }
+    }
+
+    ostringstream os;
+    if (!goTypes.empty()) {
+        os << "Its results may be decoded as " << toGoSdkList(goTypes) << ".";
+    }
+    if (!others.empty()) {
+        os << (goTypes.empty() ? "Its" : " Its other") << " results (" << toGoSdkList(others);
+        os << ") may be decoded as map[string]any.";
+    }
+    return os.str();
+}
+
+//------------------------------------------------------------------------------------------------------------
+string_q toGoSdkField(const string_q& name, const string_q& goType, const string_q& descr) {
+    ostringstream os;
+    os << "\t// " << substitute(descr, "&#44;", ",") << endl;
+    os << "\t" << substitute(toProper(name), "_", "") << " " << goType;
+    os << " `json:\"" << toCamelCase(name) << ",omitempty\"`" << endl;
+    os << endl;
+    return os.str();
+}
+
+//------------------------------------------------------------------------------------------------------------
+// toGoSdkType returns the type of a data model's field in the sdk. The types are those of the JSON the
+// daemon produces, so the sdk does not depend on chifra's packages.
+string_q toGoSdkType(const CClassDefinitionArray& models, const CClassDefinition& model, const CMember& field) {
+    bool isArray = field.memberFlags & IS_ARRAY;
+    string_q type = field.type;
+    if (isArray || field.memberFlags & IS_OBJECT) {
+        type = substitute(extract(type, 1), "Array", "");
+    }
+
+    if (model.base_name == "Block" && field.name == "transactions") {
+        return "[]Tx";
+    }
+
+    static const map<string_q, string_q> baseTypes = {
+        {"address", "string"},  {"blkrange", "string"}, {"bytes", "string"},   {"datetime", "string"},
+        {"fourbyte", "string"}, {"hash", "string"},     {"ipfshash", "string"}, {"string", "string"},
+        {"topic", "string"},    {"blknum", "uint64"},   {"gas", "uint64"},      {"txnum", "uint64"},
+        {"uint64", "uint64"},   {"uint32", "uint32"},   {"int64", "int64"},     {"timestamp", "int64"},
+        {"double", "float64"},  {"float", "float64"},   {"bool", "bool"},       {"uint8", "bool"},
+        {"wei", "BigInt"},      {"int256", "BigInt"},   {"uint256", "BigInt"},  {"any", "any"},
+    };
+
+    string_q goType;
+    auto it = baseTypes.find(toLower(type));
+    if (it != baseTypes.end()) {
+        goType = it->second;
+    } else if (findGoSdkModel(models, "C" + firstUpper(type)) && !isArray) {
+        // as in pkg/types, nested models may be missing
+        goType = "*" + firstUpper(type);
+    } else {
+        // other models, RawAppearance, and TokenType
+        goType = firstUpper(type);
+    }
+    return (isArray ? "[]" : "") + goType;
+}
+
+//------------------------------------------------------------------------------------------------------------
+bool COptions::handle_sdk_go_types(void) {
+    for (auto model : dataModels) {
+        if (model.doc_route == "no_doc") {
+            continue;
+        }
+
+        ostringstream fields;
+        for (auto field : model.fieldArray) {
+            if (field.memberFlags & IS_RAWONLY || contains(field.name, "::") || startsWith(field.name, "unused")) {
+                // the raw fields are not decoded
+                continue;
+            }
+            if (!fields.str().empty()) {
+                fields << endl;
+            }
+            if (!field.description.empty()) {
+                fields << "\t// " << substitute(field.description, "&#44;", ",") << endl;
+            }
+            fields << "\t" << firstUpper(field.name) << " " << toGoSdkType(dataModels, model, field);
+            fields << " `json:\"" << field.name << (field.memberFlags & IS_OMITEMPTY ? ",omitempty" : "") << "\"`";
+            fields << endl;
+        }
+
+        string_q source = asciiFileToString(getPathToTemplates("sdk_go_type.tmpl"));
+        replaceAll(source, "[{CLASS}]", model.base_name);
+        // as in pkg/types, a block's transactions are either hashes or transactions
+        replaceAll(source, "[{TYPE_PARAMS}]", model.base_name == "Block" ? "[Tx any]" : "");
+        replaceAll(source, "[{DESCR}]", substitute(model.doc_descr, "&#44;", ","));
+        replaceAll(source, "[{FIELDS}]", fields.str());
+        writeIfDifferent(sdkPath + "go/types_" + model.base_lower + ".go", source);
+    }
+
+    // the sdk reports the version of chifra it was generated from
+    string_q contents = asciiFileToString(getPathToSource("apps/chifra/pkg/version/version_strings.go"));
+    string_q version = substitute(contents, "const LibraryVersion = \"", "|");
+    nextTokenClear(version, '|');
+    version = nextTokenClear(version, '"');
+
+    string_q source = asciiFileToString(getPathToTemplates("sdk_go_version.tmpl"));
+    replaceAll(source, "[{VERSION}]", version);
+    writeIfDifferent(sdkPath + "go/version.go", source);
+
+    return true;
+}
+
+//------------------------------------------------------------------------------------------------------------
+bool COptions::handle_sdk_go(void) {
+    for (auto ep : endpointArray) {
+        string_q apiRoute = ep.api_route;
+
+        CStringArray types;
+        getReturnTypes(ep, types);
+        if (!isApiRoute(apiRoute) || types.empty()) {
+            // if there is no route nor any returned data, do nothing
+            continue;
+        }
+
+        ostringstream filename;
+        filename << sdkPath << "go/" << apiRoute << ".go";
+
+        CCommandOptionArray members;
+        for (auto option : routeOptionArray) {
+            if (option.isChifraRoute(false) && option.api_route == apiRoute) {
+                members.push_back(option);
+            }
+        }
+
+        counter.cmdCount += members.size();
+        counter.routeCount++;
+
+        ostringstream fields;
+        for (auto p : members) {
+            // as in the API's specification, the options served by other methods are left out
+            if (!p.is_visible_docs || isCrud(p.longName)) {
+                continue;
+            }
+            fields << toGoSdkField(p.longName, p.go_intype, p.description);
+            if (p.option_type != "positional") {
+                reportOneOption(apiRoute, toCamelCase(p.longName), "go");
+            }
+        }
+
+        // raw data is not decoded into the types, so of the global options only these are per-route
+        for (auto global : {"ether", "cache"}) {
+            string_q g = getGlobalFeature(apiRoute, global);
+            if (g.empty())
+                continue;
+            CStringArray parts;
+            explode(parts, g, '|');
+            fields << toGoSdkField(parts[0], "bool", parts[1]);
+            reportOneOption(apiRoute, parts[0], "go");
+        }
+
+        string_q source = asciiFileToString(getPathToTemplates("sdk_go.tmpl"));
+        replaceAll(source, "[{ROUTE}]", apiRoute);
+        replaceAll(source, "[{PROPER}]", toProper(apiRoute));
+        replaceAll(source, "[{DESCR}]", substitute(ep.description, "&#44;", ","));
+        replaceAll(source, "[{RESULTS}]", toGoSdkResults(dataModels, types));
+        replaceAll(source, "[{FIELDS}]", fields.str());
+
+        writeIfDifferent(filename.str(), source);
+    }
+
+    if (!handle_sdk_go_types()) {
+        return false;
+    }
+
+    ostringstream log;
+    log << cYellow << "makeClass --sdk (go)" << cOff;
+    log << " processed " << counter.routeCount << "/" << counter.cmdCount;
+    log << " paths (changed " << counter.nProcessed << ")." << string_q(40, ' ');
+    LOG_INFO(log.str());
+
+    return true;
+}
@@ -78,6 +78,8 @@ class COptions : public COptionsBase {
     bool handle_sdk_py(void);
     bool handle_sdk_py_paths(CStringArray& pathsOut);
     bool handle_sdk_py_types(CStringArray& typesOut);
+    bool handle_sdk_go(void);
+    bool handle_sdk_go_types(void);
 
     bool handle_gocmds_cmd(const CCommandOption& cmd);
     bool handle_gocmds_options(const CCommandOption& cmd);
@@ -92,6 +92,7 @@ bool COptions::parseArguments(string_q& command) {
                 }
                 tests.push_back("tools/ethNames");
                 tests.push_back("tools/getBlocks");
+                tests.push_back("tools/getLineage");
                 tests.push_back("tools/getLogs");
                 tests.push_back("tools/getReceipts");
                 tests.push_back("tools/getState");
@@ -146,6 +147,7 @@ bool COptions::parseArguments(string_q& command) {
         }
         tests.push_back("tools/ethNames");
         tests.push_back("tools/getBlocks");
+        tests.push_back("tools/getLineage");
         tests.push_back("tools/getLogs");
         tests.push_back("tools/getReceipts");
         tests.push_back("tools/getState");